@@ -0,0 +1,79 @@
+package block
+
+import (
+	"strconv"
+	"strings"
+
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+)
+
+// Header is the part of a block the block hash is computed of. It proves
+// the miner and the round of a signed block hash without the block body,
+// e.g. for evidence of equivocation.
+type Header struct {
+	MinerID            datastore.Key    `json:"miner_id"`
+	PrevHash           string           `json:"prev_hash"`
+	CreationDate       common.Timestamp `json:"creation_date"`
+	Round              int64            `json:"round"`
+	RoundRandomSeed    int64            `json:"round_random_seed"`
+	StateChangesCount  int              `json:"state_changes_count"`
+	MerkleRoot         string           `json:"merkle_root"`
+	ReceiptsMerkleRoot string           `json:"receipts_merkle_root"`
+	MagicBlockHash     string           `json:"magic_block_hash,omitempty"`
+}
+
+// Header returns header of the block
+func (b *Block) Header() *Header {
+	h := &Header{
+		MinerID:            b.MinerID,
+		PrevHash:           b.PrevHash,
+		CreationDate:       b.CreationDate,
+		Round:              b.Round,
+		RoundRandomSeed:    b.GetRoundRandomSeed(),
+		StateChangesCount:  b.StateChangesCount,
+		MerkleRoot:         b.GetMerkleTree().GetRoot(),
+		ReceiptsMerkleRoot: b.GetReceiptsMerkleTree().GetRoot(),
+	}
+
+	if b.MagicBlock != nil {
+		if b.MagicBlock.Hash == "" {
+			b.MagicBlock.Hash = b.MagicBlock.GetHash()
+		}
+		h.MagicBlockHash = b.MagicBlock.Hash
+	}
+
+	return h
+}
+
+func (h *Header) getHashData() string {
+	hashBuilder := strings.Builder{}
+	hashBuilder.WriteString(h.MinerID)
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(h.PrevHash)
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(common.TimeToString(h.CreationDate))
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(strconv.FormatInt(h.Round, 10))
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(strconv.FormatInt(h.RoundRandomSeed, 10))
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(strconv.Itoa(h.StateChangesCount))
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(h.MerkleRoot)
+	hashBuilder.WriteString(":")
+	hashBuilder.WriteString(h.ReceiptsMerkleRoot)
+
+	if h.MagicBlockHash != "" {
+		hashBuilder.WriteString(":")
+		hashBuilder.WriteString(h.MagicBlockHash)
+	}
+
+	return hashBuilder.String()
+}
+
+// ComputeHash returns hash of the block of the header
+func (h *Header) ComputeHash() string {
+	return encryption.Hash(h.getHashData())
+}
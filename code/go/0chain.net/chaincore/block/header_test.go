@@ -0,0 +1,45 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+)
+
+func TestBlock_Header(t *testing.T) {
+	b := NewBlock("", 5)
+	b.MinerID = "miner"
+	b.PrevHash = "prev"
+	b.CreationDate = 1
+	b.SetRoundRandomSeed(7)
+	b.StateChangesCount = 2
+
+	h := b.Header()
+	mt, rmt := b.GetMerkleTree().GetRoot(), b.GetReceiptsMerkleTree().GetRoot()
+	require.Equal(t, &Header{
+		MinerID:            "miner",
+		PrevHash:           "prev",
+		CreationDate:       1,
+		Round:              5,
+		RoundRandomSeed:    7,
+		StateChangesCount:  2,
+		MerkleRoot:         mt,
+		ReceiptsMerkleRoot: rmt,
+	}, h)
+
+	// the header proves the block hash
+	want := encryption.Hash("miner:prev:" + common.TimeToString(1) + ":5:7:2:" + mt + ":" + rmt)
+	assert.Equal(t, want, h.ComputeHash())
+	assert.Equal(t, want, b.ComputeHash())
+
+	b.MagicBlock = NewMagicBlock()
+	b.MagicBlock.Hash = "mb"
+	h = b.Header()
+	assert.Equal(t, "mb", h.MagicBlockHash)
+	assert.Equal(t, b.ComputeHash(), h.ComputeHash())
+	assert.NotEqual(t, want, h.ComputeHash())
+}
@@ -29,6 +29,9 @@ type MagicBlock struct {
 	T                      int                 `json:"t"`
 	K                      int                 `json:"k"`
 	N                      int                 `json:"n"`
+	// Reshared is set when the group secret of the previous magic block is
+	// reshared with the miners, the group public key doesn't change then.
+	Reshared bool `json:"reshared,omitempty"`
 }
 
 func NewMagicBlock() *MagicBlock {
@@ -90,6 +93,9 @@ func (mb *MagicBlock) GetHashBytes() []byte {
 	}
 	data = append(data, []byte(strconv.Itoa(mb.T))...)
 	data = append(data, []byte(strconv.Itoa(mb.N))...)
+	if mb.Reshared {
+		data = append(data, []byte("reshared")...)
+	}
 	return encryption.RawHash(data)
 }
 
@@ -128,6 +134,7 @@ func (mb *MagicBlock) Clone() *MagicBlock {
 		T:                      mb.T,
 		K:                      mb.K,
 		N:                      mb.N,
+		Reshared:               mb.Reshared,
 	}
 
 	if mb.ShareOrSigns != nil {
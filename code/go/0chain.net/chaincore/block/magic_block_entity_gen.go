@@ -10,9 +10,9 @@ import (
 // MarshalMsg implements msgp.Marshaler
 func (z *MagicBlock) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 11
+	// map header, size 12
 	// string "HashIDField"
-	o = append(o, 0x8b, 0xab, 0x48, 0x61, 0x73, 0x68, 0x49, 0x44, 0x46, 0x69, 0x65, 0x6c, 0x64)
+	o = append(o, 0x8c, 0xab, 0x48, 0x61, 0x73, 0x68, 0x49, 0x44, 0x46, 0x69, 0x65, 0x6c, 0x64)
 	o, err = z.HashIDField.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "HashIDField")
@@ -80,6 +80,9 @@ func (z *MagicBlock) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "N"
 	o = append(o, 0xa1, 0x4e)
 	o = msgp.AppendInt(o, z.N)
+	// string "Reshared"
+	o = append(o, 0xa8, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64)
+	o = msgp.AppendBool(o, z.Reshared)
 	return
 }
 
@@ -211,6 +214,12 @@ func (z *MagicBlock) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "N")
 				return
 			}
+		case "Reshared":
+			z.Reshared, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Reshared")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -249,6 +258,6 @@ func (z *MagicBlock) Msgsize() (s int) {
 	} else {
 		s += z.Mpks.Msgsize()
 	}
-	s += 2 + msgp.IntSize + 2 + msgp.IntSize + 2 + msgp.IntSize
+	s += 2 + msgp.IntSize + 2 + msgp.IntSize + 2 + msgp.IntSize + 9 + msgp.BoolSize
 	return
 }
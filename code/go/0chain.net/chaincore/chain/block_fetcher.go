@@ -357,6 +357,9 @@ type Chainer interface {
 		nb *block.Block, err error)
 	GetCurrentRound() int64
 	GetMagicBlock(round int64) *block.MagicBlock
+	GetStakeTable(mb *block.MagicBlock) *StakeTable
+	ThresholdByStake() int
+	ThresholdByStakePercent() int
 	GetLatestFinalizedMagicBlockRound(rn int64) *block.Block
 	GetRound(roundNumber int64) round.RoundI
 	IsRoundGenerator(r round.RoundI, nd *node.Node) bool
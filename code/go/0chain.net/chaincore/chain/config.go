@@ -7,6 +7,7 @@ import (
 	"0chain.net/smartcontract/minersc/enums"
 
 	"0chain.net/chaincore/config"
+	"0chain.net/chaincore/hardfork"
 	"github.com/0chain/common/core/currency"
 
 	"0chain.net/core/viper"
@@ -190,6 +191,13 @@ func (c *ConfigImpl) ThresholdByStake() int {
 	return c.conf.ThresholdByStake
 }
 
+func (c *ConfigImpl) ThresholdByStakePercent() int {
+	c.guard.RLock()
+	defer c.guard.RUnlock()
+
+	return c.conf.ThresholdByStakePercent
+}
+
 func (c *ConfigImpl) ValidationBatchSize() int {
 	c.guard.RLock()
 	defer c.guard.RUnlock()
@@ -260,6 +268,13 @@ func (c *ConfigImpl) BlockProposalWaitMode() int8 {
 	return c.conf.BlockProposalWaitMode
 }
 
+func (c *ConfigImpl) BlockProposalPipelining() bool {
+	c.guard.RLock()
+	defer c.guard.RUnlock()
+
+	return c.conf.BlockProposalPipelining
+}
+
 func (c *ConfigImpl) ReuseTransactions() bool {
 	c.guard.RLock()
 	defer c.guard.RUnlock()
@@ -365,6 +380,20 @@ func (c *ConfigImpl) TxnTransferCost() int {
 	return c.conf.TxnTransferCost
 }
 
+func (c *ConfigImpl) BaseFeeChangeDenominator() int {
+	c.guard.RLock()
+	defer c.guard.RUnlock()
+
+	return c.conf.BaseFeeChangeDenominator
+}
+
+func (c *ConfigImpl) BaseFeeTargetFullness() float64 {
+	c.guard.RLock()
+	defer c.guard.RUnlock()
+
+	return c.conf.BaseFeeTargetFullness
+}
+
 //ConfigData - chain Configuration
 type ConfigData struct {
 	version               int64         `json:"-"` //version of config to track updates
@@ -406,6 +435,7 @@ type ConfigData struct {
 
 	BlockProposalMaxWaitTime time.Duration `json:"block_proposal_max_wait_time"` // max time to wait to receive a block proposal
 	BlockProposalWaitMode    int8          `json:"block_proposal_wait_mode"`     // wait time for the block proposal is static (0) or dynamic (1)
+	BlockProposalPipelining  bool          `json:"block_proposal_pipelining"`    // generate the next round block on the best verified block before notarization
 
 	ReuseTransactions bool `json:"reuse_txns"` // indicates if transactions from unrelated blocks can be reused
 
@@ -424,6 +454,13 @@ type ConfigData struct {
 	DbsEvents   config.DbAccess   `json:"dbs_event"`
 	DbsSettings config.DbSettings `json:"dbs_settings"`
 	TxnExempt   map[string]bool   `json:"txn_exempt"`
+
+	HardForks map[string]int64 `json:"hard_forks"` // activation rounds of the hard forks
+
+	BaseFeeChangeDenominator int     `json:"base_fee_change_denominator"` // bounds the base fee change of a block, 0 disables the base fee
+	BaseFeeTargetFullness    float64 `json:"base_fee_target_fullness"`    // part of the block size the base fee targets
+
+	ThresholdByStakePercent int `json:"threshold_by_stake_percent"` // Percent of the total stake for a block to be notarized
 }
 
 func (c *ConfigImpl) FromViper() error {
@@ -458,6 +495,7 @@ func (c *ConfigImpl) FromViper() error {
 	conf.NumReplicators = viper.GetInt("server_chain.block.replicators")
 	conf.ThresholdByCount = viper.GetInt("server_chain.block.consensus.threshold_by_count")
 	conf.ThresholdByStake = viper.GetInt("server_chain.block.consensus.threshold_by_stake")
+	conf.ThresholdByStakePercent = viper.GetInt("server_chain.block.consensus.threshold_by_stake_percent")
 	conf.OwnerID = viper.GetString("server_chain.owner")
 	conf.ValidationBatchSize = viper.GetInt("server_chain.block.validation.batch_size")
 	conf.RoundRange = viper.GetInt64("server_chain.round_range")
@@ -471,6 +509,8 @@ func (c *ConfigImpl) FromViper() error {
 	if err != nil {
 		return err
 	}
+	conf.BaseFeeChangeDenominator = viper.GetInt("server_chain.transaction.base_fee.change_denominator")
+	conf.BaseFeeTargetFullness = viper.GetFloat64("server_chain.transaction.base_fee.target_fullness")
 	txnExp := viper.GetStringSlice("server_chain.transaction.exempt")
 	conf.TxnExempt = make(map[string]bool)
 	for i := range txnExp {
@@ -517,6 +557,7 @@ func (c *ConfigImpl) FromViper() error {
 	} else if waitMode == "dynamic" {
 		conf.BlockProposalWaitMode = BlockProposalWaitDynamic
 	}
+	conf.BlockProposalPipelining = viper.GetBool("server_chain.block.proposal.pipelining")
 	conf.ReuseTransactions = viper.GetBool("server_chain.block.reuse_txns")
 
 	conf.MinActiveSharders = viper.GetInt("server_chain.block.sharding.min_active_sharders")
@@ -546,6 +587,15 @@ func (c *ConfigImpl) FromViper() error {
 	conf.DbsSettings.PartitionChangePeriod = viper.GetInt64("server_chain.dbs.settings.partition_change_period")
 	conf.DbsSettings.PartitionKeepCount = viper.GetInt64("server_chain.dbs.settings.partition_keep_count")
 	conf.DbsSettings.PageLimit = viper.GetInt64("server_chain.dbs.settings.page_limit")
+
+	conf.HardForks = make(map[string]int64)
+	for name := range viper.GetStringMap("server_chain.hard_forks") {
+		conf.HardForks[name] = viper.GetInt64("server_chain.hard_forks." + name)
+	}
+	if err := hardfork.Schedule(conf.HardForks); err != nil {
+		logging.Logger.Error("invalid hard forks schedule", zap.Error(err))
+		return err
+	}
 	return nil
 }
 
@@ -639,6 +689,10 @@ func (c *ConfigImpl) Update(fields map[string]string, version int64) error {
 	} else if waitMode == "dynamic" {
 		conf.BlockProposalWaitMode = BlockProposalWaitDynamic
 	}
+	conf.BlockProposalPipelining, err = cf.GetBool(enums.BlockProposalPipelining)
+	if err != nil {
+		return err
+	}
 	conf.ThresholdByCount, err = cf.GetInt(enums.BlockConsensusThresholdByCount)
 	if err != nil {
 		return err
@@ -19,6 +19,10 @@ import (
 
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/governancesc"
+	"0chain.net/smartcontract/htlcsc"
+	"0chain.net/smartcontract/paychansc"
+	"0chain.net/smartcontract/schedulersc"
 	"0chain.net/smartcontract/storagesc"
 	"0chain.net/smartcontract/vestingsc"
 	"0chain.net/smartcontract/zcnsc"
@@ -127,7 +131,8 @@ type Chain struct {
 
 	currentRound int64 `json:"-"`
 
-	FeeStats transaction.FeeStats `json:"fee_stats"`
+	FeeStats  transaction.FeeStats `json:"fee_stats"`
+	feeWindow feeWindow
 
 	LatestFinalizedBlock *block.Block `json:"latest_finalized_block,omitempty"` // Latest block on the chain the program is aware of
 	lfbMutex             sync.RWMutex
@@ -145,7 +150,7 @@ type Chain struct {
 
 	BlockChain *ring.Ring `json:"-"`
 
-	minersStake map[datastore.Key]uint64
+	stakeTables map[int64]*StakeTable // magic block starting round -> stake table
 	stakeMutex  *sync.Mutex
 
 	nodePoolScorer node.PoolScorer
@@ -495,7 +500,7 @@ func (c *Chain) Initialize() {
 	c.stateDB = stateDB
 	//c.stateDB = util.NewMemoryNodeDB()
 	c.BlockChain = ring.New(10000)
-	c.minersStake = make(map[datastore.Key]uint64)
+	c.stakeTables = make(map[int64]*StakeTable)
 	c.magicBlockStartingRounds = make(map[int64]*block.Block)
 	c.MagicBlockStorage = round.NewRoundStartingStorage()
 	c.OnBlockAdded = func(b *block.Block) {
@@ -613,6 +618,30 @@ func (c *Chain) setupInitialState(initStates *state.InitStates, gb *block.Block)
 		panic(err)
 	}
 
+	err = governancesc.InitConfig(stateCtx)
+	if err != nil {
+		logging.Logger.Error("chain.stateDB governancesc InitConfig failed", zap.Error(err))
+		panic(err)
+	}
+
+	err = schedulersc.InitConfig(stateCtx)
+	if err != nil {
+		logging.Logger.Error("chain.stateDB schedulersc InitConfig failed", zap.Error(err))
+		panic(err)
+	}
+
+	err = htlcsc.InitConfig(stateCtx)
+	if err != nil {
+		logging.Logger.Error("chain.stateDB htlcsc InitConfig failed", zap.Error(err))
+		panic(err)
+	}
+
+	err = paychansc.InitConfig(stateCtx)
+	if err != nil {
+		logging.Logger.Error("chain.stateDB paychansc InitConfig failed", zap.Error(err))
+		panic(err)
+	}
+
 	if err := pmt.SaveChanges(context.Background(), stateDB, false); err != nil {
 		logging.Logger.Panic("chain.stateDB save changes failed", zap.Error(err))
 	}
@@ -1057,10 +1086,6 @@ func (c *Chain) chainHasTransaction(ctx context.Context, b *block.Block, txn *tr
 	return false, ErrInsufficientChain
 }
 
-func (c *Chain) getMiningStake(minerID datastore.Key) uint64 {
-	return c.minersStake[minerID]
-}
-
 // InitializeMinerPool - initialize the miners after their configuration is read
 func (c *Chain) InitializeMinerPool(mb *block.MagicBlock) {
 	numGenerators := c.GetGeneratorsNumOfMagicBlock(mb)
@@ -1170,6 +1195,7 @@ func (c *Chain) SetCurrentRound(r int64) {
 		return
 	}
 	if current < r {
+		checkActiveHardForks(r)
 		logging.Logger.Info("Moving to the next round", zap.Int64("next_round", r))
 		c.setCurrentRound(r)
 		return
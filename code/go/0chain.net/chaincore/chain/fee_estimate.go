@@ -0,0 +1,289 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/0chain/common/core/currency"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/node"
+	"0chain.net/chaincore/smartcontract"
+	sci "0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/memorystore"
+)
+
+const (
+	// FeeEstimateWindow is the number of the latest finalized blocks fee
+	// estimations are computed of
+	FeeEstimateWindow = 50
+	// MaxFeeEstimateTargetRounds limits the rounds a fee is estimated for
+	MaxFeeEstimateTargetRounds = 100
+)
+
+// confidence of the low, medium and high fee suggestions to get a
+// transaction included within the target rounds
+var feeEstimateConfidence = [3]float64{0.5, 0.8, 0.95}
+
+// blockFees is the min tip a transaction needed to get in a finalized block,
+// it's zero if the block wasn't full
+type blockFees struct {
+	Round   int64
+	BaseFee currency.Coin
+	MinTip  currency.Coin
+}
+
+// feeWindow keeps the fees of the latest finalized blocks
+type feeWindow struct {
+	mutex  sync.RWMutex
+	blocks []blockFees
+}
+
+// add adds the fees of the finalized block paying the base fee
+func (fw *feeWindow) add(fb *block.Block, baseFee currency.Coin, blockSize int32) {
+	bf := blockFees{Round: fb.Round, BaseFee: baseFee}
+	if blockSize > 0 && len(fb.Txns) >= int(blockSize) {
+		bf.MinTip = math.MaxInt64
+		for _, txn := range fb.Txns {
+			if txn.Fee == 0 && !txn.IsDynamicFee() {
+				continue // built-in transaction
+			}
+			if tip := txn.EffectiveTip(baseFee); tip < bf.MinTip {
+				bf.MinTip = tip
+			}
+		}
+		if bf.MinTip == math.MaxInt64 {
+			bf.MinTip = 0
+		}
+	}
+
+	fw.mutex.Lock()
+	defer fw.mutex.Unlock()
+
+	fw.blocks = append(fw.blocks, bf)
+	if len(fw.blocks) > FeeEstimateWindow {
+		fw.blocks = fw.blocks[len(fw.blocks)-FeeEstimateWindow:]
+	}
+}
+
+// minTips returns the sorted min tips of the blocks of the window and the
+// latest round of the window
+func (fw *feeWindow) minTips() (tips []currency.Coin, round int64) {
+	fw.mutex.RLock()
+	defer fw.mutex.RUnlock()
+
+	tips = make([]currency.Coin, 0, len(fw.blocks))
+	for _, bf := range fw.blocks {
+		tips = append(tips, bf.MinTip)
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i] < tips[j] })
+	if len(fw.blocks) > 0 {
+		round = fw.blocks[len(fw.blocks)-1].Round
+	}
+	return
+}
+
+// FeeSuggestion is a fee to pay by the fee or by the max fee and the
+// priority fee of a transaction
+type FeeSuggestion struct {
+	Fee         currency.Coin `json:"fee"`
+	MaxFee      currency.Coin `json:"max_fee"`
+	PriorityFee currency.Coin `json:"priority_fee"`
+}
+
+// FeeEstimate suggests fees to get a transaction included within the
+// target rounds with low, medium and high confidence
+type FeeEstimate struct {
+	Round        int64         `json:"round"` // the latest finalized round
+	TargetRounds int64         `json:"target_rounds"`
+	BaseFee      currency.Coin `json:"base_fee"`
+	PoolDepth    int64         `json:"pool_depth"`
+	Cost         int           `json:"cost"`
+	Low          FeeSuggestion `json:"low"`
+	Medium       FeeSuggestion `json:"medium"`
+	High         FeeSuggestion `json:"high"`
+}
+
+// estimateFee suggests tips the min tips of the blocks of the window don't
+// exceed for at least one of the target rounds with the confidences. The max
+// fee covers the base fee growing while the pool fills the blocks.
+func estimateFee(tips []currency.Coin, baseFee, minFee currency.Coin,
+	targetRounds, poolDepth int64, blockSize int32, denominator int) (
+	suggestions [3]FeeSuggestion) {
+
+	var maxBaseFee = baseFee
+	if denominator > 0 && blockSize > 0 {
+		full := poolDepth / int64(blockSize)
+		if full > targetRounds {
+			full = targetRounds
+		}
+		for i := int64(0); i < full; i++ {
+			change := maxBaseFee / currency.Coin(denominator)
+			if change == 0 {
+				change = 1
+			}
+			if next, err := currency.AddCoin(maxBaseFee, change); err == nil {
+				maxBaseFee = next
+			}
+		}
+	}
+
+	for i, confidence := range feeEstimateConfidence {
+		var tip currency.Coin
+		if len(tips) > 0 {
+			// part of the blocks a transaction with the tip misses, all the
+			// target rounds are missed with 1-confidence probability
+			missed := math.Pow(1-confidence, 1/float64(targetRounds))
+			k := int(math.Ceil((1-missed)*float64(len(tips)))) - 1
+			if k < 0 {
+				k = 0
+			}
+			tip = tips[k]
+		}
+		maxFee, err := currency.AddCoin(maxBaseFee, tip)
+		if err != nil {
+			maxFee = math.MaxInt64
+		}
+		if maxFee < minFee {
+			maxFee = minFee
+		}
+		suggestions[i] = FeeSuggestion{
+			Fee:         maxFee,
+			MaxFee:      maxFee,
+			PriorityFee: tip,
+		}
+	}
+	return
+}
+
+// EstimateFee suggests fees to get a transaction calling the smart contract
+// function included within the target rounds
+func (c *Chain) EstimateFee(ctx context.Context, targetRounds int64,
+	scAddress, scFunc string) (*FeeEstimate, error) {
+
+	if targetRounds < 1 || targetRounds > MaxFeeEstimateTargetRounds {
+		return nil, fmt.Errorf("target rounds should be in [1, %d] range",
+			MaxFeeEstimateTargetRounds)
+	}
+
+	var (
+		tips, round = c.feeWindow.minTips()
+		fe          = &FeeEstimate{
+			Round:        round,
+			TargetRounds: targetRounds,
+			BaseFee:      transaction.LatestBaseFee(),
+			PoolDepth:    transactionPoolDepth(),
+		}
+	)
+
+	if scFunc != "" {
+		cost, err := c.estimateFunctionCost(ctx, scAddress, scFunc)
+		if err != nil {
+			return nil, err
+		}
+		if cost > c.ChainConfig.MaxBlockCost() {
+			return nil, fmt.Errorf("cost %d of %q exceeds the max block cost",
+				cost, scFunc)
+		}
+		fe.Cost = cost
+	}
+
+	suggestions := estimateFee(tips, fe.BaseFee, c.ChainConfig.MinTxnFee(),
+		targetRounds, fe.PoolDepth, c.ChainConfig.BlockSize(),
+		c.ChainConfig.BaseFeeChangeDenominator())
+	fe.Low, fe.Medium, fe.High = suggestions[0], suggestions[1], suggestions[2]
+	return fe, nil
+}
+
+// estimateFunctionCost returns the cost of the smart contract function
+// on the latest finalized state, the smart contract is looked up by the
+// function name if its address is not given
+func (c *Chain) estimateFunctionCost(ctx context.Context, scAddress,
+	scFunc string) (int, error) {
+
+	if scAddress == "" {
+		for address, sc := range smartcontract.ContractMap {
+			if _, ok := sc.GetExecutionStats()[scFunc]; !ok {
+				continue
+			}
+			if scAddress != "" {
+				return 0, fmt.Errorf("function %q is ambiguous, "+
+					"give smart contract address", scFunc)
+			}
+			scAddress = address
+		}
+	}
+	sc, ok := smartcontract.ContractMap[scAddress]
+	if !ok {
+		return 0, fmt.Errorf("no smart contract with function %q", scFunc)
+	}
+	if _, ok := sc.GetExecutionStats()[scFunc]; !ok {
+		return 0, fmt.Errorf("smart contract %s has no function %q",
+			scAddress, scFunc)
+	}
+
+	data, err := json.Marshal(sci.SmartContractTransactionData{
+		FunctionName: scFunc,
+		InputData:    json.RawMessage("{}"),
+	})
+	if err != nil {
+		return 0, err
+	}
+	txn := &transaction.Transaction{
+		ToClientID:      scAddress,
+		TransactionType: transaction.TxnTypeSmartContract,
+		TransactionData: string(data),
+	}
+	lfb := c.GetLatestFinalizedBlock()
+	return c.EstimateTransactionCost(ctx, lfb, lfb.ClientState, txn)
+}
+
+// transactionPoolDepth returns the number of transactions in the pool of a
+// miner, sharders have no transactions pool
+func transactionPoolDepth() int64 {
+	if node.Self.Underlying().Type != node.NodeTypeMiner {
+		return 0
+	}
+	txn, ok := transaction.Provider().(*transaction.Transaction)
+	if !ok {
+		return 0
+	}
+	transactionEntityMetadata := txn.GetEntityMetadata()
+	mstore, ok := transactionEntityMetadata.GetStore().(*memorystore.Store)
+	if !ok {
+		return 0
+	}
+	cctx := memorystore.WithEntityConnection(common.GetRootContext(), transactionEntityMetadata)
+	defer memorystore.Close(cctx)
+	return mstore.GetCollectionSize(cctx, transactionEntityMetadata, txn.GetCollectionName())
+}
+
+// FeeEstimateHandler - suggests fees to get a transaction included within the
+// target rounds
+func FeeEstimateHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	var (
+		query        = r.URL.Query()
+		targetRounds = int64(1)
+		err          error
+	)
+	if tr := query.Get("target_rounds"); tr != "" {
+		if targetRounds, err = strconv.ParseInt(tr, 10, 64); err != nil {
+			return nil, common.NewErrBadRequest("invalid target_rounds: " + err.Error())
+		}
+	}
+
+	fe, err := GetServerChain().EstimateFee(ctx, targetRounds,
+		query.Get("sc_address"), query.Get("sc_func"))
+	if err != nil {
+		return nil, common.NewErrBadRequest(err.Error())
+	}
+	return fe, nil
+}
@@ -0,0 +1,83 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/transaction"
+)
+
+func newFeeBlock(round int64, fees ...currency.Coin) *block.Block {
+	b := block.NewBlock("", round)
+	for _, fee := range fees {
+		b.Txns = append(b.Txns, &transaction.Transaction{Fee: fee})
+	}
+	return b
+}
+
+func TestFeeWindowAdd(t *testing.T) {
+	var fw feeWindow
+
+	// not full block, any tip gets in
+	fw.add(newFeeBlock(1, 500, 500), 100, 3)
+	// full block, the built-in transaction is not counted
+	fw.add(newFeeBlock(2, 500, 300, 0), 100, 3)
+	fw.add(newFeeBlock(3, 500, 300, 50), 100, 3)
+
+	tips, round := fw.minTips()
+	assert.EqualValues(t, 3, round)
+	assert.Equal(t, []currency.Coin{0, 0, 200}, tips)
+
+	for i := int64(4); i < 4+FeeEstimateWindow; i++ {
+		fw.add(newFeeBlock(i, 200, 200, 200), 100, 3)
+	}
+	tips, round = fw.minTips()
+	assert.EqualValues(t, 3+FeeEstimateWindow, round)
+	require.Len(t, tips, FeeEstimateWindow)
+	assert.EqualValues(t, 100, tips[0])
+}
+
+func TestEstimateFee(t *testing.T) {
+	var tips = []currency.Coin{0, 0, 0, 0, 0, 10, 20, 30, 40, 50}
+
+	for _, tt := range []struct {
+		name         string
+		baseFee      currency.Coin
+		minFee       currency.Coin
+		targetRounds int64
+		poolDepth    int64
+		maxBaseFee   currency.Coin
+		tips         [3]currency.Coin
+	}{
+		{"next round", 800, 0, 1, 0, 800, [3]currency.Coin{0, 30, 50}},
+		{"three rounds", 800, 0, 3, 0, 800, [3]currency.Coin{0, 0, 20}},
+		{"full blocks", 800, 0, 3, 25, 1012, [3]currency.Coin{0, 0, 20}},
+		{"pool beyond target", 800, 0, 1, 100, 900, [3]currency.Coin{0, 30, 50}},
+		{"growing zero base fee", 0, 0, 3, 100, 3, [3]currency.Coin{0, 0, 20}},
+		{"min fee", 0, 1000, 1, 0, 0, [3]currency.Coin{0, 30, 50}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := estimateFee(tips, tt.baseFee, tt.minFee, tt.targetRounds,
+				tt.poolDepth, 10, 8)
+			for i, s := range got {
+				maxFee := tt.maxBaseFee + tt.tips[i]
+				if maxFee < tt.minFee {
+					maxFee = tt.minFee
+				}
+				assert.Equal(t, FeeSuggestion{
+					Fee:         maxFee,
+					MaxFee:      maxFee,
+					PriorityFee: tt.tips[i],
+				}, s)
+			}
+		})
+	}
+
+	// no blocks finalized yet
+	got := estimateFee(nil, 100, 0, 1, 0, 10, 8)
+	assert.Equal(t, FeeSuggestion{Fee: 100, MaxFee: 100}, got[2])
+}
@@ -86,6 +86,16 @@ func handlersMap(c Chainer) map[string]func(http.ResponseWriter, *http.Request)
 				LatestBlockFeeStatsHandler,
 			),
 		)),
+		"/v1/fee/estimate": common.WithCORS(common.UserRateLimit(
+			common.ToJSONResponse(
+				FeeEstimateHandler,
+			),
+		)),
+		"/v1/chain/get/hard_forks": common.WithCORS(common.UserRateLimit(
+			common.ToJSONResponse(
+				HardForksHandler,
+			),
+		)),
 		"/": common.WithCORS(common.UserRateLimit(
 			HomePageAndNotFoundHandler,
 		)),
@@ -1384,6 +1394,27 @@ func PutTransaction(ctx context.Context, entity datastore.Entity) (interface{},
 		return nil, errors.New("invalid transaction nonce")
 	}
 
+	if txn.IsSponsored() {
+		fs, err := sc.GetStateById(sc.GetLatestFinalizedBlock().ClientState, txn.FeePayerID)
+		if !isValid(err) {
+			return nil, common.NewErrorf("fee_payer_state",
+				"can't get fee payer state: %v", err)
+		}
+		if txn.FeePayerNonce <= fs.Nonce {
+			return nil, common.NewError("invalid_fee_payer_nonce",
+				"fee payer nonce is already used")
+		}
+		if sc.ChainConfig.IsFeeEnabled() && fs.Balance < txn.FeeCap() {
+			return nil, common.NewError("insufficient_fee_payer_balance",
+				"fee payer balance is not enough to pay the transaction fee")
+		}
+	}
+
+	// hold the transaction until the transactions of the nonce gap arrive
+	if txn.Nonce > nonce+1 && !transaction.IsNoncePooled(ctx, txn.ClientID, txn.Nonce-1) {
+		return transaction.QueueTransaction(ctx, txn)
+	}
+
 	return transaction.PutTransaction(ctx, txn)
 }
 
@@ -1446,6 +1477,17 @@ func RoundInfoHandler(c Chainer) common.ReqRespHandlerf {
 		fmt.Fprintf(w, "<table>")
 		fmt.Fprintf(w, "<tr><td class='active'>Consensus</td><td class='number'>%d</td>", consensus)
 		fmt.Fprintf(w, "<tr><td class='active'>Random Seed</td><td class='number'>%d</td>", rrs)
+		var (
+			st                      = c.GetStakeTable(mb)
+			thresholdByStake        = c.ThresholdByStake()
+			thresholdByStakePercent = c.ThresholdByStakePercent()
+		)
+		if st != nil {
+			fmt.Fprintf(w, "<tr><td class='active'>Stake Threshold</td><td class='number'>%d</td>", thresholdByStake)
+			fmt.Fprintf(w, "<tr><td class='active'>Stake Threshold Percent</td><td class='number'>%d%%</td>", thresholdByStakePercent)
+			fmt.Fprintf(w, "<tr><td class='active'>Total Stake</td><td class='number'>%d</td>", st.Total)
+			fmt.Fprintf(w, "<tr><td class='active'>Stake Round</td><td class='number'>%d</td>", st.Round)
+		}
 		fmt.Fprintf(w, "</table>")
 
 		roundHasRanks := rnd != nil && rnd.HasRandomSeed()
@@ -1522,7 +1564,14 @@ func RoundInfoHandler(c Chainer) common.ReqRespHandlerf {
 			fmt.Fprintf(w, "<td style='padding: 0px;'>")
 			fmt.Fprintf(w, "<div style='display:flex;flex-direction:row;'>")
 			fmt.Fprintf(w, "  <div style='flex:1;display:flex;flex-direction:column;padding:5px;min-width:60px;'>")
-			fmt.Fprintf(w, "    <div style='flex:1;'></div><div>%d (%s)</div><div style='flex:1;'></div>", len(tickets), boolString(len(tickets) >= consensus))
+			fmt.Fprintf(w, "    <div style='flex:1;'></div><div>%d (%s)</div>", len(tickets), boolString(len(tickets) >= consensus))
+			if st != nil {
+				if stake, err := st.VerifiersStake(tickets); err == nil {
+					fmt.Fprintf(w, "<div title='verifiers stake'>%d (%s)</div>", stake,
+						boolString(st.ReachedThreshold(stake, thresholdByStake, thresholdByStakePercent)))
+				}
+			}
+			fmt.Fprintf(w, "<div style='flex:1;'></div>")
 			fmt.Fprintf(w, "  </div>")
 			if len(tickets) > 0 {
 				verifiers := make([]*node.Node, 0, len(tickets))
@@ -1566,6 +1615,17 @@ func RoundInfoHandler(c Chainer) common.ReqRespHandlerf {
 		}
 		fmt.Fprintf(w, "</table>")
 
+		if st != nil {
+			fmt.Fprintf(w, "<h3>Stake Table (magic block %d)</h3>", st.MagicBlockNumber)
+			fmt.Fprintf(w, "<table style='border-collapse: collapse;'>")
+			fmt.Fprintf(w, "<tr class='header'><th>SetIndex</th> <th>Miner</th> <th>Stake</th></tr>")
+			for _, n := range mb.Miners.CopyNodes() {
+				fmt.Fprintf(w, "<tr><td>%d</td><td>%s</td><td class='number'>%d</td></tr>",
+					n.SetIndex, getNodeLink(n), st.Stakes[n.GetKey()])
+			}
+			fmt.Fprintf(w, "</table>")
+		}
+
 		if !roundHasRanks {
 			return
 		}
@@ -20,6 +20,7 @@ import (
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
 	"0chain.net/core/encryption"
+	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/logging"
 	"github.com/0chain/common/core/util"
 	"github.com/stretchr/testify/require"
@@ -250,11 +251,20 @@ func TestRoundInfoHandler(t *testing.T) {
 
 	c.On("GetRound", int64(1)).Return(r1)
 	c.On("GetMagicBlock", int64(1)).Return(mb)
+	c.On("GetStakeTable", mb).Return(&StakeTable{
+		MagicBlockNumber: 1,
+		Stakes:           map[string]currency.Coin{n1.GetKey(): 10, n2.GetKey(): 30},
+		Total:            40,
+	})
+	c.On("ThresholdByStake").Return(0)
+	c.On("ThresholdByStakePercent").Return(67)
 
 	// call RoundInfoHandler on a round without seed and ranks
 	body := runRequest(&c)
 	require.Contains(t, body, blocksSubstring)
 	require.NotContains(t, body, vrfSubstring)
+	require.Contains(t, body, `Stake Table (magic block 1)`)
+	require.Contains(t, body, `67%`)
 
 	r1.SetRandomSeed(time.Now().UnixNano(), 2)
 
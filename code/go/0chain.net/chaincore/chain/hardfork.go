@@ -0,0 +1,45 @@
+package chain
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/0chain/common/core/logging"
+	"go.uber.org/zap"
+
+	"0chain.net/chaincore/hardfork"
+)
+
+// CheckHardForks stops the node if a hard fork active in the round is not
+// implemented by the build and warns about the unknown forks scheduled. It's
+// called on start, the new rounds are checked by the checkActiveHardForks.
+func (c *Chain) CheckHardForks(round int64) {
+	checkActiveHardForks(round)
+	for _, f := range hardfork.List(round) {
+		if !f.Known {
+			logging.Logger.Warn("unknown hard fork is scheduled, "+
+				"upgrade the node before its round",
+				zap.String("name", f.Name), zap.Int64("round", f.Round))
+		}
+	}
+}
+
+// checkActiveHardForks stops the node if a hard fork active in the round is
+// not implemented by the build, processing the blocks of the round by the
+// old rules would diverge from the other nodes.
+func checkActiveHardForks(round int64) {
+	if err := hardfork.CheckKnown(round); err != nil {
+		logging.Logger.Fatal("the build doesn't support active hard fork, "+
+			"upgrade the node", zap.Int64("round", round), zap.Error(err))
+	}
+}
+
+// HardForksHandler - returns the scheduled hard forks, active of them are
+// given for the latest finalized round
+func HardForksHandler(ctx context.Context, r *http.Request) (interface{}, error) {
+	var round int64
+	if lfb := GetServerChain().GetLatestFinalizedBlock(); lfb != nil {
+		round = lfb.Round
+	}
+	return hardfork.List(round), nil
+}
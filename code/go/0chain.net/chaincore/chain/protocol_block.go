@@ -10,10 +10,11 @@ import (
 	"0chain.net/chaincore/block"
 	"0chain.net/chaincore/config"
 	"0chain.net/chaincore/node"
+	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
 	"0chain.net/core/encryption"
-	"0chain.net/core/maths"
+	"0chain.net/smartcontract/minersc"
 	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/logging"
 	"github.com/0chain/common/core/util"
@@ -182,7 +183,6 @@ func (c *Chain) reachedNotarization(round int64, hash string,
 		mb        = c.GetMagicBlock(round)
 		num       = mb.Miners.Size()
 		threshold = c.GetNotarizationThresholdCount(num)
-		err       error
 	)
 
 	if c.ThresholdByCount() > 0 {
@@ -198,21 +198,28 @@ func (c *Chain) reachedNotarization(round int64, hash string,
 			return false
 		}
 	}
-	if c.ThresholdByStake() > 0 {
-		verifiersStake := uint64(0)
-		for _, ticket := range bvt {
-			verifiersStake, err = maths.SafeAddUInt64(verifiersStake, c.getMiningStake(ticket.VerifierID))
-			if err != nil {
-				logging.Logger.Error("reached_notarization", zap.Error(err))
-				return false
-			}
+	if c.ThresholdByStake() > 0 || c.ThresholdByStakePercent() > 0 {
+		// the block can't be notarized without knowing the stakes
+		st := c.GetStakeTable(mb)
+		if st == nil {
+			logging.Logger.Error("not reached notarization - no stake table",
+				zap.Int64("mb_sr", mb.StartingRound),
+				zap.Int64("round", round))
+			return false
+		}
+		verifiersStake, err := st.VerifiersStake(bvt)
+		if err != nil {
+			logging.Logger.Error("reached_notarization", zap.Error(err))
+			return false
 		}
 
-		if verifiersStake < uint64(c.ThresholdByStake()) {
+		if !st.ReachedThreshold(verifiersStake, c.ThresholdByStake(), c.ThresholdByStakePercent()) {
 			logging.Logger.Info("not reached notarization - stake < threshold stake",
 				zap.Int64("mb_sr", mb.StartingRound),
 				zap.Uint64("verify stake", verifiersStake),
+				zap.Any("total stake", st.Total),
 				zap.Int("threshold", c.ThresholdByStake()),
+				zap.Int("threshold percent", c.ThresholdByStakePercent()),
 				zap.Int("active_miners", num),
 				zap.Int("num_signatures", len(bvt)),
 				zap.Int("signature threshold", threshold),
@@ -398,6 +405,7 @@ func (c *Chain) finalizeBlock(ctx context.Context, fb *block.Block, bsh BlockSta
 			return err
 		}
 		c.SetLatestFinalizedMagicBlock(fb)
+		c.UpdateStakeTable(fb.MagicBlock, fb)
 	}
 
 	wg.Run("finalize block - update finalized block", fb.Round, func() {
@@ -821,17 +829,39 @@ func (c *Chain) commonAncestor(ctx context.Context, b1 *block.Block, b2 *block.B
 	return b1
 }
 
+// GetNextBaseFee returns the base fee transactions of the block next to the
+// given one pay
+func (c *Chain) GetNextBaseFee(b *block.Block) (currency.Coin, error) {
+	fm := &minersc.FeeMarket{}
+	switch err := c.GetBlockStateNode(b, minersc.FeeMarketKey, fm); err {
+	case nil, util.ErrValueNotPresent:
+		return fm.BaseFee, nil
+	default:
+		return 0, err
+	}
+}
+
 func (c *Chain) updateFeeStats(fb *block.Block) error {
 	var (
 		totalFees currency.Coin
+		baseFee   = c.FeeStats.BaseFee // the base fee of the finalized block
 		err       error
 	)
+	if c.ChainConfig.IsFeeEnabled() {
+		nextBaseFee, err := c.GetNextBaseFee(fb)
+		if err != nil {
+			return err
+		}
+		c.FeeStats.BaseFee = nextBaseFee
+		transaction.SetLatestBaseFee(nextBaseFee)
+	}
+	c.feeWindow.add(fb, baseFee, c.ChainConfig.BlockSize())
 	if len(fb.Txns) == 0 {
 		return nil
 	}
 
 	for _, txn := range fb.Txns {
-		totalFees, err = currency.AddCoin(totalFees, txn.Fee)
+		totalFees, err = currency.AddCoin(totalFees, txn.EffectiveFee(baseFee))
 		if err != nil {
 			return err
 		}
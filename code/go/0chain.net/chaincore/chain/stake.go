@@ -0,0 +1,178 @@
+package chain
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/logging"
+	"github.com/0chain/common/core/util"
+	"go.uber.org/zap"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/core/datastore"
+	"0chain.net/core/maths"
+	"0chain.net/smartcontract/minersc"
+)
+
+// maxStakeTables is the number of the latest magic blocks the stake tables
+// are cached for
+const maxStakeTables = 4
+
+// StakeTable is the stake of the miners of a magic block taken from the
+// miner SC stake pools at the view change boundary
+type StakeTable struct {
+	MagicBlockNumber int64                           `json:"magic_block_number"`
+	StartingRound    int64                           `json:"starting_round"`
+	Round            int64                           `json:"round"` // round of the state the stakes are taken from
+	Stakes           map[datastore.Key]currency.Coin `json:"stakes"`
+	Total            currency.Coin                   `json:"total"`
+}
+
+// VerifiersStake returns the total stake of the verifiers of the tickets,
+// a verifier is counted once
+func (st *StakeTable) VerifiersStake(bvt []*block.VerificationTicket) (uint64, error) {
+	var (
+		stake    uint64
+		err      error
+		verified = make(map[datastore.Key]struct{}, len(bvt))
+	)
+	for _, ticket := range bvt {
+		if _, ok := verified[ticket.VerifierID]; ok {
+			continue
+		}
+		verified[ticket.VerifierID] = struct{}{}
+		stake, err = maths.SafeAddUInt64(stake, uint64(st.Stakes[ticket.VerifierID]))
+		if err != nil {
+			return 0, err
+		}
+	}
+	return stake, nil
+}
+
+// ReachedThreshold reports the stake is at least the given absolute stake
+// and the given percent of the total stake, a zero threshold isn't checked.
+// The percent can't be checked for the miners having no stake at all, the
+// count threshold only decides then.
+func (st *StakeTable) ReachedThreshold(stake uint64, byStake, percent int) bool {
+	if byStake > 0 && stake < uint64(byStake) {
+		return false
+	}
+	if percent <= 0 || st.Total == 0 {
+		return true
+	}
+	var (
+		left  = new(big.Int).Mul(new(big.Int).SetUint64(stake), big.NewInt(100))
+		right = new(big.Int).Mul(new(big.Int).SetUint64(uint64(st.Total)), big.NewInt(int64(percent)))
+	)
+	return left.Cmp(right) >= 0
+}
+
+// newStakeTable takes the stakes of the miners of the magic block from the
+// miner SC state of the block
+func (c *Chain) newStakeTable(mb *block.MagicBlock, b *block.Block) (*StakeTable, error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	state := c.blockState(b)
+	st := &StakeTable{
+		MagicBlockNumber: mb.MagicBlockNumber,
+		StartingRound:    mb.StartingRound,
+		Round:            b.Round,
+		Stakes:           make(map[datastore.Key]currency.Coin, mb.Miners.Size()),
+	}
+	for _, id := range mb.Miners.Keys() {
+		mn := minersc.NewMinerNode()
+		mn.ID = id
+		err := state.GetNodeValue(getNodePath(mn.GetKey()), mn)
+		if errors.Is(err, util.ErrValueNotPresent) {
+			st.Stakes[id] = 0
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		st.Stakes[id] = mn.TotalStaked
+		if st.Total, err = currency.AddCoin(st.Total, mn.TotalStaked); err != nil {
+			return nil, err
+		}
+	}
+	return st, nil
+}
+
+// blockState returns the state of the block, the state of a block loaded
+// from the store is read by the state root from the state DB
+func (c *Chain) blockState(b *block.Block) util.MerklePatriciaTrieI {
+	if b.ClientState != nil {
+		return CreateTxnMPT(b.ClientState)
+	}
+	return util.NewMerklePatriciaTrie(c.stateDB, util.Sequence(b.Round), b.ClientStateHash)
+}
+
+// UpdateStakeTable takes the stake table of the magic block from the state of
+// the block of the view change boundary
+func (c *Chain) UpdateStakeTable(mb *block.MagicBlock, b *block.Block) {
+	st, err := c.newStakeTable(mb, b)
+	if err != nil {
+		logging.Logger.Error("update stake table",
+			zap.Int64("mb_number", mb.MagicBlockNumber),
+			zap.Int64("round", b.Round),
+			zap.Error(err))
+		return
+	}
+	c.setStakeTable(st)
+	logging.Logger.Info("update stake table",
+		zap.Int64("mb_number", mb.MagicBlockNumber),
+		zap.Int64("mb_sr", mb.StartingRound),
+		zap.Int64("round", b.Round),
+		zap.Any("total", st.Total))
+}
+
+func (c *Chain) setStakeTable(st *StakeTable) {
+	c.stakeMutex.Lock()
+	defer c.stakeMutex.Unlock()
+
+	c.stakeTables[st.StartingRound] = st
+	if len(c.stakeTables) <= maxStakeTables {
+		return
+	}
+	rounds := make([]int64, 0, len(c.stakeTables))
+	for sr := range c.stakeTables {
+		rounds = append(rounds, sr)
+	}
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i] < rounds[j] })
+	for _, sr := range rounds[:len(rounds)-maxStakeTables] {
+		delete(c.stakeTables, sr)
+	}
+}
+
+// GetStakeTable returns the stake table of the magic block. The stakes are
+// taken from the state of the finalized block of the magic block only, so
+// all the miners get the same table; nil is returned if the state of the
+// block isn't available.
+func (c *Chain) GetStakeTable(mb *block.MagicBlock) *StakeTable {
+	c.stakeMutex.Lock()
+	st, ok := c.stakeTables[mb.StartingRound]
+	c.stakeMutex.Unlock()
+	if ok {
+		return st
+	}
+
+	c.lfmbMutex.RLock()
+	b := c.magicBlockStartingRounds[mb.StartingRound]
+	c.lfmbMutex.RUnlock()
+	if b == nil || b.MagicBlock == nil || b.MagicBlock.Hash != mb.Hash {
+		return nil
+	}
+	st, err := c.newStakeTable(mb, b)
+	if err != nil {
+		logging.Logger.Error("get stake table",
+			zap.Int64("mb_number", mb.MagicBlockNumber),
+			zap.Int64("round", b.Round),
+			zap.Error(err))
+		return nil
+	}
+	c.setStakeTable(st)
+	return st
+}
@@ -0,0 +1,111 @@
+package chain
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/node"
+	"0chain.net/smartcontract/minersc"
+)
+
+func TestStakeTable(t *testing.T) {
+	st := &StakeTable{
+		Stakes: map[string]currency.Coin{"a": 10, "b": 30, "c": 60},
+		Total:  100,
+	}
+	tickets := func(ids ...string) (bvt []*block.VerificationTicket) {
+		for _, id := range ids {
+			bvt = append(bvt, &block.VerificationTicket{VerifierID: id})
+		}
+		return
+	}
+
+	stake, err := st.VerifiersStake(tickets("a", "b"))
+	require.NoError(t, err)
+	require.EqualValues(t, 40, stake)
+	require.False(t, st.ReachedThreshold(stake, 0, 67))
+
+	// a verifier is counted once, unknown verifiers have no stake
+	stake, err = st.VerifiersStake(tickets("a", "c", "a", "x"))
+	require.NoError(t, err)
+	require.EqualValues(t, 70, stake)
+	require.True(t, st.ReachedThreshold(stake, 0, 67))
+	require.True(t, st.ReachedThreshold(stake, 0, 70))
+	require.False(t, st.ReachedThreshold(stake, 0, 71))
+
+	// the absolute stake threshold
+	require.True(t, st.ReachedThreshold(stake, 70, 0))
+	require.False(t, st.ReachedThreshold(stake, 71, 0))
+	require.False(t, st.ReachedThreshold(stake, 71, 67))
+
+	// the percent can't be checked without stake, the absolute stake can
+	empty := &StakeTable{}
+	require.True(t, empty.ReachedThreshold(0, 0, 67))
+	require.False(t, empty.ReachedThreshold(0, 1, 67))
+}
+
+func TestGetStakeTable(t *testing.T) {
+	var (
+		mb    = block.NewMagicBlock()
+		b     = block.NewBlock("", 10)
+		state = util.NewMerklePatriciaTrie(util.NewMemoryNodeDB(), 0, nil)
+		c     = &Chain{
+			stakeTables:              make(map[int64]*StakeTable),
+			stakeMutex:               &sync.Mutex{},
+			stateMutex:               &sync.RWMutex{},
+			magicBlockStartingRounds: make(map[int64]*block.Block),
+		}
+	)
+	mb.Hash = "mb"
+	mb.StartingRound = 15
+	mb.Miners = node.NewPool(node.NodeTypeMiner)
+	for i, stake := range []currency.Coin{10, 30, 0} {
+		n, err := makeTestNode()
+		require.NoError(t, err)
+		mb.Miners.AddNode(n)
+		if i == 2 {
+			continue // not registered in the miner SC
+		}
+		mn := minersc.NewMinerNode()
+		mn.ID = n.GetKey()
+		mn.TotalStaked = stake
+		_, err = state.Insert(getNodePath(mn.GetKey()), mn)
+		require.NoError(t, err)
+	}
+
+	// the magic block isn't finalized
+	require.Nil(t, c.GetStakeTable(mb))
+
+	// the stakes are taken from the state of the magic block's block
+	b.MagicBlock = mb
+	b.ClientState = state
+	c.magicBlockStartingRounds[mb.StartingRound] = b
+	st := c.GetStakeTable(mb)
+	require.NotNil(t, st)
+	require.EqualValues(t, 40, st.Total)
+	require.EqualValues(t, 10, st.Round)
+	require.Len(t, st.Stakes, 3)
+	require.Equal(t, st, c.stakeTables[mb.StartingRound])
+}
+
+func TestSetStakeTable(t *testing.T) {
+	c := &Chain{
+		stakeTables: make(map[int64]*StakeTable),
+		stakeMutex:  &sync.Mutex{},
+	}
+	for sr := int64(0); sr < maxStakeTables+2; sr++ {
+		c.setStakeTable(&StakeTable{StartingRound: sr * 100})
+	}
+	require.Len(t, c.stakeTables, maxStakeTables)
+	require.NotContains(t, c.stakeTables, int64(0))
+	require.NotContains(t, c.stakeTables, int64(100))
+
+	mb := block.NewMagicBlock()
+	mb.StartingRound = 500
+	require.Equal(t, c.stakeTables[500], c.GetStakeTable(mb))
+}
@@ -25,6 +25,8 @@ import (
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
 	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/schedulersc"
+	"0chain.net/smartcontract/storagesc"
 	"github.com/0chain/common/core/logging"
 	"github.com/0chain/common/core/util"
 )
@@ -203,7 +205,7 @@ func (c *Chain) EstimateTransactionCost(ctx context.Context,
 
 	switch txn.TransactionType {
 
-	case transaction.TxnTypeSmartContract:
+	case transaction.TxnTypeSmartContract, transaction.TxnTypeMultiCall:
 		var scData sci.SmartContractTransactionData
 		dataBytes := []byte(txn.TransactionData)
 		err := json.Unmarshal(dataBytes, &scData)
@@ -296,14 +298,32 @@ func (c *Chain) updateState(ctx context.Context, b *block.Block, bState util.Mer
 	if err = c.validateNonce(sctx, txn.ClientID, txn.Nonce); err != nil {
 		return nil, err
 	}
+	if txn.IsSponsored() {
+		if err = c.validateNonce(sctx, txn.FeePayerID, txn.FeePayerNonce); err != nil {
+			return nil, err
+		}
+	}
 
 	// checks if the client has enough funds to pay for transaction before heavy computations are executed
 	if err = sctx.Validate(); err != nil {
 		return nil, err
 	}
 
+	var baseFee currency.Coin
+	if c.ChainConfig.IsFeeEnabled() {
+		if baseFee, err = minersc.GetBaseFee(sctx); err != nil {
+			return nil, err
+		}
+		// a block with a transaction underpaying the base fee is invalid
+		if !isBuildInTxn(b, txn) {
+			if err = txn.ValidateBaseFee(c.ChainConfig.TxnExempt(), baseFee); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	switch txn.TransactionType {
-	case transaction.TxnTypeSmartContract:
+	case transaction.TxnTypeSmartContract, transaction.TxnTypeMultiCall:
 		var (
 			scData    sci.SmartContractTransactionData
 			dataBytes = []byte(txn.TransactionData)
@@ -314,6 +334,10 @@ func (c *Chain) updateState(ctx context.Context, b *block.Block, bState util.Mer
 				zap.String("input", txn.TransactionData), zap.Error(err))
 			return nil, err
 		}
+		if txn.TransactionType == transaction.TxnTypeMultiCall {
+			// the calls are decoded by the multi-call execution
+			scData = sci.SmartContractTransactionData{FunctionName: smartcontract.MultiCallFunctionName}
+		}
 
 		t := time.Now()
 		output, err := c.ExecuteSmartContract(ctx, txn, &scData, sctx)
@@ -405,13 +429,16 @@ func (c *Chain) updateState(ctx context.Context, b *block.Block, bState util.Mer
 	}
 
 	if c.ChainConfig.IsFeeEnabled() {
-		err = sctx.AddTransfer(state.NewTransfer(txn.ClientID, minersc.ADDRESS, txn.Fee))
+		// the base fee part is burned, the tip is paid by the miner SC
+		fee := txn.EffectiveFee(baseFee)
+		err = sctx.AddTransfer(state.NewTransfer(txn.FeeClientID(), minersc.ADDRESS, fee))
 		if err != nil {
 			logging.Logger.Error("Failed to add transfer",
 				zap.Int("txn type", txn.TransactionType),
 				zap.String("transaction_ClientID", txn.ClientID),
+				zap.String("fee_ClientID", txn.FeeClientID()),
 				zap.String("minersc_address", minersc.ADDRESS),
-				zap.Any("state_balance", txn.Fee))
+				zap.Any("state_balance", fee))
 			return nil, err
 		}
 	}
@@ -474,6 +501,20 @@ func (c *Chain) updateState(ctx context.Context, b *block.Block, bState util.Mer
 		ue[u.UserID] = u
 	}
 
+	// the fee payer nonce is used up by the sponsored transaction
+	if txn.IsSponsored() {
+		u, err := c.incrementNonce(sctx, txn.FeePayerID)
+		if err != nil {
+			logging.Logger.Error("update fee payer nonce error", zap.Error(err),
+				zap.Any("transaction", txn),
+				zap.String("fee_payer_id", txn.FeePayerID))
+			return nil, err
+		}
+		if u != nil {
+			ue[u.UserID] = u
+		}
+	}
+
 	for _, e := range ue {
 		c.emitUserEvent(sctx, e)
 	}
@@ -624,6 +665,35 @@ func (c *Chain) mintAmount(sctx bcstate.StateContextI, toClient datastore.Key, a
 	return stateToUser(toClient, ts, amount), nil
 }
 
+// buildInTxnFunctions are the functions of the transactions the generator
+// adds to every block by the smart contract, those transactions pay no fee
+var buildInTxnFunctions = map[string]map[string]bool{
+	minersc.ADDRESS: {"payFees": true},
+	storagesc.ADDRESS: {
+		"generate_challenge":      true,
+		"blobber_block_rewards":   true,
+		"commit_settings_changes": true,
+	},
+	schedulersc.ADDRESS: {"execute": true},
+}
+
+// isBuildInTxn reports the transaction is one of the transactions the
+// generator of the block adds to it
+func isBuildInTxn(b *block.Block, txn *transaction.Transaction) bool {
+	if txn.ClientID != b.MinerID || txn.TransactionType != transaction.TxnTypeSmartContract {
+		return false
+	}
+	functions, ok := buildInTxnFunctions[txn.ToClientID]
+	if !ok {
+		return false
+	}
+	var scData sci.SmartContractTransactionData
+	if err := json.Unmarshal([]byte(txn.TransactionData), &scData); err != nil {
+		return false
+	}
+	return functions[scData.FunctionName]
+}
+
 func (c *Chain) validateNonce(sctx bcstate.StateContextI, fromClient datastore.Key, txnNonce int64) error {
 	s, err := sctx.GetClientState(fromClient)
 	if !isValid(err) {
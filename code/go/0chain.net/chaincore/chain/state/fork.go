@@ -0,0 +1,50 @@
+package state
+
+import (
+	"errors"
+
+	"github.com/0chain/common/core/util"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/transaction"
+)
+
+// Fork is an in-memory copy of the state of a state context. Smart contract
+// code can run against the fork, e.g. to simulate future rounds, the changes
+// are never persisted and the events emitted are never processed.
+type Fork struct {
+	base  StateContextI
+	state util.MerklePatriciaTrieI
+}
+
+// NewFork forks the state of the given state context, it can be a state
+// context of a transaction or a query state context of a REST handler.
+func NewFork(sctx CommonStateContextI) (*Fork, error) {
+	base, ok := sctx.(StateContextI)
+	if !ok {
+		return nil, errors.New("the state context can't be forked")
+	}
+	var s = base.GetState()
+	if s == nil {
+		return nil, errors.New("the state context has no state")
+	}
+	var ndb = util.NewLevelNodeDB(util.NewMemoryNodeDB(), s.GetNodeDB(), false)
+	return &Fork{
+		base:  base,
+		state: util.NewMerklePatriciaTrie(ndb, s.GetVersion(), s.GetRoot()),
+	}, nil
+}
+
+// StateContext returns a new state context over the forked state for the
+// given block and transaction. State contexts of a fork share the state, so
+// changes made through one are seen by the next ones.
+func (f *Fork) StateContext(b *block.Block, t *transaction.Transaction) StateContextI {
+	return NewStateContext(b, f.state, t,
+		f.base.GetMagicBlock,
+		f.base.GetLastestFinalizedMagicBlock,
+		f.base.GetChainCurrentMagicBlock,
+		f.base.GetSignatureScheme,
+		f.base.GetLatestFinalizedBlock,
+		nil,
+	)
+}
@@ -0,0 +1,51 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+)
+
+func newTestState(balance currency.Coin) *state.State {
+	var s = &state.State{Balance: balance}
+	s.SetTxnHash("0000000000000000000000000000000000000000000000000000000000000000")
+	return s
+}
+
+func TestFork(t *testing.T) {
+	var (
+		b    = &block.Block{}
+		txn  = &transaction.Transaction{}
+		mpt  = util.NewMerklePatriciaTrie(util.NewMemoryNodeDB(), 0, nil)
+		base = NewStateContext(b, mpt, txn, nil, nil, nil, nil, nil, nil)
+	)
+	_, err := base.InsertTrieNode("key", newTestState(1))
+	require.NoError(t, err)
+
+	fork, err := NewFork(base)
+	require.NoError(t, err)
+
+	var (
+		forked = fork.StateContext(b, txn)
+		st     = &state.State{}
+	)
+	require.NoError(t, forked.GetTrieNode("key", st))
+	require.Equal(t, currency.Coin(1), st.Balance)
+	_, err = forked.InsertTrieNode("key", newTestState(2))
+	require.NoError(t, err)
+
+	// the changes are seen by the next state contexts of the fork only
+	require.NoError(t, fork.StateContext(b, txn).GetTrieNode("key", st))
+	require.Equal(t, currency.Coin(2), st.Balance)
+	require.NoError(t, base.GetTrieNode("key", st))
+	require.Equal(t, currency.Coin(1), st.Balance)
+
+	_, err = NewFork(NewStateContext(b, nil, txn, nil, nil, nil, nil, nil, nil))
+	require.EqualError(t, err, "the state context has no state")
+}
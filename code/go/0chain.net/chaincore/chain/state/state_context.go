@@ -204,11 +204,35 @@ func (sc *StateContext) GetTransaction() *transaction.Transaction {
 	return sc.txn
 }
 
+// Caller is implemented by the state contexts able to execute calls on behalf
+// of other transactions.
+type Caller interface {
+	Call(t *transaction.Transaction, f func() error) error
+}
+
+// Call runs f with the state context associated with the given transaction,
+// so the transfers of f are validated against it. The transfers, mints and
+// events of f are kept in the state context.
+func (sc *StateContext) Call(t *transaction.Transaction, f func() error) error {
+	sc.mutex.Lock()
+	var txn = sc.txn
+	sc.txn = t
+	sc.mutex.Unlock()
+
+	defer func() {
+		sc.mutex.Lock()
+		sc.txn = txn
+		sc.mutex.Unlock()
+	}()
+	return f()
+}
+
 // AddTransfer - add the transfer
 func (sc *StateContext) AddTransfer(t *state.Transfer) error {
 	sc.mutex.Lock()
 	defer sc.mutex.Unlock()
-	if t.ClientID != sc.txn.ClientID && t.ClientID != sc.txn.ToClientID {
+	if t.ClientID != sc.txn.ClientID && t.ClientID != sc.txn.ToClientID &&
+		(!sc.txn.IsSponsored() || t.ClientID != sc.txn.FeePayerID) {
 		return state.ErrInvalidTransfer
 	}
 	sc.transfers = append(sc.transfers, t)
@@ -345,30 +369,37 @@ func (sc *StateContext) GetEventDB() *event.EventDb {
 // Validate - implement interface
 func (sc *StateContext) Validate() error {
 	var (
-		amount currency.Coin
-		err    error
+		amount    currency.Coin
+		feeAmount currency.Coin
+		err       error
 	)
 	for _, transfer := range sc.transfers {
-		if transfer.ClientID == sc.txn.ClientID {
+		switch {
+		case transfer.ClientID == sc.txn.ClientID:
 			amount, err = currency.AddCoin(amount, transfer.Amount)
-			if err != nil {
-				return err
-			}
-		} else {
-			if transfer.ClientID != sc.txn.ToClientID {
-				return state.ErrInvalidTransfer
-			}
+		case sc.txn.IsSponsored() && transfer.ClientID == sc.txn.FeePayerID:
+			feeAmount, err = currency.AddCoin(feeAmount, transfer.Amount)
+		case transfer.ClientID != sc.txn.ToClientID:
+			return state.ErrInvalidTransfer
+		}
+		if err != nil {
+			return err
 		}
 	}
 
-	totalValue := sc.txn.Value
+	// the fee payer of a sponsored transaction transfers the fee only
+	var (
+		totalValue = sc.txn.Value
+		feeValue   currency.Coin
+	)
 	if config.Configuration().ChainConfig.IsFeeEnabled() {
-		totalValue, err = currency.AddCoin(totalValue, sc.txn.Fee)
-		if err != nil {
+		if sc.txn.IsSponsored() {
+			feeValue = sc.txn.FeeCap()
+		} else if totalValue, err = currency.AddCoin(totalValue, sc.txn.FeeCap()); err != nil {
 			return err
 		}
 	}
-	if amount > totalValue {
+	if amount > totalValue || feeAmount > feeValue {
 		return state.ErrInvalidTransfer
 	}
 
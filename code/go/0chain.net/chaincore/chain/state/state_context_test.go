@@ -8,6 +8,11 @@ import (
 	"github.com/0chain/common/core/logging"
 	"github.com/0chain/common/core/util"
 	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/config"
+	"0chain.net/chaincore/config/mocks"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
 )
 
 func init() {
@@ -187,3 +192,42 @@ func TestWrongGetItemFunc(t *testing.T) {
 	//}, nil)
 	//require.NoError(t, err)
 }
+
+func TestValidateSponsoredTransfers(t *testing.T) {
+	conf := mocks.NewChainConfig(t)
+	conf.On("IsFeeEnabled").Return(true)
+	config.Configuration().ChainConfig = conf
+
+	newContext := func(feePayerID string) *StateContext {
+		txn := &transaction.Transaction{
+			ClientID:   "client",
+			ToClientID: "to_client",
+			Value:      100,
+			Fee:        10,
+			FeePayerID: feePayerID,
+		}
+		return NewStateContext(nil, nil, txn, nil, nil, nil, nil, nil, nil)
+	}
+
+	// the client pays the value and the fee
+	sc := newContext("")
+	require.NoError(t, sc.AddTransfer(state.NewTransfer("client", "to_client", 100)))
+	require.NoError(t, sc.AddTransfer(state.NewTransfer("client", "miner_sc", 10)))
+	require.NoError(t, sc.Validate())
+	require.Equal(t, state.ErrInvalidTransfer,
+		sc.AddTransfer(state.NewTransfer("fee_payer", "miner_sc", 10)))
+
+	// the fee payer pays the fee only
+	sc = newContext("fee_payer")
+	require.NoError(t, sc.AddTransfer(state.NewTransfer("client", "to_client", 100)))
+	require.NoError(t, sc.AddTransfer(state.NewTransfer("fee_payer", "miner_sc", 10)))
+	require.NoError(t, sc.Validate())
+
+	sc = newContext("fee_payer")
+	require.NoError(t, sc.AddTransfer(state.NewTransfer("client", "miner_sc", 110)))
+	require.Equal(t, state.ErrInvalidTransfer, sc.Validate())
+
+	sc = newContext("fee_payer")
+	require.NoError(t, sc.AddTransfer(state.NewTransfer("fee_payer", "to_client", 11)))
+	require.Equal(t, state.ErrInvalidTransfer, sc.Validate())
+}
@@ -0,0 +1,53 @@
+// Package statetest runs smart contract transactions on an in-memory state
+// in tests.
+package statetest
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/util"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+)
+
+// Chain keeps the in-memory state the transactions run on, the state
+// changes of a transaction are visible to the following ones
+type Chain struct {
+	T   *testing.T
+	mpt util.MerklePatriciaTrieI
+}
+
+// NewChain returns a chain with an empty state for the test
+func NewChain(t *testing.T) *Chain {
+	return &Chain{
+		T:   t,
+		mpt: util.NewMerklePatriciaTrie(util.NewMemoryNodeDB(), 0, nil),
+	}
+}
+
+// Context returns state context of the transaction in a block of the round
+// created at the now
+func (c *Chain) Context(round int64, now common.Timestamp,
+	txn *transaction.Transaction) *state.StateContext {
+
+	var b = &block.Block{}
+	b.Round = round
+	b.CreationDate = now
+	return state.NewStateContext(b, c.mpt, txn, nil, nil, nil, nil, nil, nil)
+}
+
+// ContextAt returns state context of the transaction in a block of the
+// round equal to the now
+func (c *Chain) ContextAt(now common.Timestamp,
+	txn *transaction.Transaction) *state.StateContext {
+
+	return c.Context(int64(now), now, txn)
+}
+
+// State returns the state of the chain
+func (c *Chain) State() util.MerklePatriciaTrieI {
+	return c.mpt
+}
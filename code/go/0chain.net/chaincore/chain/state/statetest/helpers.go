@@ -0,0 +1,60 @@
+package statetest
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+)
+
+// Owner is the owner of the smart contracts configured in tests
+const Owner = "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745802"
+
+func randString(n int) string {
+	const hexLetters = "abcdef0123456789"
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		sb.WriteByte(hexLetters[rand.Intn(len(hexLetters))])
+	}
+	return sb.String()
+}
+
+// NewTransaction returns a transaction of the value created at the now,
+// the hash is random
+func NewTransaction(from, to string, value currency.Coin,
+	now common.Timestamp) *transaction.Transaction {
+
+	var tx = new(transaction.Transaction)
+	tx.Hash = randString(64)
+	tx.ClientID = from
+	tx.ToClientID = to
+	tx.Value = value
+	tx.CreationDate = now
+	return tx
+}
+
+// RequireErrMsg requires the error with the message, or no error if the
+// message is empty
+func RequireErrMsg(t *testing.T, err error, msg string) {
+	t.Helper()
+	if msg == "" {
+		require.Nil(t, err)
+	} else {
+		require.NotNil(t, err)
+		require.Equal(t, msg, err.Error())
+	}
+}
+
+// MustEncode returns JSON of the value
+func MustEncode(t *testing.T, val interface{}) (b []byte) {
+	var err error
+	b, err = json.Marshal(val)
+	require.NoError(t, err)
+	return
+}
@@ -0,0 +1,41 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/storagesc"
+)
+
+func TestIsBuildInTxn(t *testing.T) {
+	b := block.NewBlock("", 1)
+	b.MinerID = "generator"
+
+	newTxn := func(clientID, toClientID, name string) *transaction.Transaction {
+		return &transaction.Transaction{
+			ClientID:        clientID,
+			ToClientID:      toClientID,
+			TransactionType: transaction.TxnTypeSmartContract,
+			TransactionData: `{"name":"` + name + `","input":{}}`,
+		}
+	}
+	for _, tt := range []struct {
+		name string
+		txn  *transaction.Transaction
+		want bool
+	}{
+		{"pay fees", newTxn("generator", minersc.ADDRESS, "payFees"), true},
+		{"challenge", newTxn("generator", storagesc.ADDRESS, "generate_challenge"), true},
+		{"other client", newTxn("client", minersc.ADDRESS, "payFees"), false},
+		{"other function", newTxn("generator", minersc.ADDRESS, "add_miner"), false},
+		{"other SC", newTxn("generator", storagesc.ADDRESS, "payFees"), false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isBuildInTxn(b, tt.txn))
+		})
+	}
+}
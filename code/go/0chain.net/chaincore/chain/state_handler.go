@@ -12,8 +12,12 @@ import (
 	"strings"
 
 	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/governancesc"
+	"0chain.net/smartcontract/htlcsc"
 	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/paychansc"
 	"0chain.net/smartcontract/rest"
+	"0chain.net/smartcontract/schedulersc"
 	"0chain.net/smartcontract/storagesc"
 	"0chain.net/smartcontract/vestingsc"
 	"0chain.net/smartcontract/zcnsc"
@@ -53,6 +57,10 @@ func SetupScRestApiHandlers() {
 		storagesc.SetupRestHandler(restHandler)
 		vestingsc.SetupRestHandler(restHandler)
 		zcnsc.SetupRestHandler(restHandler)
+		governancesc.SetupRestHandler(restHandler)
+		schedulersc.SetupRestHandler(restHandler)
+		htlcsc.SetupRestHandler(restHandler)
+		paychansc.SetupRestHandler(restHandler)
 
 	} else {
 		logging.Logger.Warn("cannot find event database, REST API will not be supported on this sharder")
@@ -201,6 +209,14 @@ func GetFunctionNames(address string) []string {
 		endpoints = vestingsc.GetEndpoints(nil)
 	case zcnsc.ADDRESS:
 		endpoints = zcnsc.GetEndpoints(nil)
+	case governancesc.ADDRESS:
+		endpoints = governancesc.GetEndpoints(nil)
+	case schedulersc.ADDRESS:
+		endpoints = schedulersc.GetEndpoints(nil)
+	case htlcsc.ADDRESS:
+		endpoints = htlcsc.GetEndpoints(nil)
+	case paychansc.ADDRESS:
+		endpoints = paychansc.GetEndpoints(nil)
 	default:
 		return []string{}
 	}
@@ -39,6 +39,7 @@ func SetupDefaultConfig() {
 	viper.SetDefault("server_chain.stuck.check_interval", 10)
 	viper.SetDefault("server_chain.stuck.time_threshold", 60)
 	viper.SetDefault("server_chain.transaction.timeout", 30)
+	viper.SetDefault("server_chain.transaction.max_pending_per_client", 16)
 	viper.SetDefault("server_chain.block.generation.retry_wait_time", 5)
 	viper.SetDefault("server_chain.block.proposal.max_wait_time", "200ms")
 	viper.SetDefault("server_chain.block.proposal.wait_mode", "static")
@@ -160,6 +161,7 @@ type ChainConfig interface {
 	IsVestingEnabled() bool
 	IsZcnEnabled() bool
 	OwnerID() datastore.Key
+	BlockSize() int32
 	MinBlockSize() int32
 	MaxBlockCost() int
 	MaxByteSize() int64
@@ -168,6 +170,7 @@ type ChainConfig interface {
 	NumReplicators() int
 	ThresholdByCount() int
 	ThresholdByStake() int
+	ThresholdByStakePercent() int
 	ValidationBatchSize() int
 	TxnMaxPayload() int
 	PruneStateBelowCount() int
@@ -178,6 +181,7 @@ type ChainConfig interface {
 	HCCycleScan() [2]HealthCheckCycleScan
 	BlockProposalMaxWaitTime() time.Duration
 	BlockProposalWaitMode() int8
+	BlockProposalPipelining() bool
 	ReuseTransactions() bool
 	ClientSignatureScheme() string
 	MinActiveSharders() int
@@ -194,6 +198,8 @@ type ChainConfig interface {
 	TxnExempt() map[string]bool
 	MinTxnFee() currency.Coin
 	TxnTransferCost() int
+	BaseFeeChangeDenominator() int
+	BaseFeeTargetFullness() float64
 }
 
 type DbAccess struct {
@@ -350,6 +356,12 @@ func GetThresholdCount() int {
 	return viper.GetInt("server_chain.block.consensus.threshold_by_count")
 }
 
+// RewardsSimulation reports whether the node serves the rewards simulations,
+// a simulation pays rewards of many rounds against a fork of the state
+func RewardsSimulation() bool {
+	return viper.GetBool("server_chain.rewards_simulation")
+}
+
 // LFB tickets.
 
 func GetReBroadcastLFBTicketTimeout() time.Duration {
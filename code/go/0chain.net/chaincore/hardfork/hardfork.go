@@ -0,0 +1,99 @@
+// Package hardfork activates changes of consensus and smart contracts at
+// given rounds. A change is implemented under a named fork the code checks
+// with IsForkActive, the round the fork activates at is scheduled by
+// server_chain.hard_forks of 0chain.yaml, so all nodes switch to the new
+// behaviour at the same round.
+package hardfork
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Fork is a hard fork scheduled at a round.
+type Fork struct {
+	Name  string `json:"name"`
+	Round int64  `json:"round"`
+	// Known is true if the fork is implemented by the build.
+	Known bool `json:"known"`
+	// Active is true if the fork is active in the round the list is given
+	// for.
+	Active bool `json:"active"`
+}
+
+var (
+	mutex    sync.RWMutex
+	known    = make(map[string]bool)
+	schedule = make(map[string]int64)
+)
+
+// Register a fork implemented by the build, it's called from init of the
+// package checking the fork.
+func Register(name string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	known[name] = true
+}
+
+// Schedule sets the activation rounds of the forks, it replaces the
+// previous schedule.
+func Schedule(forks map[string]int64) error {
+	for name, round := range forks {
+		if round < 0 {
+			return fmt.Errorf("negative activation round of hard fork %q", name)
+		}
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	schedule = make(map[string]int64, len(forks))
+	for name, round := range forks {
+		schedule[name] = round
+	}
+	return nil
+}
+
+// IsForkActive returns true if the fork is scheduled at the round or
+// before it.
+func IsForkActive(name string, round int64) bool {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	activation, ok := schedule[name]
+	return ok && round >= activation
+}
+
+// List returns the scheduled forks ordered by activation round, Active of
+// the forks is given for the round.
+func List(round int64) []Fork {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	var forks = make([]Fork, 0, len(schedule))
+	for name, activation := range schedule {
+		forks = append(forks, Fork{
+			Name:   name,
+			Round:  activation,
+			Known:  known[name],
+			Active: round >= activation,
+		})
+	}
+	sort.Slice(forks, func(i, j int) bool {
+		if forks[i].Round != forks[j].Round {
+			return forks[i].Round < forks[j].Round
+		}
+		return forks[i].Name < forks[j].Name
+	})
+	return forks
+}
+
+// CheckKnown returns error if a fork active in the round is not implemented
+// by the build, the node can't process the blocks of the round then.
+func CheckKnown(round int64) error {
+	for _, f := range List(round) {
+		if f.Active && !f.Known {
+			return fmt.Errorf("hard fork %q is active from round %d, "+
+				"but it's not known by the build", f.Name, f.Round)
+		}
+	}
+	return nil
+}
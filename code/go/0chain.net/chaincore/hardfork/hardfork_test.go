@@ -0,0 +1,61 @@
+package hardfork
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetForks(t *testing.T) {
+	t.Cleanup(func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		known = make(map[string]bool)
+		schedule = make(map[string]int64)
+	})
+}
+
+func TestIsForkActive(t *testing.T) {
+	resetForks(t)
+
+	require.NoError(t, Schedule(map[string]int64{"a": 10, "genesis": 0}))
+	assert.True(t, IsForkActive("genesis", 0))
+	assert.False(t, IsForkActive("a", 9))
+	assert.True(t, IsForkActive("a", 10))
+	assert.True(t, IsForkActive("a", 11))
+	assert.False(t, IsForkActive("b", 100))
+
+	require.EqualError(t, Schedule(map[string]int64{"b": -1}),
+		`negative activation round of hard fork "b"`)
+	assert.True(t, IsForkActive("a", 10), "invalid schedule is not applied")
+
+	require.NoError(t, Schedule(map[string]int64{"b": 20}))
+	assert.False(t, IsForkActive("a", 10))
+	assert.True(t, IsForkActive("b", 20))
+}
+
+func TestList(t *testing.T) {
+	resetForks(t)
+
+	Register("b")
+	require.NoError(t, Schedule(map[string]int64{"c": 20, "b": 10, "a": 10}))
+	assert.Equal(t, []Fork{
+		{Name: "a", Round: 10, Known: false, Active: true},
+		{Name: "b", Round: 10, Known: true, Active: true},
+		{Name: "c", Round: 20, Known: false, Active: false},
+	}, List(15))
+}
+
+func TestCheckKnown(t *testing.T) {
+	resetForks(t)
+
+	Register("a")
+	require.NoError(t, Schedule(map[string]int64{"a": 10, "b": 20}))
+	require.NoError(t, CheckKnown(19))
+	require.EqualError(t, CheckKnown(20),
+		`hard fork "b" is active from round 20, but it's not known by the build`)
+
+	Register("b")
+	require.NoError(t, CheckKnown(20))
+}
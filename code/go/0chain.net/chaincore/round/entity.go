@@ -611,6 +611,7 @@ func (r *Round) Clear() {
 // Restart - restart the round
 func (r *Round) Restart() error {
 	r.mutex.Lock()
+	defer r.mutex.Unlock()
 	if r.getState() >= Share {
 		return CompleteRoundRestartError
 	}
@@ -618,8 +619,6 @@ func (r *Round) Restart() error {
 	r.Block = nil
 	r.resetSoftTimeoutCount()
 	r.ResetPhase(ShareVRF)
-
-	r.mutex.Unlock()
 	return nil
 }
 
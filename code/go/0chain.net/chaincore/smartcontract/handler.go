@@ -61,6 +61,9 @@ func ExecuteWithStats(smcoi sci.SmartContractInterface, t *transaction.Transacti
 
 // ExecuteSmartContract - executes the smart contract in the context of the given transaction
 func ExecuteSmartContract(t *transaction.Transaction, scData *sci.SmartContractTransactionData, balances c_state.StateContextI) (string, error) {
+	if t.TransactionType == transaction.TxnTypeMultiCall {
+		return ExecuteMultiCall(t, balances)
+	}
 	contractObj := getSmartContract(t.ToClientID)
 	if contractObj != nil {
 		transactionOutput, err := ExecuteWithStats(contractObj, t, scData.FunctionName, scData.InputData, balances)
@@ -73,6 +76,9 @@ func ExecuteSmartContract(t *transaction.Transaction, scData *sci.SmartContractT
 }
 
 func EstimateTransactionCost(t *transaction.Transaction, scData sci.SmartContractTransactionData, balances c_state.StateContextI) (int, error) {
+	if t.TransactionType == transaction.TxnTypeMultiCall {
+		return EstimateMultiCallCost(t, balances)
+	}
 	contractObj := getSmartContract(t.ToClientID)
 	if contractObj == nil {
 		return 0, errors.New("estimate transaction cost - invalid to client id")
@@ -0,0 +1,130 @@
+package smartcontract
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/0chain/common/core/currency"
+
+	c_state "0chain.net/chaincore/chain/state"
+	sci "0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+)
+
+const (
+	// MaxMultiCalls is the max number of the calls of a multi-call transaction
+	MaxMultiCalls = 16
+	// MultiCallFunctionName is the function name a multi-call transaction is
+	// logged and measured by
+	MultiCallFunctionName = "multi_call"
+)
+
+// decodeMultiCall decodes and validates the calls of the multi-call
+// transaction, the values of the calls should sum up to the transaction value
+func decodeMultiCall(t *transaction.Transaction) ([]sci.SmartContractCall, error) {
+	var data sci.MultiCallTransactionData
+	if err := json.Unmarshal([]byte(t.TransactionData), &data); err != nil {
+		return nil, fmt.Errorf("invalid multi-call data: %v", err)
+	}
+	if len(data.Calls) == 0 || len(data.Calls) > MaxMultiCalls {
+		return nil, fmt.Errorf("multi-call should have 1 to %d calls", MaxMultiCalls)
+	}
+
+	var value currency.Coin
+	for i, call := range data.Calls {
+		if getSmartContract(call.Address) == nil {
+			return nil, fmt.Errorf("call %d: invalid smart contract address %s", i, call.Address)
+		}
+		var err error
+		if value, err = currency.AddCoin(value, call.Value); err != nil {
+			return nil, err
+		}
+	}
+	if value != t.Value {
+		return nil, errors.New("values of the calls don't sum up to the transaction value")
+	}
+	return data.Calls, nil
+}
+
+// callTransaction returns the transaction the call is executed in the
+// context of
+func callTransaction(t *transaction.Transaction, call sci.SmartContractCall) (*transaction.Transaction, error) {
+	data, err := json.Marshal(sci.SmartContractTransactionData{
+		FunctionName: call.FunctionName,
+		InputData:    call.InputData,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx := t.Clone()
+	tx.ToClientID = call.Address
+	tx.Value = call.Value
+	tx.TransactionData = string(data)
+	tx.TransactionType = transaction.TxnTypeSmartContract
+	return tx, nil
+}
+
+// ExecuteMultiCall executes the calls of the multi-call transaction in order
+// with the same state context, the outputs of the calls are returned as a
+// JSON list. The first failed call fails the transaction, so the state
+// changes of the calls are rejected all together.
+func ExecuteMultiCall(t *transaction.Transaction, balances c_state.StateContextI) (string, error) {
+	calls, err := decodeMultiCall(t)
+	if err != nil {
+		return "", err
+	}
+	caller, ok := balances.(c_state.Caller)
+	if !ok {
+		return "", errors.New("the state context can't execute calls")
+	}
+
+	outputs := make([]string, 0, len(calls))
+	for i, call := range calls {
+		tx, err := callTransaction(t, call)
+		if err != nil {
+			return "", err
+		}
+		var output string
+		err = caller.Call(tx, func() (err error) {
+			output, err = ExecuteWithStats(getSmartContract(call.Address), tx,
+				call.FunctionName, call.InputData, balances)
+			return
+		})
+		if err != nil {
+			return "", fmt.Errorf("call %d %s: %w", i, call.FunctionName, err)
+		}
+		outputs = append(outputs, output)
+	}
+
+	out, err := json.Marshal(outputs)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// EstimateMultiCallCost returns the sum of the costs of the calls of the
+// multi-call transaction
+func EstimateMultiCallCost(t *transaction.Transaction, balances c_state.StateContextI) (int, error) {
+	calls, err := decodeMultiCall(t)
+	if err != nil {
+		return 0, err
+	}
+
+	var cost int
+	for i, call := range calls {
+		tx, err := callTransaction(t, call)
+		if err != nil {
+			return 0, err
+		}
+		c, err := getSmartContract(call.Address).GetCost(tx,
+			strings.ToLower(call.FunctionName), balances)
+		if err != nil {
+			return 0, fmt.Errorf("call %d %s: %w", i, call.FunctionName, err)
+		}
+		cost += c
+	}
+	return cost, nil
+}
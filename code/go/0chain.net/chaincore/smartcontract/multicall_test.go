@@ -0,0 +1,111 @@
+package smartcontract
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	c_state "0chain.net/chaincore/chain/state"
+	sci "0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/encryption"
+)
+
+// callTestSC outputs the address and the value of the transaction it's
+// called with, and transfers the value from the client
+type callTestSC struct {
+	address string
+}
+
+func (sc *callTestSC) Execute(t *transaction.Transaction, funcName string,
+	input []byte, balances c_state.StateContextI) (string, error) {
+	if funcName == "fail" {
+		return "", errors.New("failed")
+	}
+	if err := balances.AddTransfer(state.NewTransfer(t.ClientID, sc.address, t.Value)); err != nil {
+		return "", err
+	}
+	return balances.GetTransaction().ToClientID + ":" + string(input) + ":" + strconv.FormatInt(int64(t.Value), 10), nil
+}
+
+func (sc *callTestSC) GetHandlerStats(context.Context, url.Values) (interface{}, error) {
+	return nil, nil
+}
+func (sc *callTestSC) GetExecutionStats() map[string]interface{} { return nil }
+func (sc *callTestSC) GetName() string                           { return "call_test" }
+func (sc *callTestSC) GetAddress() string                        { return sc.address }
+func (sc *callTestSC) GetCost(t *transaction.Transaction, funcName string,
+	balances c_state.StateContextI) (int, error) {
+	return 10, nil
+}
+
+func newMultiCallTxn(t *testing.T, calls ...sci.SmartContractCall) *transaction.Transaction {
+	data, err := json.Marshal(sci.MultiCallTransactionData{Calls: calls})
+	require.NoError(t, err)
+	txn := &transaction.Transaction{
+		ClientID:        encryption.Hash("client"),
+		TransactionType: transaction.TxnTypeMultiCall,
+		TransactionData: string(data),
+	}
+	for _, call := range calls {
+		txn.Value += call.Value
+	}
+	return txn
+}
+
+func TestExecuteMultiCall(t *testing.T) {
+	var (
+		first  = encryption.Hash("first")
+		second = encryption.Hash("second")
+	)
+	ContractMap[first] = &callTestSC{address: first}
+	ContractMap[second] = &callTestSC{address: second}
+	defer func() {
+		delete(ContractMap, first)
+		delete(ContractMap, second)
+	}()
+
+	txn := newMultiCallTxn(t,
+		sci.SmartContractCall{Address: first, FunctionName: "a", InputData: json.RawMessage(`1`), Value: 5},
+		sci.SmartContractCall{Address: second, FunctionName: "b", InputData: json.RawMessage(`2`), Value: 7},
+	)
+	balances := c_state.NewStateContext(nil, nil, txn, nil, nil, nil, nil, nil, nil)
+	output, err := ExecuteSmartContract(txn, &sci.SmartContractTransactionData{}, balances)
+	require.NoError(t, err)
+
+	var outputs []string
+	require.NoError(t, json.Unmarshal([]byte(output), &outputs))
+	require.Equal(t, []string{first + ":1:5", second + ":2:7"}, outputs)
+	require.Len(t, balances.GetTransfers(), 2)
+	require.Equal(t, txn, balances.GetTransaction())
+
+	cost, err := EstimateTransactionCost(txn, sci.SmartContractTransactionData{}, balances)
+	require.NoError(t, err)
+	require.Equal(t, 20, cost)
+
+	// the failed call fails the transaction
+	txn = newMultiCallTxn(t,
+		sci.SmartContractCall{Address: first, FunctionName: "a"},
+		sci.SmartContractCall{Address: second, FunctionName: "fail"},
+	)
+	balances = c_state.NewStateContext(nil, nil, txn, nil, nil, nil, nil, nil, nil)
+	_, err = ExecuteMultiCall(txn, balances)
+	require.EqualError(t, err, "call 1 fail: failed")
+
+	// invalid calls
+	_, err = ExecuteMultiCall(newMultiCallTxn(t), balances)
+	require.Error(t, err)
+	_, err = ExecuteMultiCall(newMultiCallTxn(t,
+		sci.SmartContractCall{Address: encryption.Hash("none"), FunctionName: "a"}), balances)
+	require.Error(t, err)
+	txn = newMultiCallTxn(t, sci.SmartContractCall{Address: first, FunctionName: "a", Value: 5})
+	txn.Value = 10
+	_, err = ExecuteMultiCall(txn, balances)
+	require.Error(t, err)
+}
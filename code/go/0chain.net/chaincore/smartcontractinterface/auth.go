@@ -4,6 +4,18 @@ import (
 	"0chain.net/core/common"
 )
 
+// governanceAddress is address of the governance SC. The SC applies passed
+// proposals of settings changes, so it replaces the owner of settings of all
+// SCs. It's empty until the SC is enabled and registers itself.
+var governanceAddress string
+
+// RegisterGovernance sets address of the governance SC authorized by the
+// AuthorizeWithGovernance. The SC can't be imported here, so it registers
+// itself on creation.
+func RegisterGovernance(address string) {
+	governanceAddress = address
+}
+
 func AuthorizeWithOwner(funcName string, hasAccess func() bool) error {
 	if !hasAccess() {
 		return common.NewError(funcName,
@@ -19,3 +31,16 @@ func AuthorizeWithDelegate(funcName string, hasAccess func() bool) error {
 	}
 	return nil
 }
+
+// AuthorizeWithGovernance authorizes the governance SC only if it's enabled,
+// or the owner otherwise
+func AuthorizeWithGovernance(funcName, clientID string, hasAccess func() bool) error {
+	if governanceAddress == "" {
+		return AuthorizeWithOwner(funcName, hasAccess)
+	}
+	if clientID != governanceAddress {
+		return common.NewError(funcName,
+			"unauthorized access - only the governance SC can access")
+	}
+	return nil
+}
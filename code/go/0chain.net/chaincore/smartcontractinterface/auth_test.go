@@ -0,0 +1,31 @@
+package smartcontractinterface
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorizeWithGovernance(t *testing.T) {
+	const (
+		owner      = "owner"
+		governance = "governance"
+	)
+	var authorize = func(clientID string) error {
+		return AuthorizeWithGovernance("update_settings", clientID, func() bool {
+			return clientID == owner
+		})
+	}
+
+	// the governance SC is not enabled
+	require.NoError(t, authorize(owner))
+	require.EqualError(t, authorize(governance),
+		"update_settings: unauthorized access - only the owner can access")
+
+	RegisterGovernance(governance)
+	t.Cleanup(func() { RegisterGovernance("") })
+
+	require.NoError(t, authorize(governance))
+	require.EqualError(t, authorize(owner),
+		"update_settings: unauthorized access - only the governance SC can access")
+}
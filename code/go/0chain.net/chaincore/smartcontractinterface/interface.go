@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/url"
 
+	"github.com/0chain/common/core/currency"
+
 	c_state "0chain.net/chaincore/chain/state"
 	"0chain.net/chaincore/transaction"
 )
@@ -31,6 +33,22 @@ type SmartContractTransactionData struct {
 	InputData    json.RawMessage `json:"input"`
 }
 
+// MultiCallTransactionData is passed in Transaction.TransactionData of a
+// multi-call transaction, the calls are executed in order and either all of
+// them succeed or none
+type MultiCallTransactionData struct {
+	Calls []SmartContractCall `json:"calls"`
+}
+
+// SmartContractCall is a call of a multi-call transaction, the value of the
+// call is a part of the transaction value
+type SmartContractCall struct {
+	Address      string          `json:"address"`
+	FunctionName string          `json:"name"`
+	InputData    json.RawMessage `json:"input"`
+	Value        currency.Coin   `json:"value"`
+}
+
 type SmartContractInterface interface {
 	Execute(t *transaction.Transaction, funcName string, input []byte, balances c_state.StateContextI) (string, error)
 	GetHandlerStats(ctx context.Context, params url.Values) (interface{}, error)
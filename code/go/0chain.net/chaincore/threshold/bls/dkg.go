@@ -41,6 +41,11 @@ type DKG struct {
 
 	MagicBlockNumber int64
 	StartingRound    int64
+
+	// reshared DKG interpolates the shares dealt instead of summing them,
+	// see the SetReshare
+	reshared bool
+	parties  []PartyID
 }
 
 type DKGSummary struct {
@@ -127,7 +132,9 @@ func SetDKG(t, n int, shares map[string]string, msk []string, mpks map[PartyID][
 			panic("failed to verify secret share")
 		}
 	}
-	dkg.AggregateSecretKeyShares()
+	if err := dkg.AggregateSecretKeyShares(); err != nil {
+		panic(err)
+	}
 	return dkg
 }
 
@@ -149,6 +156,24 @@ func (dkg *DKG) GetMPKs() []PublicKey {
 	return mpks
 }
 
+// GetT returns the threshold
+func (dkg *DKG) GetT() int {
+	return dkg.T
+}
+
+// SetViewChange sets the magic block the DKG is run for
+func (dkg *DKG) SetViewChange(magicBlockNumber, startingRound int64, t, n int) {
+	dkg.MagicBlockNumber = magicBlockNumber
+	dkg.StartingRound = startingRound
+	dkg.T = t
+	dkg.N = n
+}
+
+// GetMagicBlockNumber returns the number of the magic block
+func (dkg *DKG) GetMagicBlockNumber() int64 {
+	return dkg.MagicBlockNumber
+}
+
 // ComputeDKGKeyShare - Derive the share for each miner through polynomial substitution method
 func (dkg *DKG) ComputeDKGKeyShare(forID PartyID) (Key, error) {
 	var secVec Key
@@ -192,15 +217,30 @@ func (dkg *DKG) GetSijLen() int {
 }
 
 // AggregateSecretKeyShares - Each party aggregates the received shares from other party which is calculated for that party
-func (dkg *DKG) AggregateSecretKeyShares() {
+func (dkg *DKG) AggregateSecretKeyShares() error {
 	var sk Key
 	dkg.secretSharesMutex.RLock()
 	defer dkg.secretSharesMutex.RUnlock()
-	for _, Sij := range dkg.receivedSecretShares {
-		sk.Add(&Sij)
+	if dkg.reshared {
+		var (
+			shares = make([]Key, 0, len(dkg.receivedSecretShares))
+			ids    = make([]PartyID, 0, len(dkg.receivedSecretShares))
+		)
+		for id, Sij := range dkg.receivedSecretShares {
+			shares = append(shares, Sij)
+			ids = append(ids, id)
+		}
+		if err := sk.Recover(shares, ids); err != nil {
+			return fmt.Errorf("interpolating reshared secret shares: %v", err)
+		}
+	} else {
+		for _, Sij := range dkg.receivedSecretShares {
+			sk.Add(&Sij)
+		}
 	}
 	dkg.Si = sk
 	dkg.Pi = dkg.Si.GetPublicKey()
+	return nil
 }
 
 // GetSecretKeyShares - Each party aggregates the received shares from other party which is calculated for that party
@@ -311,15 +351,19 @@ func (dkg *DKG) CalBlsGpSign(recSig []string, recIDs []string) (Sign, error) {
 func (dkg *DKG) AggregatePublicKeyShares(mpks map[PartyID][]PublicKey) error {
 	dkg.gmpkMutex.Lock()
 	defer dkg.gmpkMutex.Unlock()
-	dkg.gmpk = make(map[PartyID]PublicKey)
-	for k := range mpks {
-		var pk PublicKey
-		for _, mpk := range mpks {
-			var pkj PublicKey
-			if err := pkj.Set(mpk, &k); err != nil {
-				return err
-			}
-			pk.Add(&pkj)
+	var parties = dkg.parties
+	if !dkg.reshared {
+		// all the parties are dealers
+		parties = make([]PartyID, 0, len(mpks))
+		for k := range mpks {
+			parties = append(parties, k)
+		}
+	}
+	dkg.gmpk = make(map[PartyID]PublicKey, len(parties))
+	for _, k := range parties {
+		pk, err := PublicKeyShare(mpks, k, dkg.reshared)
+		if err != nil {
+			return err
 		}
 		dkg.gmpk[k] = pk
 	}
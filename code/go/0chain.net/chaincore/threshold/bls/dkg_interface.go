@@ -16,9 +16,30 @@ type Sign = bls.Sign
 
 type PublicKey = bls.PublicKey
 
-/*DKGI - Interface for DKG*/
+// DKGI is a party of a distributed key generation with verifiable secret
+// sharing, a miner runs it to get its group key share of a view change.
 type DKGI interface {
+	// GetT returns the threshold of the DKG
+	GetT() int
+	// GetMPKs returns the master public key the party contributes
+	GetMPKs() []PublicKey
+	// ComputeDKGKeyShare deals the share for the party of the given ID
 	ComputeDKGKeyShare(forID PartyID) (Key, error)
-	GetKeyShareForOther(to PartyID) *DKGKeyShare
-	AggregateSecretKeyShares(qual []PartyID)
+	GetDKGKeyShare(to PartyID) *DKGKeyShare
+	GetKeyShare(id PartyID) (Key, bool)
+	GetSijLen() int
+	// ValidateShare validates a share dealt to the party against the
+	// master public key of the dealer
+	ValidateShare(jpk []PublicKey, sij Key) bool
+	AddSecretShare(id PartyID, share string, force bool) error
+	DeleteFromSet(nodes []string)
+	AggregatePublicKeyShares(mpks map[PartyID][]PublicKey) error
+	AggregateSecretKeyShares() error
+	// SetReshare makes the DKG aggregate the shares of a resharing of the
+	// previous DKG for the parties given
+	SetReshare(parties []PartyID)
+	// SetViewChange sets the magic block the DKG is run for
+	SetViewChange(magicBlockNumber, startingRound int64, t, n int)
+	GetMagicBlockNumber() int64
+	GetDKGSummary() *DKGSummary
 }
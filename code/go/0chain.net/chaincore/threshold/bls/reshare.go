@@ -0,0 +1,89 @@
+package bls
+
+/*
+Resharing transfers the group secret of a DKG to a new set of parties keeping
+the group public key. The dealers are the parties of the previous DKG: a
+dealer shares its group secret key share with a polynomial having the share
+as the free coefficient. A party interpolates the shares dealt to it by any T
+of the dealers (Lagrange interpolation at zero) instead of summing them, the
+group public key is the interpolation of the free coefficients the same way.
+*/
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/herumi/bls/ffi/go/bls"
+)
+
+// MakeReshareDKG creates a DKG dealing the group secret key share of the
+// party in the previous DKG.
+func MakeReshareDKG(t, n int, id string, share Key) *DKG {
+	dkg := MakeDKG(t, n, id)
+	dkg.msk[0] = share
+	dkg.mpks = bls.GetMasterPublicKey(dkg.msk)
+	return dkg
+}
+
+// SetReshare makes the DKG aggregate the shares of a resharing, the group
+// public key shares are computed for the parties given, since not all of
+// them are dealers.
+func (dkg *DKG) SetReshare(parties []PartyID) {
+	dkg.reshared = true
+	dkg.parties = parties
+}
+
+// PublicKeyShare returns the public key of the group secret key share of the
+// party computed from the master public keys of the dealers.
+func PublicKeyShare(mpks map[PartyID][]PublicKey, id PartyID, reshared bool) (
+	PublicKey, error) {
+
+	var (
+		pks = make([]PublicKey, 0, len(mpks))
+		ids = make([]PartyID, 0, len(mpks))
+	)
+	for dealer, mpk := range mpks {
+		var pk PublicKey
+		if err := pk.Set(mpk, &id); err != nil {
+			return PublicKey{}, err
+		}
+		pks, ids = append(pks, pk), append(ids, dealer)
+	}
+	return combinePublicKeys(pks, ids, reshared)
+}
+
+// GroupPublicKey returns the group public key of the DKG of the master public
+// keys of the dealers.
+func GroupPublicKey(mpks map[PartyID][]PublicKey, reshared bool) (
+	PublicKey, error) {
+
+	var (
+		pks = make([]PublicKey, 0, len(mpks))
+		ids = make([]PartyID, 0, len(mpks))
+	)
+	for dealer, mpk := range mpks {
+		if len(mpk) == 0 {
+			return PublicKey{}, errors.New("empty master public key")
+		}
+		pks, ids = append(pks, mpk[0]), append(ids, dealer)
+	}
+	return combinePublicKeys(pks, ids, reshared)
+}
+
+func combinePublicKeys(pks []PublicKey, ids []PartyID, reshared bool) (
+	pk PublicKey, err error) {
+
+	if len(pks) == 0 {
+		return pk, errors.New("no dealers")
+	}
+	if reshared {
+		if err = pk.Recover(pks, ids); err != nil {
+			return pk, fmt.Errorf("interpolating public keys: %v", err)
+		}
+		return
+	}
+	for i := range pks {
+		pk.Add(&pks[i])
+	}
+	return
+}
@@ -0,0 +1,138 @@
+package bls
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/core/encryption"
+)
+
+// runDKG deals the shares of the dealers to the parties and aggregates them,
+// it returns the DKGs of the parties and the master public keys of dealers
+func runDKG(t *testing.T, dealers map[string]*DKG, parties []string,
+	reshared bool) (map[string]*DKG, map[PartyID][]PublicKey) {
+
+	var (
+		dkgs = make(map[string]*DKG, len(parties))
+		mpks = make(map[PartyID][]PublicKey, len(dealers))
+		ids  = make([]PartyID, 0, len(parties))
+	)
+	for id, dealer := range dealers {
+		mpks[ComputeIDdkg(id)] = dealer.GetMPKs()
+	}
+	for _, p := range parties {
+		ids = append(ids, ComputeIDdkg(p))
+	}
+	for _, p := range parties {
+		var dkg, ok = dealers[p]
+		if !ok {
+			dkg = MakeDKG(len(dealers), len(parties), p)
+		}
+		for id, dealer := range dealers {
+			share, err := dealer.ComputeDKGKeyShare(ComputeIDdkg(p))
+			require.NoError(t, err)
+			require.True(t, dkg.ValidateShare(mpks[ComputeIDdkg(id)], share))
+			require.NoError(t, dkg.AddSecretShare(ComputeIDdkg(id),
+				share.GetHexString(), false))
+		}
+		if reshared {
+			dkg.SetReshare(ids)
+		}
+		require.NoError(t, dkg.AggregateSecretKeyShares())
+		require.NoError(t, dkg.AggregatePublicKeyShares(mpks))
+		dkgs[p] = dkg
+	}
+	return dkgs, mpks
+}
+
+// groupSign recovers the group signature of the message from the signature
+// shares of the parties given
+func groupSign(t *testing.T, dkgs map[string]*DKG, parties []string,
+	msg string) Sign {
+
+	var (
+		ids   []PartyID
+		signs []Sign
+	)
+	for _, p := range parties {
+		var dkg = dkgs[p]
+		var sign = dkg.Sign(msg)
+		require.True(t, dkg.VerifySignature(sign, msg, dkg.ID))
+		ids, signs = append(ids, dkg.ID), append(signs, *sign)
+	}
+	sign, err := dkgs[parties[0]].RecoverGroupSig(ids, signs)
+	require.NoError(t, err)
+	return sign
+}
+
+func TestReshare(t *testing.T) {
+	var ids []string
+	for i := 0; i < 6; i++ {
+		ids = append(ids, encryption.Hash(fmt.Sprintf("party %d", i)))
+	}
+
+	// the initial DKG of the parties 0-3
+	var dealers = make(map[string]*DKG)
+	for _, id := range ids[:4] {
+		dealers[id] = MakeDKG(3, 4, id)
+	}
+	old, oldMPKs := runDKG(t, dealers, ids[:4], false)
+	groupPK, err := GroupPublicKey(oldMPKs, false)
+	require.NoError(t, err)
+
+	const msg = "message"
+	var sign = groupSign(t, old, ids[1:4], msg)
+	require.True(t, sign.Verify(&groupPK, msg))
+
+	// the party 0 leaves and the parties 4-5 join, the parties 1-3 deal
+	var (
+		scheme   = feldmanVSS{}
+		newSet   = ids[1:]
+		reshares = make(map[string]*DKG)
+	)
+	for _, id := range ids[1:4] {
+		reshares[id] = scheme.NewReshareDKG(3, 5, id, old[id].Si).(*DKG)
+		var mpk []string
+		for _, pk := range reshares[id].GetMPKs() {
+			mpk = append(mpk, pk.GetHexString())
+		}
+		require.NoError(t, scheme.ValidateReshareMPK(oldMPKs, false, id, mpk))
+		require.EqualError(t, scheme.ValidateReshareMPK(oldMPKs, false, ids[0], mpk),
+			"master public key doesn't commit to the previous key share")
+	}
+	reshared, newMPKs := runDKG(t, reshares, newSet, true)
+
+	newGroupPK, err := GroupPublicKey(newMPKs, true)
+	require.NoError(t, err)
+	assert.True(t, groupPK.IsEqual(&newGroupPK), "group public key changed")
+
+	for _, parties := range [][]string{ids[1:4], ids[3:6], {ids[1], ids[4], ids[5]}} {
+		sign = groupSign(t, reshared, parties, msg)
+		assert.True(t, sign.Verify(&groupPK, msg))
+	}
+
+	// a fresh secret can't be reshared
+	var fresh = MakeDKG(3, 5, ids[4])
+	var mpk []string
+	for _, pk := range fresh.GetMPKs() {
+		mpk = append(mpk, pk.GetHexString())
+	}
+	require.Error(t, scheme.ValidateReshareMPK(newMPKs, true, ids[4], mpk))
+
+	// the reshared keys can be reshared again
+	reshares = make(map[string]*DKG)
+	for _, id := range ids[3:6] {
+		reshares[id] = MakeReshareDKG(2, 3, id, reshared[id].Si)
+		mpk = mpk[:0]
+		for _, pk := range reshares[id].GetMPKs() {
+			mpk = append(mpk, pk.GetHexString())
+		}
+		require.NoError(t, scheme.ValidateReshareMPK(newMPKs, true, id, mpk))
+	}
+	again, _ := runDKG(t, reshares, ids[3:6], true)
+	sign = groupSign(t, again, ids[4:6], msg)
+	assert.True(t, sign.Verify(&groupPK, msg))
+}
@@ -0,0 +1,118 @@
+package bls
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"0chain.net/core/encryption"
+)
+
+// FeldmanVSS is the joint Feldman DKG of BLS keys, a share is verified
+// against the public coefficients of the polynomial of the dealer.
+const FeldmanVSS = "feldman_vss"
+
+// Scheme is a DKG scheme. The miners of a view change run the DKG of the
+// scheme chosen by the miner smart contract, and the smart contract checks
+// the shares of complaints against dealers with it.
+type Scheme interface {
+	// NewDKG returns a new party of the DKG
+	NewDKG(t, n int, id string) DKGI
+	// ValidateShare validates the share dealt to the party against the
+	// master public key of the dealer
+	ValidateShare(mpk []string, share, partyID string) error
+}
+
+// ResharingScheme is a DKG scheme able to reshare the group secret of the
+// previous DKG with a new set of parties keeping the group public key.
+type ResharingScheme interface {
+	Scheme
+	// NewReshareDKG returns a new party of the DKG dealing its group secret
+	// key share of the previous DKG
+	NewReshareDKG(t, n int, id string, share Key) DKGI
+	// ValidateReshareMPK validates the master public key of the dealer
+	// commits to its group secret key share of the previous DKG
+	ValidateReshareMPK(prevMPKs map[PartyID][]PublicKey, prevReshared bool,
+		dealerID string, mpk []string) error
+}
+
+var (
+	schemesMutex sync.RWMutex
+	schemes      = map[string]Scheme{
+		FeldmanVSS: feldmanVSS{},
+	}
+)
+
+// RegisterScheme registers a DKG scheme by its name.
+func RegisterScheme(name string, scheme Scheme) {
+	schemesMutex.Lock()
+	defer schemesMutex.Unlock()
+	schemes[name] = scheme
+}
+
+// GetScheme returns the DKG scheme of the name, an empty name is the
+// Feldman VSS scheme.
+func GetScheme(name string) (Scheme, error) {
+	if name == "" {
+		name = FeldmanVSS
+	}
+	schemesMutex.RLock()
+	defer schemesMutex.RUnlock()
+	scheme, ok := schemes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown DKG scheme %q", name)
+	}
+	return scheme, nil
+}
+
+// ShareMessage is the message a dealer signs sending a share to the party.
+// It binds the share to the master public key of the dealer, so the party
+// can prove an invalid share to anyone knowing the key.
+func ShareMessage(partyID, share string, mpk []string) string {
+	return encryption.Hash(partyID + ":" + share + ":" + strings.Join(mpk, ":"))
+}
+
+type feldmanVSS struct{}
+
+func (feldmanVSS) NewDKG(t, n int, id string) DKGI {
+	return MakeDKG(t, n, id)
+}
+
+func (feldmanVSS) ValidateShare(mpk []string, share, partyID string) error {
+	pks, err := ConvertStringToMpk(mpk)
+	if err != nil {
+		return fmt.Errorf("invalid master public key: %v", err)
+	}
+	var sij Key
+	if err := sij.SetHexString(share); err != nil {
+		return fmt.Errorf("invalid share: %v", err)
+	}
+	if !ValidateShare(pks, sij, ComputeIDdkg(partyID)) {
+		return fmt.Errorf("share doesn't match the master public key")
+	}
+	return nil
+}
+
+func (feldmanVSS) NewReshareDKG(t, n int, id string, share Key) DKGI {
+	return MakeReshareDKG(t, n, id, share)
+}
+
+func (feldmanVSS) ValidateReshareMPK(prevMPKs map[PartyID][]PublicKey,
+	prevReshared bool, dealerID string, mpk []string) error {
+
+	pks, err := ConvertStringToMpk(mpk)
+	if err != nil {
+		return fmt.Errorf("invalid master public key: %v", err)
+	}
+	if len(pks) == 0 {
+		return fmt.Errorf("empty master public key")
+	}
+	share, err := PublicKeyShare(prevMPKs, ComputeIDdkg(dealerID), prevReshared)
+	if err != nil {
+		return fmt.Errorf("computing previous key share: %v", err)
+	}
+	if !share.IsEqual(&pks[0]) {
+		return fmt.Errorf("master public key doesn't commit to the previous key share")
+	}
+	return nil
+}
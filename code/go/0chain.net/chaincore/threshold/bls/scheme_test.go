@@ -0,0 +1,52 @@
+package bls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/core/encryption"
+)
+
+func TestGetScheme(t *testing.T) {
+	def, err := GetScheme("")
+	require.NoError(t, err)
+	feldman, err := GetScheme(FeldmanVSS)
+	require.NoError(t, err)
+	assert.Equal(t, feldman, def)
+
+	_, err = GetScheme("unknown")
+	require.EqualError(t, err, `unknown DKG scheme "unknown"`)
+
+	RegisterScheme("unknown", feldmanVSS{})
+	defer func() {
+		schemesMutex.Lock()
+		delete(schemes, "unknown")
+		schemesMutex.Unlock()
+	}()
+	_, err = GetScheme("unknown")
+	require.NoError(t, err)
+}
+
+func TestFeldmanVSSValidateShare(t *testing.T) {
+	var (
+		scheme = feldmanVSS{}
+		party  = encryption.Hash("party")
+		other  = encryption.Hash("other")
+		dkg    = scheme.NewDKG(2, 3, encryption.Hash("dealer"))
+		mpk    []string
+	)
+	for _, pk := range dkg.GetMPKs() {
+		mpk = append(mpk, pk.GetHexString())
+	}
+	share, err := dkg.ComputeDKGKeyShare(ComputeIDdkg(party))
+	require.NoError(t, err)
+
+	require.NoError(t, scheme.ValidateShare(mpk, share.GetHexString(), party))
+	require.EqualError(t, scheme.ValidateShare(mpk, share.GetHexString(), other),
+		"share doesn't match the master public key")
+	require.Error(t, scheme.ValidateShare(mpk, "not a share", party))
+	require.Error(t, scheme.ValidateShare([]string{"not a key"},
+		share.GetHexString(), party))
+}
@@ -35,8 +35,10 @@ var transactionCount uint64 = 0
 
 // ErrTxnMissingPublicKey is returned if the transaction does not have ClientID and public key
 var (
-	ErrTxnMissingPublicKey = errors.New("transaction missing public key")
-	ErrTxnInvalidPublicKey = errors.New("transaction public key is invalid")
+	ErrTxnMissingPublicKey  = errors.New("transaction missing public key")
+	ErrTxnInvalidPublicKey  = errors.New("transaction public key is invalid")
+	ErrTxnExpired           = errors.New("transaction expired")
+	ErrTxnBaseFeeNotCovered = errors.New("transaction fee is less than the base fee")
 )
 
 func SetupTransactionDB(redisTxnsHost string, redisTxnsPort int) {
@@ -66,6 +68,24 @@ type Transaction struct {
 	Fee             currency.Coin    `json:"transaction_fee" msgpack:"f"`
 	Nonce           int64            `json:"transaction_nonce" msgpack:"n"`
 
+	// MaxFee and PriorityFee replace the Fee of a transaction paying the
+	// base fee of the block it's included in, see EffectiveFee
+	MaxFee      currency.Coin `json:"max_fee,omitempty" msgpack:"mf,omitempty"`
+	PriorityFee currency.Coin `json:"priority_fee,omitempty" msgpack:"pf,omitempty"`
+
+	// ExpiresAt is the optional deadline the transaction can't be included
+	// in a block created after
+	ExpiresAt common.Timestamp `json:"expires_at,omitempty" msgpack:"ea,omitempty"`
+
+	// FeePayerID is the optional client paying the fee of the transaction,
+	// the fee payer signs the transaction hash. The hash of a sponsored
+	// transaction covers the fee and the fee payer nonce, the next nonce of
+	// the fee payer the transaction uses up.
+	FeePayerID        string `json:"fee_payer_id,omitempty" msgpack:"fpid,omitempty"`
+	FeePayerPublicKey string `json:"fee_payer_public_key,omitempty" msgpack:"fppk,omitempty"`
+	FeePayerSignature string `json:"fee_payer_signature,omitempty" msgpack:"fps,omitempty"`
+	FeePayerNonce     int64  `json:"fee_payer_nonce,omitempty" msgpack:"fpn,omitempty"`
+
 	TransactionType   int    `json:"transaction_type" msgpack:"tt"`
 	TransactionOutput string `json:"transaction_output,omitempty" msgpack:"o,omitempty"`
 	OutputHash        string `json:"txn_output_hash" msgpack:"oh"`
@@ -76,6 +96,7 @@ type FeeStats struct {
 	MaxFees  currency.Coin `json:"max_fees"`
 	MeanFees currency.Coin `json:"mean_fees"`
 	MinFees  currency.Coin `json:"min_fees"`
+	BaseFee  currency.Coin `json:"base_fee"`
 }
 
 var transactionEntityMetadata *datastore.EntityMetadataImpl
@@ -109,25 +130,46 @@ func (t *Transaction) ValidateNonce() error {
 
 // ValidateFee - Validate fee
 func (t *Transaction) ValidateFee(txnExempted map[string]bool, minTxnFee currency.Coin) error {
-	if t.TransactionData != "" {
-		var smartContractData smartContractTransactionData
-		dataBytes := []byte(t.TransactionData)
-		err := json.Unmarshal(dataBytes, &smartContractData)
-		if err != nil {
-			logging.Logger.Error("unmarshal txn data failed", zap.Error(err))
-			return errors.New("invalid transaction data")
-		}
-
-		if _, ok := txnExempted[smartContractData.FunctionName]; ok {
-			return nil
-		}
+	exempted, err := t.isFeeExempted(txnExempted)
+	if err != nil || exempted {
+		return err
 	}
-	if t.Fee < minTxnFee {
+	if t.EffectiveFee(minTxnFee) < minTxnFee {
 		return common.InvalidRequest("The given fee is less than the minimum required fee to process the txn")
 	}
 	return nil
 }
 
+// ValidateBaseFee checks the transaction pays the base fee of the block it's
+// included in, unless its function is exempted from the fee
+func (t *Transaction) ValidateBaseFee(txnExempted map[string]bool, baseFee currency.Coin) error {
+	exempted, err := t.isFeeExempted(txnExempted)
+	if err != nil || exempted {
+		return err
+	}
+	if !t.CoversBaseFee(baseFee) {
+		return ErrTxnBaseFeeNotCovered
+	}
+	return nil
+}
+
+// isFeeExempted reports the function of the transaction is exempted from the
+// fee, the fee payer of a sponsored transaction pays the fee anyway
+func (t *Transaction) isFeeExempted(txnExempted map[string]bool) (bool, error) {
+	if t.TransactionData == "" {
+		return false, nil
+	}
+	var smartContractData smartContractTransactionData
+	dataBytes := []byte(t.TransactionData)
+	err := json.Unmarshal(dataBytes, &smartContractData)
+	if err != nil {
+		logging.Logger.Error("unmarshal txn data failed", zap.Error(err))
+		return false, errors.New("invalid transaction data")
+	}
+	_, ok := txnExempted[smartContractData.FunctionName]
+	return ok && !t.IsSponsored(), nil
+}
+
 /*ComputeClientID - compute the client id if there is a public key in the transaction */
 func (t *Transaction) ComputeClientID() error {
 	if t.PublicKey == "" {
@@ -178,9 +220,18 @@ func (t *Transaction) ValidateWrtTimeForBlock(ctx context.Context, ts common.Tim
 	if !common.WithinTime(int64(ts), int64(t.CreationDate), TXN_TIME_TOLERANCE) {
 		return common.InvalidRequest(fmt.Sprintf("Transaction creation time not within tolerance: ts=%v txn.creation_date=%v", ts, t.CreationDate))
 	}
-	if t.ClientID == t.ToClientID {
+	if t.ExpiresAt > 0 && t.ExpiresAt < t.CreationDate {
+		return common.InvalidRequest("transaction expires before its creation")
+	}
+	if t.IsExpired(ts) {
+		return ErrTxnExpired
+	}
+	if t.ClientID == t.ToClientID && !t.IsCancel() {
 		return common.InvalidRequest("from and to client should be different")
 	}
+	if err = t.validateFeePayer(); err != nil {
+		return err
+	}
 	err = t.VerifyHash(ctx)
 	if err != nil {
 		return err
@@ -190,6 +241,11 @@ func (t *Transaction) ValidateWrtTimeForBlock(ctx context.Context, ts common.Tim
 		if err != nil {
 			return err
 		}
+	} else if t.IsSponsored() {
+		// the aggregated signatures are of the senders only
+		if err = t.VerifyFeePayerSignature(ctx); err != nil {
+			return err
+		}
 	}
 	if t.OutputHash != "" {
 		err = t.VerifyOutputHash(ctx)
@@ -200,6 +256,12 @@ func (t *Transaction) ValidateWrtTimeForBlock(ctx context.Context, ts common.Tim
 	return nil
 }
 
+// IsExpired reports the transaction can't be included in a block created at
+// the given time
+func (t *Transaction) IsExpired(ts common.Timestamp) bool {
+	return t.ExpiresAt > 0 && ts > t.ExpiresAt
+}
+
 /*Validate - Entity implementation */
 func (t *Transaction) Validate(ctx context.Context) error {
 	return t.ValidateWrtTime(ctx, common.Now())
@@ -207,9 +269,13 @@ func (t *Transaction) Validate(ctx context.Context) error {
 
 /*GetScore - score for write*/
 
+// The score is the effective tip at the base fee known when the transaction
+// is put to the pool, it isn't updated when the base fee changes later. So
+// the pool order is approximate, the generator checks every transaction
+// against the base fee of the block anyway.
 func (t *Transaction) GetScore() (int64, error) {
 	if config.Configuration().ChainConfig.IsFeeEnabled() {
-		return t.Fee.Int64()
+		return t.EffectiveTip(LatestBaseFee()).Int64()
 	}
 	return 0, nil
 }
@@ -269,6 +335,24 @@ func (t *Transaction) HashData() string {
 	s.WriteString(strconv.FormatUint(uint64(t.Value), 10))
 	s.WriteString(":")
 	s.WriteString(encryption.Hash(t.TransactionData))
+	if t.MaxFee > 0 {
+		s.WriteString(":")
+		s.WriteString(strconv.FormatUint(uint64(t.MaxFee), 10))
+		s.WriteString(":")
+		s.WriteString(strconv.FormatUint(uint64(t.PriorityFee), 10))
+	}
+	if t.ExpiresAt > 0 {
+		s.WriteString(":")
+		s.WriteString(common.TimeToString(t.ExpiresAt))
+	}
+	if t.IsSponsored() {
+		s.WriteString(":")
+		s.WriteString(t.FeePayerID)
+		s.WriteString(":")
+		s.WriteString(strconv.FormatUint(uint64(t.Fee), 10))
+		s.WriteString(":")
+		s.WriteString(strconv.FormatInt(t.FeePayerNonce, 10))
+	}
 	return s.String()
 }
 
@@ -305,17 +389,25 @@ func (t *Transaction) VerifySignature(ctx context.Context) error {
 	if !correctSignature {
 		return common.NewError("invalid_signature", "Invalid Signature")
 	}
+	if t.IsSponsored() {
+		return t.VerifyFeePayerSignature(ctx)
+	}
 	return nil
 }
 
 /*GetSignatureScheme - get the signature scheme associated with this transaction */
 func (t *Transaction) GetSignatureScheme(ctx context.Context) (encryption.SignatureScheme, error) {
+	return getSignatureScheme(t.ClientID, t.PublicKey)
+}
 
-	co, err := client.GetClientFromCache(t.ClientID)
+// getSignatureScheme returns the signature scheme of the client, the given
+// public key is used if the client is not cached
+func getSignatureScheme(clientID, publicKey string) (encryption.SignatureScheme, error) {
+	co, err := client.GetClientFromCache(clientID)
 	if err != nil {
 		co = client.NewClient()
-		co.ID = t.ClientID
-		if err := co.SetPublicKey(t.PublicKey); err != nil {
+		co.ID = clientID
+		if err := co.SetPublicKey(publicKey); err != nil {
 			return nil, err
 		}
 		if err := client.PutClientCache(co); err != nil {
@@ -324,12 +416,12 @@ func (t *Transaction) GetSignatureScheme(ctx context.Context) (encryption.Signat
 	}
 
 	if co.SigScheme == nil {
-		if t.PublicKey == "" {
+		if publicKey == "" {
 			return nil, errors.New("get signature scheme failed, empty public key in transaction")
 		}
 
-		co.ID = t.ClientID
-		if err := co.SetPublicKey(t.PublicKey); err != nil {
+		co.ID = clientID
+		if err := co.SetPublicKey(publicKey); err != nil {
 			return nil, err
 		}
 		if err := client.PutClientCache(co); err != nil {
@@ -446,6 +538,13 @@ func (t *Transaction) Clone() *Transaction {
 		CreationDate:      t.CreationDate,
 		Fee:               t.Fee,
 		Nonce:             t.Nonce,
+		MaxFee:            t.MaxFee,
+		PriorityFee:       t.PriorityFee,
+		ExpiresAt:         t.ExpiresAt,
+		FeePayerID:        t.FeePayerID,
+		FeePayerPublicKey: t.FeePayerPublicKey,
+		FeePayerSignature: t.FeePayerSignature,
+		FeePayerNonce:     t.FeePayerNonce,
 		TransactionType:   t.TransactionType,
 		TransactionOutput: t.TransactionOutput,
 		OutputHash:        t.OutputHash,
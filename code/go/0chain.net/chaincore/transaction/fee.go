@@ -0,0 +1,73 @@
+package transaction
+
+import (
+	"sync/atomic"
+
+	"github.com/0chain/common/core/currency"
+)
+
+// latestBaseFee is the base fee of the block next to the latest finalized
+// block, it's used to order the transactions pool by effective tip
+var latestBaseFee uint64
+
+// SetLatestBaseFee sets the base fee of the block next to the latest
+// finalized block
+func SetLatestBaseFee(baseFee currency.Coin) {
+	atomic.StoreUint64(&latestBaseFee, uint64(baseFee))
+}
+
+// LatestBaseFee returns the base fee of the block next to the latest
+// finalized block
+func LatestBaseFee() currency.Coin {
+	return currency.Coin(atomic.LoadUint64(&latestBaseFee))
+}
+
+// IsDynamicFee reports the transaction gives the max fee and the priority
+// fee instead of the fee
+func (t *Transaction) IsDynamicFee() bool {
+	return t.MaxFee > 0
+}
+
+// FeeCap returns the max fee the transaction can pay
+func (t *Transaction) FeeCap() currency.Coin {
+	if t.IsDynamicFee() {
+		return t.MaxFee
+	}
+	return t.Fee
+}
+
+// EffectiveFee returns the fee the transaction pays in a block with the given
+// base fee. A transaction with the fee pays the fee, a transaction with the
+// max fee pays the base fee and the priority fee, but not more than the max
+// fee.
+func (t *Transaction) EffectiveFee(baseFee currency.Coin) currency.Coin {
+	if !t.IsDynamicFee() {
+		return t.Fee
+	}
+	fee, err := currency.AddCoin(baseFee, t.PriorityFee)
+	if err != nil || fee > t.MaxFee {
+		return t.MaxFee
+	}
+	return fee
+}
+
+// EffectiveTip returns the part of the effective fee above the base fee, the
+// tip is paid to the block generator and the base fee is burned
+func (t *Transaction) EffectiveTip(baseFee currency.Coin) currency.Coin {
+	fee := t.EffectiveFee(baseFee)
+	if fee <= baseFee {
+		return 0
+	}
+	return fee - baseFee
+}
+
+// CoversBaseFee reports the transaction pays at least the given base fee
+func (t *Transaction) CoversBaseFee(baseFee currency.Coin) bool {
+	return t.EffectiveFee(baseFee) >= baseFee
+}
+
+// BurnedFee returns the part of the effective fee burned in a block with the
+// given base fee, it's the effective fee without the tip
+func (t *Transaction) BurnedFee(baseFee currency.Coin) currency.Coin {
+	return t.EffectiveFee(baseFee) - t.EffectiveTip(baseFee)
+}
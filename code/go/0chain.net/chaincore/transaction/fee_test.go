@@ -0,0 +1,80 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectiveFee(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		txn        *Transaction
+		baseFee    currency.Coin
+		fee, tip   currency.Coin
+		coversBase bool
+		feeCap     currency.Coin
+	}{
+		{"fee", &Transaction{Fee: 150}, 100, 150, 50, true, 150},
+		{"fee below base fee", &Transaction{Fee: 50}, 100, 50, 0, false, 50},
+		{"priority fee", &Transaction{MaxFee: 200, PriorityFee: 30}, 100, 130, 30, true, 200},
+		{"capped priority fee", &Transaction{MaxFee: 120, PriorityFee: 30}, 100, 120, 20, true, 120},
+		{"max fee below base fee", &Transaction{MaxFee: 90, PriorityFee: 30}, 100, 90, 0, false, 90},
+		{"fee ignored", &Transaction{Fee: 1000, MaxFee: 200}, 100, 100, 0, true, 200},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.fee, tt.txn.EffectiveFee(tt.baseFee))
+			assert.Equal(t, tt.tip, tt.txn.EffectiveTip(tt.baseFee))
+			assert.Equal(t, tt.coversBase, tt.txn.CoversBaseFee(tt.baseFee))
+			assert.Equal(t, tt.feeCap, tt.txn.FeeCap())
+		})
+	}
+}
+
+func TestHashDataFeeFields(t *testing.T) {
+	var txn = Transaction{ClientID: "a", ToClientID: "b", Fee: 10}
+
+	// the legacy hash doesn't depend on the fee
+	legacy := txn.HashData()
+	txn.Fee = 20
+	assert.Equal(t, legacy, txn.HashData())
+
+	// the max fee and priority fee are signed
+	txn.MaxFee = 100
+	dynamic := txn.HashData()
+	assert.NotEqual(t, legacy, dynamic)
+	txn.PriorityFee = 5
+	assert.NotEqual(t, dynamic, txn.HashData())
+}
+
+func TestCloneFeeFields(t *testing.T) {
+	txn := &Transaction{Fee: 10, MaxFee: 100, PriorityFee: 5, FeePayerNonce: 3}
+	clone := txn.Clone()
+	assert.Equal(t, txn.MaxFee, clone.MaxFee)
+	assert.Equal(t, txn.PriorityFee, clone.PriorityFee)
+	assert.Equal(t, txn.FeePayerNonce, clone.FeePayerNonce)
+}
+
+func TestValidateBaseFee(t *testing.T) {
+	var (
+		exempted = map[string]bool{"wait": true}
+		wait     = `{"name":"wait","input":{}}`
+		lock     = `{"name":"lock","input":{}}`
+	)
+	for _, tt := range []struct {
+		name string
+		txn  *Transaction
+		err  error
+	}{
+		{"covered", &Transaction{Fee: 100, TransactionData: lock}, nil},
+		{"not covered", &Transaction{Fee: 50, TransactionData: lock}, ErrTxnBaseFeeNotCovered},
+		{"transfer", &Transaction{Fee: 50}, ErrTxnBaseFeeNotCovered},
+		{"exempted", &Transaction{TransactionData: wait}, nil},
+		{"sponsored", &Transaction{FeePayerID: "sponsor", TransactionData: wait}, ErrTxnBaseFeeNotCovered},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, tt.txn.ValidateBaseFee(exempted, 100))
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 /*SetupHandlers sets up the necessary API end points */
 func SetupHandlers() {
 	http.HandleFunc("/v1/transaction/get", common.UserRateLimit(common.ToJSONResponse(memorystore.WithConnectionHandler(GetTransaction))))
+	http.HandleFunc("/v1/transaction/get/pool_events", common.UserRateLimit(common.ToJSONResponse(GetPoolEvents)))
+	http.HandleFunc("/v1/transaction/pending", common.UserRateLimit(common.ToJSONResponse(GetPendingTransactions)))
 }
 
 /*GetTransaction - given an id returns the transaction information */
@@ -29,16 +31,28 @@ func PutTransaction(ctx context.Context, entity datastore.Entity) (interface{},
 		return nil, fmt.Errorf("invalid request %T", entity)
 	}
 
+	if err := validatePut(ctx, txn); err != nil {
+		return nil, err
+	}
+	if err := storePooled(ctx, txn); err != nil {
+		return nil, err
+	}
+	promotePending(ctx, txn.ClientID, txn.Nonce)
+	return txn, nil
+}
+
+// validatePut validates the transaction put to the pool
+func validatePut(ctx context.Context, txn *Transaction) error {
 	if err := txn.ComputeProperties(); err != nil {
 		logging.Logger.Error("put transaction error", zap.String("txn", txn.Hash), zap.Error(err))
-		return nil, err
+		return err
 	}
 
 	debugTxn := txn.DebugTxn()
 	err := txn.Validate(ctx)
 	if err != nil {
 		logging.Logger.Error("put transaction error", zap.String("txn", txn.Hash), zap.Error(err))
-		return nil, err
+		return err
 	}
 	if debugTxn {
 		logging.Logger.Info("put transaction (debug transaction)", zap.String("txn", txn.Hash), zap.String("txn_obj", datastore.ToJSON(txn).String()))
@@ -46,20 +60,29 @@ func PutTransaction(ctx context.Context, entity datastore.Entity) (interface{},
 
 	cli, err := txn.GetClient(ctx)
 	if err != nil || cli == nil || cli.PublicKey == "" {
-		return nil, common.NewError("put transaction error", fmt.Sprintf("client %v doesn't exist, please register", txn.ClientID))
+		return common.NewError("put transaction error", fmt.Sprintf("client %v doesn't exist, please register", txn.ClientID))
+	}
+	return nil
+}
+
+// storePooled stores the validated transaction to the pool
+func storePooled(ctx context.Context, txn *Transaction) error {
+	if err := replacePooled(ctx, txn); err != nil {
+		logging.Logger.Error("put transaction error", zap.String("txn", txn.Hash), zap.Error(err))
+		return err
 	}
 	if datastore.DoAsync(ctx, txn) {
 		IncTransactionCount()
-		return txn, nil
+		return nil
 	}
-	err = entity.GetEntityMetadata().GetStore().Write(ctx, txn)
+	err := txn.GetEntityMetadata().GetStore().Write(ctx, txn)
 	if err != nil {
 		logging.Logger.Error("put transaction", zap.Error(err), zap.String("txn", txn.Hash), zap.String("txn_obj", datastore.ToJSON(txn).String()))
-		return nil, err
+		return err
 	}
 
 	IncTransactionCount()
-	return txn, nil
+	return nil
 }
 
 func PutTransactionWithoutVerifySig(ctx context.Context, entity datastore.Entity) (interface{}, error) {
@@ -0,0 +1,221 @@
+package transaction
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/0chain/common/core/logging"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/memorystore"
+)
+
+// MaxPendingPerClient is the max number of transactions with future nonces
+// queued for a client
+var MaxPendingPerClient = 16
+
+// SetMaxPendingPerClient sets the max number of transactions with future
+// nonces queued for a client
+func SetMaxPendingPerClient(max int) {
+	MaxPendingPerClient = max
+}
+
+// ErrPendingQueueFull is returned for a transaction with a future nonce of a
+// client having the max number of transactions queued
+var ErrPendingQueueFull = common.NewError("pending_queue_full",
+	"too many transactions with future nonces are queued for the client")
+
+// pendingQueue holds the transactions with future nonces per client until
+// the nonce gaps close, the transactions of a client are ordered by nonce
+type pendingQueue struct {
+	mutex   sync.Mutex
+	clients map[datastore.Key][]*Transaction
+}
+
+var pendingTxns = pendingQueue{clients: make(map[datastore.Key][]*Transaction)}
+
+// add queues the transaction, a queued transaction of the same nonce is
+// replaced and returned
+func (pq *pendingQueue) add(txn *Transaction) (*Transaction, error) {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	queued := pq.clients[txn.ClientID]
+	i := sort.Search(len(queued), func(i int) bool { return queued[i].Nonce >= txn.Nonce })
+	if i < len(queued) && queued[i].Nonce == txn.Nonce {
+		replaced := queued[i]
+		if replaced.Hash == txn.Hash {
+			return nil, nil
+		}
+		if !txn.IsCancel() && txn.FeeCap() < replaced.ReplacementFeeCap() {
+			return nil, ErrReplacementUnderpriced
+		}
+		queued[i] = txn
+		return replaced, nil
+	}
+	if len(queued) >= MaxPendingPerClient {
+		return nil, ErrPendingQueueFull
+	}
+
+	queued = append(queued, nil)
+	copy(queued[i+1:], queued[i:])
+	queued[i] = txn
+	pq.clients[txn.ClientID] = queued
+	return nil, nil
+}
+
+// popNext removes the queued transactions of the client with nonces up to
+// the given one and returns the queued transactions following the nonce
+// without gaps
+func (pq *pendingQueue) popNext(clientID string, nonce int64) (next []*Transaction) {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	queued := pq.clients[clientID]
+	i := 0
+	for ; i < len(queued); i++ {
+		if queued[i].Nonce > nonce+1 {
+			break
+		}
+		if queued[i].Nonce == nonce+1 {
+			next = append(next, queued[i])
+			nonce++
+		}
+	}
+	if i == len(queued) {
+		delete(pq.clients, clientID)
+	} else {
+		pq.clients[clientID] = queued[i:]
+	}
+	return
+}
+
+// has reports the client has queued transactions
+func (pq *pendingQueue) has(clientID string) bool {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+	return len(pq.clients[clientID]) > 0
+}
+
+// list returns the queued transactions of the client
+func (pq *pendingQueue) list(clientID string) []*Transaction {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	queued := pq.clients[clientID]
+	list := make([]*Transaction, len(queued))
+	copy(list, queued)
+	return list
+}
+
+// expire removes the expired queued transactions and the ones not within
+// the time tolerance
+func (pq *pendingQueue) expire() (expired int) {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	now := common.Now()
+	for clientID, queued := range pq.clients {
+		valid := queued[:0]
+		for _, txn := range queued {
+			if common.Within(int64(txn.CreationDate), TXN_TIME_TOLERANCE-1) && !txn.IsExpired(now) {
+				valid = append(valid, txn)
+			}
+		}
+		expired += len(queued) - len(valid)
+		if len(valid) == 0 {
+			delete(pq.clients, clientID)
+			continue
+		}
+		pq.clients[clientID] = valid
+	}
+	return
+}
+
+// IsNoncePooled reports the transaction of the client with the nonce is in
+// the transactions pool
+func IsNoncePooled(ctx context.Context, clientID string, nonce int64) bool {
+	con := memorystore.GetEntityCon(ctx, transactionEntityMetadata)
+	if con == nil {
+		return false
+	}
+	ok, err := redis.Bool(con.Do("EXISTS", poolNonceKey(clientID, nonce)))
+	if err != nil {
+		logging.Logger.Error("is nonce pooled", zap.String("client", clientID),
+			zap.Int64("nonce", nonce), zap.Error(err))
+		return false
+	}
+	return ok
+}
+
+/*QueueTransaction - Given a transaction with a future nonce, it queues it until the nonce gap closes */
+func QueueTransaction(ctx context.Context, txn *Transaction) (interface{}, error) {
+	if err := validatePut(ctx, txn); err != nil {
+		return nil, err
+	}
+	replaced, err := pendingTxns.add(txn)
+	if err != nil {
+		logging.Logger.Error("queue transaction error", zap.String("txn", txn.Hash), zap.Error(err))
+		return nil, err
+	}
+	if replaced != nil {
+		ev := PoolEvent{
+			Type:     PoolEventReplaced,
+			ClientID: txn.ClientID,
+			Nonce:    txn.Nonce,
+			Hash:     replaced.Hash,
+			ByHash:   txn.Hash,
+			Time:     common.Now(),
+		}
+		if txn.IsCancel() {
+			ev.Type = PoolEventCancelled
+		}
+		recentPoolEvents.add(ev)
+	}
+	logging.Logger.Debug("queue transaction", zap.String("txn", txn.Hash),
+		zap.String("client", txn.ClientID), zap.Int64("nonce", txn.Nonce))
+	return txn, nil
+}
+
+// promotePending moves the queued transactions of the client following the
+// nonce without gaps, skipping the pooled nonces, to the transactions pool
+func promotePending(ctx context.Context, clientID string, nonce int64) {
+	for pendingTxns.has(clientID) {
+		next := pendingTxns.popNext(clientID, nonce)
+		if len(next) == 0 {
+			if !IsNoncePooled(ctx, clientID, nonce+1) {
+				return
+			}
+			nonce++
+			continue
+		}
+		for _, txn := range next {
+			if !common.Within(int64(txn.CreationDate), TXN_TIME_TOLERANCE-1) ||
+				txn.IsExpired(common.Now()) {
+				continue
+			}
+			if err := storePooled(ctx, txn); err != nil {
+				logging.Logger.Error("promote pending transaction",
+					zap.String("txn", txn.Hash), zap.Error(err))
+				continue
+			}
+			logging.Logger.Debug("promote pending transaction", zap.String("txn", txn.Hash),
+				zap.String("client", txn.ClientID), zap.Int64("nonce", txn.Nonce))
+		}
+		nonce = next[len(next)-1].Nonce
+	}
+}
+
+/*GetPendingTransactions - returns the transactions of the client queued for nonce gaps to close */
+func GetPendingTransactions(ctx context.Context, r *http.Request) (interface{}, error) {
+	clientID := r.FormValue("client_id")
+	if clientID == "" {
+		return nil, common.NewErrBadRequest("client_id is required")
+	}
+	return pendingTxns.list(clientID), nil
+}
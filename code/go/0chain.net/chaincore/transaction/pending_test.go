@@ -0,0 +1,99 @@
+package transaction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/core/common"
+)
+
+func pendingNonces(txns []*Transaction) (nonces []int64) {
+	for _, txn := range txns {
+		nonces = append(nonces, txn.Nonce)
+	}
+	return
+}
+
+func TestPendingQueue(t *testing.T) {
+	var (
+		pq  = pendingQueue{clients: make(map[string][]*Transaction)}
+		now = common.Now()
+	)
+	SetTxnTimeout(60)
+	defer SetMaxPendingPerClient(MaxPendingPerClient)
+	SetMaxPendingPerClient(4)
+
+	for _, nonce := range []int64{5, 3, 4, 8} {
+		replaced, err := pq.add(&Transaction{ClientID: "a", Nonce: nonce,
+			Fee: 100, CreationDate: now})
+		require.NoError(t, err)
+		require.Nil(t, replaced)
+	}
+	assert.Equal(t, []int64{3, 4, 5, 8}, pendingNonces(pq.list("a")))
+
+	_, err := pq.add(&Transaction{ClientID: "a", Nonce: 9, Fee: 100})
+	assert.Equal(t, ErrPendingQueueFull, err)
+
+	// replacement of the same nonce doesn't count
+	txn := &Transaction{ClientID: "a", Nonce: 4, Fee: 105, CreationDate: now}
+	txn.Hash = "bumped"
+	_, err = pq.add(txn)
+	assert.Equal(t, ErrReplacementUnderpriced, err)
+	txn.Fee = 110
+	replaced, err := pq.add(txn)
+	require.NoError(t, err)
+	require.NotNil(t, replaced)
+	assert.Equal(t, "bumped", pq.list("a")[1].Hash)
+
+	// the gap at 6 keeps the 8
+	assert.Equal(t, []int64{3, 4, 5}, pendingNonces(pq.popNext("a", 2)))
+	assert.Equal(t, []int64{8}, pendingNonces(pq.list("a")))
+	assert.Empty(t, pq.popNext("a", 6))
+	assert.True(t, pq.has("a"))
+
+	// the stale transactions are dropped
+	assert.Empty(t, pq.popNext("a", 10))
+	assert.False(t, pq.has("a"))
+
+	_, err = pq.add(&Transaction{ClientID: "b", Nonce: 2, CreationDate: now - 120})
+	require.NoError(t, err)
+	_, err = pq.add(&Transaction{ClientID: "b", Nonce: 3, CreationDate: now})
+	require.NoError(t, err)
+	assert.Equal(t, 1, pq.expire())
+	assert.Equal(t, []int64{3}, pendingNonces(pq.list("b")))
+}
+
+func TestPromotePending(t *testing.T) {
+	var (
+		ctx = setupPoolStore(t)
+		c   = newPoolClient(t)
+		now = common.Now()
+	)
+
+	queue := func(hash string, nonce int64) {
+		txn := newPoolTxn(c, hash, nonce, 100)
+		txn.CreationDate = now
+		_, err := pendingTxns.add(txn)
+		require.NoError(t, err)
+	}
+	queue("q3", 3)
+	queue("q4", 4)
+	queue("q6", 6)
+
+	// the pooled nonce 2 closes the gap up to the 5
+	require.NoError(t, putPoolTxn(ctx, newPoolTxn(c, "p2", 2, 100)))
+	promotePending(ctx, c.GetKey(), 2)
+	assert.True(t, isPooled(ctx, "q3"))
+	assert.True(t, isPooled(ctx, "q4"))
+	assert.False(t, isPooled(ctx, "q6"))
+	assert.True(t, IsNoncePooled(ctx, c.GetKey(), 4))
+	assert.Equal(t, []int64{6}, pendingNonces(pendingTxns.list(c.GetKey())))
+
+	// finalized nonce 1 with the pooled nonces 2 to 5
+	require.NoError(t, putPoolTxn(ctx, newPoolTxn(c, "p5", 5, 100)))
+	promotePending(ctx, c.GetKey(), 1)
+	assert.True(t, isPooled(ctx, "q6"))
+	assert.False(t, pendingTxns.has(c.GetKey()))
+}
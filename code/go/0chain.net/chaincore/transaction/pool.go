@@ -0,0 +1,179 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/logging"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/memorystore"
+)
+
+const (
+	// MinReplacementFeeBump is the percentage the fee cap of a transaction
+	// replacing a pooled one should exceed the pooled fee cap by
+	MinReplacementFeeBump = 10
+	// MaxPoolEvents is the number of the latest pool events kept
+	MaxPoolEvents = 1000
+)
+
+// pool event types
+const (
+	PoolEventReplaced  = "replaced"
+	PoolEventCancelled = "cancelled"
+)
+
+// ErrReplacementUnderpriced is returned for a transaction with the nonce of a
+// pooled transaction not paying enough more to replace it
+var ErrReplacementUnderpriced = common.NewError("replacement_underpriced",
+	"transaction with the same nonce is already in the pool, "+
+		"the fee should be bumped to replace it")
+
+// PoolEvent is a pooled transaction removed by a transaction of the same
+// client and nonce
+type PoolEvent struct {
+	Type     string           `json:"type"`
+	ClientID string           `json:"client_id"`
+	Nonce    int64            `json:"nonce"`
+	Hash     string           `json:"hash"`    // the removed transaction
+	ByHash   string           `json:"by_hash"` // the transaction removed it
+	Time     common.Timestamp `json:"timestamp"`
+}
+
+// poolEvents keeps the latest pool events
+type poolEvents struct {
+	mutex  sync.RWMutex
+	events []PoolEvent
+}
+
+var recentPoolEvents poolEvents
+
+func (pe *poolEvents) add(ev PoolEvent) {
+	pe.mutex.Lock()
+	defer pe.mutex.Unlock()
+
+	pe.events = append(pe.events, ev)
+	if len(pe.events) > MaxPoolEvents {
+		pe.events = pe.events[len(pe.events)-MaxPoolEvents:]
+	}
+}
+
+// list returns the events of the client, of all clients if it's empty
+func (pe *poolEvents) list(clientID string) []PoolEvent {
+	pe.mutex.RLock()
+	defer pe.mutex.RUnlock()
+
+	events := make([]PoolEvent, 0, len(pe.events))
+	for _, ev := range pe.events {
+		if clientID == "" || ev.ClientID == clientID {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+// IsCancel reports the transaction is a zero value send to the client itself,
+// it cancels the pooled transaction of the same nonce
+func (t *Transaction) IsCancel() bool {
+	return t.TransactionType == TxnTypeSend && t.Value == 0 &&
+		t.ClientID != "" && t.ClientID == t.ToClientID
+}
+
+// ReplacementFeeCap returns the min fee cap of a transaction replacing the
+// pooled one
+func (t *Transaction) ReplacementFeeCap() currency.Coin {
+	feeCap := t.FeeCap()
+	bump := feeCap * MinReplacementFeeBump / 100
+	if bump == 0 {
+		bump = 1
+	}
+	min, err := currency.AddCoin(feeCap, bump)
+	if err != nil {
+		return feeCap
+	}
+	return min
+}
+
+// poolNonceKey is the redis key of the hash of the pooled transaction of the
+// client with the nonce
+func poolNonceKey(clientID string, nonce int64) string {
+	return fmt.Sprintf("txn_nonce:%s:%d", clientID, nonce)
+}
+
+// replacePooled removes the pooled transaction of the client with the nonce
+// of the given transaction, if any, and indexes the given transaction by its
+// nonce. It fails if the given transaction neither cancels the pooled one nor
+// pays enough more to replace it.
+func replacePooled(ctx context.Context, txn *Transaction) error {
+	var (
+		emd = txn.GetEntityMetadata()
+		con = memorystore.GetEntityCon(ctx, emd)
+		key = poolNonceKey(txn.ClientID, txn.Nonce)
+	)
+	if con == nil {
+		return nil
+	}
+
+	pooledHash, err := redis.String(con.Do("GET", key))
+	if err != nil && err != redis.ErrNil {
+		return err
+	}
+
+	if pooledHash != "" && pooledHash != txn.Hash {
+		pooled := emd.Instance().(*Transaction)
+		err := emd.GetStore().Read(ctx, pooledHash, pooled)
+		if err == nil {
+			if err := removePooled(ctx, pooled, txn); err != nil {
+				return err
+			}
+		} else if cerr, ok := err.(*common.Error); !ok || cerr.Code != datastore.EntityNotFound {
+			return err
+		}
+		// otherwise the pooled transaction is included in a block or expired
+	}
+
+	_, err = con.Do("SET", key, txn.Hash, "EX", TXN_TIME_TOLERANCE)
+	return err
+}
+
+// removePooled removes the pooled transaction replaced or cancelled by the
+// given one
+func removePooled(ctx context.Context, pooled, txn *Transaction) error {
+	ev := PoolEvent{
+		Type:     PoolEventReplaced,
+		ClientID: txn.ClientID,
+		Nonce:    txn.Nonce,
+		Hash:     pooled.Hash,
+		ByHash:   txn.Hash,
+		Time:     common.Now(),
+	}
+	if txn.IsCancel() {
+		ev.Type = PoolEventCancelled
+	} else if txn.FeeCap() < pooled.ReplacementFeeCap() {
+		return ErrReplacementUnderpriced
+	}
+
+	if err := pooled.GetEntityMetadata().GetStore().Delete(ctx, pooled); err != nil {
+		return err
+	}
+	recentPoolEvents.add(ev)
+	logging.Logger.Info("put transaction - pooled transaction removed",
+		zap.String("event", ev.Type),
+		zap.String("client", ev.ClientID),
+		zap.Int64("nonce", ev.Nonce),
+		zap.String("txn", ev.Hash),
+		zap.String("by_txn", ev.ByHash))
+	return nil
+}
+
+/*GetPoolEvents - returns the latest pooled transactions replaced or cancelled */
+func GetPoolEvents(ctx context.Context, r *http.Request) (interface{}, error) {
+	return recentPoolEvents.list(r.FormValue("client_id")), nil
+}
@@ -0,0 +1,139 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/logging"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"0chain.net/chaincore/client"
+	"0chain.net/chaincore/config"
+	"0chain.net/chaincore/config/mocks"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/core/memorystore"
+)
+
+func setupPoolStore(t *testing.T) context.Context {
+	logging.Logger = zap.NewNop()
+
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	t.Cleanup(mr.Close)
+
+	common.SetupRootContext(context.Background())
+	memorystore.AddPool("txndb", &redis.Pool{
+		MaxIdle: 10,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", mr.Addr())
+		},
+	})
+	SetupEntity(memorystore.GetStorageProvider())
+	SetTxnTimeout(60)
+
+	conf := mocks.NewChainConfig(t)
+	conf.On("IsFeeEnabled").Return(false).Maybe()
+	config.Configuration().ChainConfig = conf
+
+	ctx := memorystore.WithEntityConnection(context.Background(), transactionEntityMetadata)
+	t.Cleanup(func() { memorystore.Close(ctx) })
+	return ctx
+}
+
+func putPoolTxn(ctx context.Context, txn *Transaction) error {
+	if err := replacePooled(ctx, txn); err != nil {
+		return err
+	}
+	return transactionEntityMetadata.GetStore().Write(ctx, txn)
+}
+
+func newPoolClient(t *testing.T) *client.Client {
+	sigScheme := encryption.GetSignatureScheme(clientSignatureScheme)
+	require.NoError(t, sigScheme.GenerateKeys())
+	c := &client.Client{}
+	require.NoError(t, c.SetPublicKey(sigScheme.GetPublicKey()))
+	return c
+}
+
+func newPoolTxn(c *client.Client, hash string, nonce int64, fee currency.Coin) *Transaction {
+	txn := transactionEntityMetadata.Instance().(*Transaction)
+	txn.Hash = hash
+	txn.ClientID = c.GetKey()
+	txn.PublicKey = c.PublicKey
+	txn.ToClientID = "to_client"
+	txn.TransactionType = TxnTypeSend
+	txn.Value = 10
+	txn.Nonce = nonce
+	txn.Fee = fee
+	return txn
+}
+
+func isPooled(ctx context.Context, hash string) bool {
+	txn := transactionEntityMetadata.Instance().(*Transaction)
+	err := transactionEntityMetadata.GetStore().Read(ctx, hash, txn)
+	if cerr, ok := err.(*common.Error); ok && cerr.Code == datastore.EntityNotFound {
+		return false
+	}
+	return err == nil
+}
+
+func TestReplacePooled(t *testing.T) {
+	var (
+		ctx = setupPoolStore(t)
+		c   = newPoolClient(t)
+	)
+
+	require.NoError(t, putPoolTxn(ctx, newPoolTxn(c, "a1", 1, 100)))
+	require.NoError(t, putPoolTxn(ctx, newPoolTxn(c, "b2", 2, 100)))
+
+	// resubmitted transaction
+	require.NoError(t, putPoolTxn(ctx, newPoolTxn(c, "a1", 1, 100)))
+	assert.True(t, isPooled(ctx, "a1"))
+
+	// the fee is not bumped enough
+	err := putPoolTxn(ctx, newPoolTxn(c, "a2", 1, 109))
+	assert.Equal(t, ErrReplacementUnderpriced, err)
+	assert.True(t, isPooled(ctx, "a1"))
+
+	// replaced by the bumped max fee
+	replacement := newPoolTxn(c, "a3", 1, 0)
+	replacement.MaxFee, replacement.PriorityFee = 110, 10
+	require.NoError(t, putPoolTxn(ctx, replacement))
+	assert.False(t, isPooled(ctx, "a1"))
+	assert.True(t, isPooled(ctx, "a3"))
+
+	// cancelled by zero value self send
+	cancel := newPoolTxn(c, "b3", 2, 1)
+	cancel.ToClientID, cancel.Value = cancel.ClientID, 0
+	require.NoError(t, putPoolTxn(ctx, cancel))
+	assert.False(t, isPooled(ctx, "b2"))
+	assert.True(t, isPooled(ctx, "b3"))
+
+	events := recentPoolEvents.list(c.GetKey())
+	require.Len(t, events, 2)
+	assert.Equal(t, PoolEventReplaced, events[0].Type)
+	assert.Equal(t, "a1", events[0].Hash)
+	assert.Equal(t, "a3", events[0].ByHash)
+	assert.Equal(t, PoolEventCancelled, events[1].Type)
+	assert.Equal(t, "b2", events[1].Hash)
+	assert.EqualValues(t, 2, events[1].Nonce)
+	assert.Empty(t, recentPoolEvents.list("other_client"))
+}
+
+func TestIsCancel(t *testing.T) {
+	assert.True(t, (&Transaction{ClientID: "a", ToClientID: "a",
+		TransactionType: TxnTypeSend}).IsCancel())
+	assert.False(t, (&Transaction{ClientID: "a", ToClientID: "a",
+		TransactionType: TxnTypeSend, Value: 1}).IsCancel())
+	assert.False(t, (&Transaction{ClientID: "a", ToClientID: "b",
+		TransactionType: TxnTypeSend}).IsCancel())
+	assert.False(t, (&Transaction{ClientID: "a", ToClientID: "a",
+		TransactionType: TxnTypeData}).IsCancel())
+}
@@ -0,0 +1,70 @@
+package transaction
+
+import (
+	"context"
+
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+)
+
+// IsSponsored reports the fee of the transaction is paid by the fee payer
+func (t *Transaction) IsSponsored() bool {
+	return t.FeePayerID != ""
+}
+
+// FeeClientID returns the client paying the fee of the transaction
+func (t *Transaction) FeeClientID() string {
+	if t.IsSponsored() {
+		return t.FeePayerID
+	}
+	return t.ClientID
+}
+
+// validateFeePayer validates the fee payer of a sponsored transaction
+func (t *Transaction) validateFeePayer() error {
+	if !t.IsSponsored() {
+		return nil
+	}
+	if !encryption.IsHash(t.FeePayerID) {
+		return common.InvalidRequest("fee payer id must be a hexadecimal hash")
+	}
+	if t.FeePayerID == t.ClientID || t.FeePayerID == t.ToClientID {
+		return common.InvalidRequest("fee payer should be different from the from and to clients")
+	}
+	if t.FeePayerPublicKey == "" || t.FeePayerSignature == "" {
+		return common.InvalidRequest("fee payer public key and signature required for sponsored transaction")
+	}
+	if t.FeePayerNonce <= 0 {
+		return common.InvalidRequest("invalid fee payer nonce")
+	}
+	return nil
+}
+
+/*SignFeePayer - given the fee payer signature scheme, sign the hash of the sponsored transaction */
+func (t *Transaction) SignFeePayer(signatureScheme encryption.SignatureScheme) error {
+	signature, err := signatureScheme.Sign(t.Hash)
+	if err != nil {
+		return err
+	}
+	t.FeePayerSignature = signature
+	return nil
+}
+
+/*VerifyFeePayerSignature - verify the fee payer signature of the transaction hash */
+func (t *Transaction) VerifyFeePayerSignature(ctx context.Context) error {
+	if err := encryption.VerifyPublicKeyClientID(t.FeePayerPublicKey, t.FeePayerID); err != nil {
+		return common.NewError("invalid_fee_payer_public_key", err.Error())
+	}
+	sigScheme, err := getSignatureScheme(t.FeePayerID, t.FeePayerPublicKey)
+	if err != nil {
+		return err
+	}
+	correctSignature, err := sigScheme.Verify(t.FeePayerSignature, t.Hash)
+	if err != nil {
+		return err
+	}
+	if !correctSignature {
+		return common.NewError("invalid_fee_payer_signature", "Invalid fee payer signature")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/client"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/core/memorystore"
+)
+
+func newSponsoredTxn(t *testing.T) (*Transaction, encryption.SignatureScheme) {
+	sender := encryption.GetSignatureScheme(clientSignatureScheme)
+	require.NoError(t, sender.GenerateKeys())
+	sponsor := encryption.GetSignatureScheme(clientSignatureScheme)
+	require.NoError(t, sponsor.GenerateKeys())
+
+	senderID, err := client.GetIDFromPublicKey(sender.GetPublicKey())
+	require.NoError(t, err)
+	sponsorID, err := client.GetIDFromPublicKey(sponsor.GetPublicKey())
+	require.NoError(t, err)
+
+	txn := &Transaction{
+		ClientID:          senderID,
+		PublicKey:         sender.GetPublicKey(),
+		ToClientID:        encryption.Hash("to"),
+		CreationDate:      common.Now(),
+		Fee:               10,
+		Nonce:             1,
+		FeePayerID:        sponsorID,
+		FeePayerPublicKey: sponsor.GetPublicKey(),
+		FeePayerNonce:     1,
+	}
+	_, err = txn.Sign(sender)
+	require.NoError(t, err)
+	require.NoError(t, txn.SignFeePayer(sponsor))
+	return txn, sponsor
+}
+
+func TestSponsoredTransaction(t *testing.T) {
+	client.SetupEntity(memorystore.GetStorageProvider())
+
+	var (
+		ctx          = context.Background()
+		txn, sponsor = newSponsoredTxn(t)
+	)
+	require.NoError(t, txn.validateFeePayer())
+	require.NoError(t, txn.VerifySignature(ctx))
+	require.Equal(t, txn.FeePayerID, txn.FeeClientID())
+
+	// the sender commits to the fee payer
+	feePayerID := txn.FeePayerID
+	txn.FeePayerID = ""
+	require.NotEqual(t, txn.Hash, txn.ComputeHash())
+	require.Equal(t, txn.ClientID, txn.FeeClientID())
+	txn.FeePayerID = feePayerID
+
+	// the fee and the fee payer nonce can't be changed by a relay
+	fee := txn.Fee
+	txn.Fee = 20
+	require.NotEqual(t, txn.Hash, txn.ComputeHash())
+	txn.Fee = fee
+	txn.FeePayerNonce = 2
+	require.NotEqual(t, txn.Hash, txn.ComputeHash())
+	txn.FeePayerNonce = 1
+	require.Equal(t, txn.Hash, txn.ComputeHash())
+
+	// the fee payer signs the transaction hash
+	other, _ := newSponsoredTxn(t)
+	require.NoError(t, other.SignFeePayer(sponsor))
+	txn.FeePayerSignature = other.FeePayerSignature
+	require.Error(t, txn.VerifyFeePayerSignature(ctx))
+
+	// the public key of another client
+	txn.FeePayerPublicKey = other.FeePayerPublicKey
+	require.Error(t, txn.VerifyFeePayerSignature(ctx))
+
+	txn.FeePayerID = txn.ClientID
+	require.Error(t, txn.validateFeePayer())
+	txn.FeePayerID, txn.FeePayerSignature = feePayerID, ""
+	require.Error(t, txn.validateFeePayer())
+	txn.FeePayerSignature, txn.FeePayerNonce = other.FeePayerSignature, 0
+	require.Error(t, txn.validateFeePayer())
+}
@@ -195,3 +195,31 @@ func processWorker(txnChannel <-chan *Transaction, done chan<- bool) {
 		done <- true
 	}
 }
+
+func TestTransactionExpiry(t *testing.T) {
+	var (
+		now = common.Now()
+		txn = &Transaction{ClientID: encryption.Hash("a"),
+			ToClientID: encryption.Hash("b"), CreationDate: now}
+	)
+	txn.Hash = "hash"
+	SetTxnTimeout(60)
+	config.SetServerChainID(config.GetMainChainID())
+
+	// no expiry
+	legacy := txn.HashData()
+	require.False(t, txn.IsExpired(now+3600))
+
+	// the expiry is signed
+	txn.ExpiresAt = now + 10
+	require.NotEqual(t, legacy, txn.HashData())
+	require.False(t, txn.IsExpired(now+10))
+	require.True(t, txn.IsExpired(now+11))
+	require.Equal(t, txn.ExpiresAt, txn.Clone().ExpiresAt)
+
+	ctx := context.Background()
+	require.Equal(t, ErrTxnExpired, txn.ValidateWrtTimeForBlock(ctx, now+11, false))
+
+	txn.ExpiresAt = now - 1
+	require.Error(t, txn.ValidateWrtTimeForBlock(ctx, now-2, false))
+}
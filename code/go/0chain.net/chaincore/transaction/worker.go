@@ -41,7 +41,7 @@ func CleanupWorker(ctx context.Context) {
 				logging.Logger.Error("Error in deleting txn in redis", zap.Error(err))
 			}
 		}
-		if !common.Within(int64(txn.CreationDate), TXN_TIME_TOLERANCE-1) {
+		if !common.Within(int64(txn.CreationDate), TXN_TIME_TOLERANCE-1) || txn.IsExpired(common.Now()) {
 			invalidTxns = append(invalidTxns, txn)
 		}
 		err := transactionEntityMetadata.GetStore().Read(ctx, txn.Hash, txn)
@@ -57,6 +57,9 @@ func CleanupWorker(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			if expired := pendingTxns.expire(); expired > 0 {
+				logging.Logger.Info("pending transactions cleanup", zap.Int("expired_count", expired))
+			}
 			err := mstore.IterateCollectionAsc(cctx, transactionEntityMetadata, collectionName, handler)
 			if err != nil {
 				logging.Logger.Error("Error in IterateCollectionAsc", zap.Error(err))
@@ -130,4 +133,8 @@ func RemoveFromPool(ctx context.Context, txns []datastore.Entity) {
 	if err != nil {
 		logging.Logger.Error("Error in MultiDeleteFromCollection", zap.Error(err))
 	}
+
+	for clientID, nonce := range clientMaxNonce {
+		promotePending(cctx, clientID, nonce)
+	}
 }
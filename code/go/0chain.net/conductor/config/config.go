@@ -30,6 +30,7 @@ const (
 	PhaseStart      = iota //
 	PhaseContribute        //
 	PhaseShare             //
+	PhaseComplain          //
 	PhasePublish           //
 	PhaseWait              //
 )
@@ -42,6 +43,8 @@ func ParsePhase(ps string) (ph Phase, err error) {
 		return PhaseContribute, nil
 	case "share":
 		return PhaseShare, nil
+	case "complain":
+		return PhaseComplain, nil
 	case "publish":
 		return PhasePublish, nil
 	case "wait":
@@ -59,6 +62,8 @@ func (p Phase) String() string {
 		return "contribute"
 	case PhaseShare:
 		return "share"
+	case PhaseComplain:
+		return "complain"
 	case PhasePublish:
 		return "publish"
 	case PhaseWait:
@@ -74,6 +74,7 @@ func main() {
 
 	config.Configuration().ChainID = viper.GetString("server_chain.id")
 	transaction.SetTxnTimeout(int64(viper.GetInt("server_chain.transaction.timeout")))
+	transaction.SetMaxPendingPerClient(viper.GetInt("server_chain.transaction.max_pending_per_client"))
 
 	config.SetServerChainID(config.Configuration().ChainID)
 
@@ -273,6 +274,14 @@ func main() {
 	}
 
 	mb = mc.GetLatestMagicBlock()
+
+	// refuse to run if a hard fork is active and the build doesn't know it
+	var startRound = mc.GetCurrentRound()
+	if mb.StartingRound > startRound {
+		startRound = mb.StartingRound
+	}
+	mc.CheckHardForks(startRound)
+
 	if mb.StartingRound == 0 && mb.IsActiveNode(node.Self.Underlying().GetKey(), mb.StartingRound) {
 		genesisDKG := viper.GetInt64("network.genesis_dkg")
 		var (
@@ -19,12 +19,15 @@ import (
 	"0chain.net/chaincore/client"
 	"0chain.net/chaincore/config"
 	"0chain.net/chaincore/node"
+	"0chain.net/chaincore/smartcontract"
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
 	"0chain.net/core/encryption"
 	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/schedulersc"
 	"0chain.net/smartcontract/storagesc"
+	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/logging"
 	"github.com/0chain/common/core/util"
 )
@@ -131,11 +134,42 @@ func (mc *Chain) createGenerateChallengeTxn(b *block.Block) *transaction.Transac
 	return brTxn
 }
 
+// createScheduledCallTxns creates transactions executing the calls of the
+// scheduler SC due in the block
+func (mc *Chain) createScheduledCallTxns(b *block.Block, state util.MerklePatriciaTrieI) []*transaction.Transaction {
+	if smartcontract.GetSmartContract(schedulersc.ADDRESS) == nil {
+		return nil
+	}
+
+	ids, err := schedulersc.DueCalls(state, b.Round, b.CreationDate)
+	if err != nil {
+		logging.Logger.Error("can't get due scheduled calls",
+			zap.Int64("round", b.Round), zap.Error(err))
+		return nil
+	}
+
+	txns := make([]*transaction.Transaction, 0, len(ids))
+	for _, id := range ids {
+		scTxn := transaction.Provider().(*transaction.Transaction)
+		scTxn.ClientID = b.MinerID
+		scTxn.ToClientID = schedulersc.ADDRESS
+		scTxn.CreationDate = b.CreationDate
+		scTxn.TransactionType = transaction.TxnTypeSmartContract
+		scTxn.TransactionData = fmt.Sprintf(`{"name":"execute","input":{"call_id":%q}}`, id)
+		scTxn.Fee = 0
+		txns = append(txns, scTxn)
+	}
+	return txns
+}
+
 func (mc *Chain) validateTransaction(b *block.Block,
 	bState util.MerklePatriciaTrieI, txn *transaction.Transaction, waitC chan struct{}) error {
 	if !common.WithinTime(int64(b.CreationDate), int64(txn.CreationDate), transaction.TXN_TIME_TOLERANCE) {
 		return ErrNotTimeTolerant
 	}
+	if txn.IsExpired(b.CreationDate) {
+		return transaction.ErrTxnExpired
+	}
 	state, err := mc.GetStateById(bState, txn.ClientID)
 	if err != nil {
 		if err == util.ErrValueNotPresent {
@@ -183,7 +217,8 @@ func (mc *Chain) UpdatePendingBlock(ctx context.Context, b *block.Block, txns []
 
 func (mc *Chain) verifySmartContracts(ctx context.Context, b *block.Block) error {
 	for _, txn := range b.Txns {
-		if txn.TransactionType == transaction.TxnTypeSmartContract {
+		if txn.TransactionType == transaction.TxnTypeSmartContract ||
+			txn.TransactionType == transaction.TxnTypeMultiCall {
 			err := txn.VerifyOutputHash(ctx)
 			if err != nil {
 				logging.Logger.Error("Smart contract output verification failed", zap.Error(err), zap.String("output", txn.TransactionOutput))
@@ -717,6 +752,15 @@ func txnProcessorHandlerFunc(mc *Chain, b *block.Block) txnProcessorHandler {
 		}
 		var debugTxn = txn.DebugTxn()
 
+		if err := txn.ValidateBaseFee(mc.ChainConfig.TxnExempt(), tii.baseFee); err != nil {
+			if debugTxn {
+				logging.Logger.Info("generate block (debug transaction) error, fee is less than base fee",
+					zap.String("txn", txn.Hash), zap.Int32("idx", tii.idx),
+					zap.Any("base_fee", tii.baseFee), zap.Error(err))
+			}
+			return false, nil
+		}
+
 		err := mc.validateTransaction(b, bState, txn, waitC)
 		switch err {
 		case PastTransaction:
@@ -732,8 +776,8 @@ func txnProcessorHandlerFunc(mc *Chain, b *block.Block) txnProcessorHandler {
 			list = append(list, txn)
 			sort.SliceStable(list, func(i, j int) bool {
 				if list[i].Nonce == list[j].Nonce {
-					//if the same nonce order by fee
-					return list[i].Fee > list[j].Fee
+					//if the same nonce order by tip
+					return list[i].EffectiveTip(tii.baseFee) > list[j].EffectiveTip(tii.baseFee)
 				}
 				return list[i].Nonce < list[j].Nonce
 			})
@@ -748,6 +792,14 @@ func txnProcessorHandlerFunc(mc *Chain, b *block.Block) txnProcessorHandler {
 					zap.Any("now", common.Now()))
 			}
 			return false, nil
+		case transaction.ErrTxnExpired:
+			tii.invalidTxns = append(tii.invalidTxns, txn)
+			if debugTxn {
+				logging.Logger.Info("generate block (debug transaction) error - txn expired",
+					zap.String("txn", txn.Hash), zap.Int32("idx", tii.idx),
+					zap.Any("expires_at", txn.ExpiresAt))
+			}
+			return false, nil
 		default:
 			if err != nil && cstate.ErrInvalidState(err) {
 				return false, err // return err to break the txns pool iteration
@@ -822,6 +874,8 @@ type TxnIterInfo struct {
 	byteSize int64
 	// accumulated transaction cost
 	cost int
+	// base fee the transactions of the block pay
+	baseFee currency.Coin
 }
 
 func (tii *TxnIterInfo) checkForCurrent(txn *transaction.Transaction) {
@@ -973,6 +1027,11 @@ func (mc *Chain) generateBlock(ctx context.Context, b *block.Block,
 	)
 
 	iterInfo.roundTimeoutCount = mc.GetRoundTimeoutCount()
+	if mc.ChainConfig.IsFeeEnabled() {
+		if iterInfo.baseFee, err = mc.GetNextBaseFee(b.PrevBlock); err != nil {
+			return fmt.Errorf("get base fee failed: %v", err)
+		}
+	}
 
 	start := time.Now()
 	b.CreationDate = common.Now()
@@ -1228,6 +1287,8 @@ func (mc *Chain) buildInTxns(ctx context.Context, lfb, b *block.Block, state uti
 		txns = append(txns, mc.storageScCommitSettingChangesTx(b))
 	}
 
+	txns = append(txns, mc.createScheduledCallTxns(b, state)...)
+
 	var cost int
 	for _, txn := range txns {
 		c, err := mc.EstimateTransactionCost(ctx, lfb, lfb.ClientState, txn, chain.WithSync())
@@ -107,12 +107,20 @@ func (mc *Chain) SetDKGSFromStore(ctx context.Context, mb *block.MagicBlock) (
 		}
 	}
 
-	if !newDKG.HasAllSecretShares() {
+	var enough = newDKG.HasAllSecretShares()
+	if mb.Reshared {
+		// the interpolation needs the shares of all the dealers
+		enough = newDKG.GetSecretSharesSize() == len(mb.Mpks.Mpks)
+		newDKG.SetReshare(magicBlockParties(mb))
+	}
+	if !enough {
 		return common.NewError("failed to set dkg from store",
 			"not enough secret shares for dkg")
 	}
 
-	newDKG.AggregateSecretKeyShares()
+	if err := newDKG.AggregateSecretKeyShares(); err != nil {
+		return err
+	}
 	newDKG.Pi = newDKG.Si.GetPublicKey()
 	mpks, err := mb.Mpks.GetMpkMap()
 	if err != nil {
@@ -28,7 +28,7 @@ func (mc *Chain) handleVRFShare(ctx context.Context, msg *BlockMessage) {
 		zap.Int("sender_index", msg.Sender.SetIndex),
 	)
 
-	if msg.VRFShare.Round > mc.GetCurrentRound() {
+	if msg.VRFShare.Round > mc.GetCurrentRound() && !mc.isPipelinedRound(msg.VRFShare.Round) {
 		logging.Logger.Debug("received VRF share for the future round, caching it",
 			zap.Int64("current_round", mc.GetCurrentRound()), zap.Int64("vrf_share_round", msg.VRFShare.Round))
 		mr.vrfSharesCache.add(msg.VRFShare)
@@ -306,6 +306,11 @@ func (mc *Chain) TryProposeBlock(ctx context.Context, mr *Round) {
 		zap.Int64("random_seed", mr.GetRandomSeed()),
 		zap.Int64("lf_round", mc.GetLatestFinalizedBlock().Round))
 
+	if mc.ChainConfig.BlockProposalPipelining() && pr.GetHeaviestNotarizedBlock() == nil &&
+		mc.tryProposePipelinedBlock(ctx, mr, pr) {
+		return
+	}
+
 	// NOTE: If there are not enough txns, this will not advance further even
 	// though rest of the network is. That's why this is a goroutine.
 	go func() {
@@ -372,9 +377,6 @@ func (mc *Chain) getBlockToExtend(ctx context.Context, r round.RoundI) (
 
 // generateRoundBlock - given a round number generates a block.
 func (mc *Chain) generateRoundBlock(ctx context.Context, r *Round) (*block.Block, error) {
-	var ts = time.Now()
-	defer func() { rbgTimer.UpdateSince(ts) }()
-
 	roundNumber := r.GetRoundNumber()
 	pround := mc.GetRound(roundNumber - 1)
 	if pround == nil {
@@ -388,6 +390,38 @@ func (mc *Chain) generateRoundBlock(ctx context.Context, r *Round) (*block.Block
 		return nil, common.NewError("block_gen_no_block_to_extend", "Do not have the block to extend this round")
 	}
 
+	b, err := mc.buildRoundBlock(ctx, r, pb)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.IsVerificationComplete() {
+		logging.Logger.Warn("generate block - verification complete, we are late, cancel block generation",
+			zap.Int64("round", roundNumber),
+			zap.Int("notarized", len(r.GetNotarizedBlocks())))
+		return nil, nil
+	}
+
+	mc.proposeRoundBlock(ctx, r, b)
+	return b, nil
+}
+
+// proposeRoundBlock adds the generated block to the round verification and
+// sends it to the network
+func (mc *Chain) proposeRoundBlock(ctx context.Context, r *Round, b *block.Block) {
+	mc.addToRoundVerification(r, b)
+	r.AddProposedBlock(b)
+
+	go mc.SendBlock(ctx, b)
+}
+
+// buildRoundBlock generates the block of the round extending the given block
+// and adds it to the round blocks.
+func (mc *Chain) buildRoundBlock(ctx context.Context, r *Round, pb *block.Block) (*block.Block, error) {
+	var ts = time.Now()
+	defer func() { rbgTimer.UpdateSince(ts) }()
+
+	roundNumber := r.GetRoundNumber()
 	if !pb.IsStateComputed() {
 		logging.Logger.Debug("GenerateRoundBlock, state of prior round block not computed",
 			zap.Int8("state status", pb.GetStateStatus()))
@@ -541,17 +575,6 @@ func (mc *Chain) generateRoundBlock(ctx context.Context, r *Round) (*block.Block
 		break
 	}
 
-	if r.IsVerificationComplete() {
-		logging.Logger.Warn("generate block - verification complete, we are late, cancel block generation",
-			zap.Int64("round", roundNumber),
-			zap.Int("notarized", len(r.GetNotarizedBlocks())))
-		return nil, nil
-	}
-
-	mc.addToRoundVerification(r, b)
-	r.AddProposedBlock(b)
-
-	go mc.SendBlock(ctx, b)
 	return b, nil
 }
 
@@ -873,6 +896,7 @@ func (mc *Chain) CollectBlocksForVerification(ctx context.Context, r *Round) {
 			return false
 		}
 		b.SetBlockState(block.StateVerificationSuccessful)
+		mc.startPipelinedRound(ctx, r)
 
 		bnb := r.GetBestRankedNotarizedBlock()
 		if bnb == nil || bnb.Hash == b.Hash {
@@ -1154,7 +1178,11 @@ func (mc *Chain) MergeNotarization(ctx context.Context, r *Round, b *block.Block
 func (mc *Chain) AddNotarizedBlock(r *Round, b *block.Block) bool {
 	ctx, cancel := context.WithTimeout(common.GetRootContext(), 30*time.Second)
 	defer cancel()
+	seed := r.GetRandomSeed()
 	mc.AddNotarizedBlockToRound(r, b)
+	if seed != 0 && r.GetRandomSeed() != seed {
+		mc.resetPipelinedRound(ctx, r)
+	}
 	mc.UpdateNodeState(b)
 
 	if !b.IsStateComputed() {
@@ -1173,6 +1201,7 @@ func (mc *Chain) AddNotarizedBlock(r *Round, b *block.Block) bool {
 	//	mc.CancelRoundVerification(ctx, r)
 	//}
 	b.SetBlockState(block.StateNotarized)
+	mc.notarizePipelinedRound(r)
 	return true
 }
 
@@ -1649,6 +1678,7 @@ func (mc *Chain) restartRound(ctx context.Context, rn int64) {
 				logging.Logger.Warn("Attempt to restart already notarized round, skip this attempt")
 				return
 			}
+			mc.resetPipelinedRound(ctx, r)
 			r.IncrementTimeoutCount(mc.getRoundRandomSeed(r.Number-1), mc.GetMiners(r.Number))
 			mc.RedoVrfShare(ctx, r)
 		}
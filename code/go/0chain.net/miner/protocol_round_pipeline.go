@@ -0,0 +1,234 @@
+package miner
+
+import (
+	"context"
+
+	"github.com/0chain/common/core/logging"
+	"go.uber.org/zap"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/node"
+	"0chain.net/chaincore/round"
+	"0chain.net/core/common"
+)
+
+// startPipeline marks the round block proposal pipelined, it's not started
+// if it's started already or the previous round is notarized
+func (r *Round) startPipeline() (started, notarized bool) {
+	r.pipelineGuard.Lock()
+	defer r.pipelineGuard.Unlock()
+
+	if r.prevNotarized {
+		return false, true
+	}
+	if r.pipelineStarted {
+		return false, false
+	}
+	r.pipelineStarted, r.pipelineGenerating = true, true
+	return true, false
+}
+
+// finishPipeline holds the pipelined block, nil if the generation failed,
+// and reports the previous round is notarized meanwhile. The block is not
+// held if the pipeline is reset during the generation.
+func (r *Round) finishPipeline(b *block.Block) (held, resolve bool) {
+	r.pipelineGuard.Lock()
+	defer r.pipelineGuard.Unlock()
+
+	r.pipelineGenerating = false
+	if r.pipelineReset {
+		r.pipelineReset, r.pipelineStarted = false, false
+		return false, r.prevNotarized
+	}
+	r.pipelinedBlock = b
+	return true, r.prevNotarized
+}
+
+// resetPipeline drops the pipelined block proposal and returns the held
+// block, the pipeline can be started again
+func (r *Round) resetPipeline() (b *block.Block) {
+	r.pipelineGuard.Lock()
+	defer r.pipelineGuard.Unlock()
+
+	b = r.pipelinedBlock
+	r.pipelinedBlock = nil
+	if r.pipelineGenerating {
+		r.pipelineReset = true
+		return
+	}
+	r.pipelineStarted = false
+	return
+}
+
+// notarizePipeline marks the previous round notarized and reports the
+// pipelined block proposal is to be resolved
+func (r *Round) notarizePipeline() (resolve bool) {
+	r.pipelineGuard.Lock()
+	defer r.pipelineGuard.Unlock()
+
+	if r.prevNotarized {
+		return false
+	}
+	r.prevNotarized = true
+	return r.pipelineStarted && !r.pipelineGenerating
+}
+
+// takePipelinedBlock returns and releases the held pipelined block
+func (r *Round) takePipelinedBlock() *block.Block {
+	r.pipelineGuard.Lock()
+	defer r.pipelineGuard.Unlock()
+
+	b := r.pipelinedBlock
+	r.pipelinedBlock = nil
+	return b
+}
+
+// isPipelinedRound reports the VRF shares of the round are accepted before
+// the round is started, the next round shares are sent on the current round
+// block verification in the pipelined mode
+func (mc *Chain) isPipelinedRound(rn int64) bool {
+	return mc.ChainConfig.BlockProposalPipelining() && rn == mc.GetCurrentRound()+1
+}
+
+// startPipelinedRound sends the VRF share of the next round once a block of
+// the round is verified, so the next round generation doesn't wait for the
+// round notarization
+func (mc *Chain) startPipelinedRound(ctx context.Context, r *Round) {
+	if !mc.ChainConfig.BlockProposalPipelining() || !r.HasRandomSeed() {
+		return
+	}
+	nr := mc.getOrCreateRound(ctx, r.GetRoundNumber()+1)
+	if nr.VrfShare() != nil || nr.HasRandomSeed() {
+		return
+	}
+	logging.Logger.Info("pipelined round - add VRF", zap.Int64("round", nr.GetRoundNumber()))
+	mc.addMyVRFShare(ctx, r, nr)
+}
+
+// resetPipelinedRound drops the VRF shares, the seed and the held block of
+// the pipelined next round when the round is restarted or gets a new seed,
+// they are based on the previous seed of the round
+func (mc *Chain) resetPipelinedRound(ctx context.Context, r *Round) {
+	nr := mc.GetMinerRound(r.GetRoundNumber() + 1)
+	if nr == nil || !mc.isPipelinedRound(nr.GetRoundNumber()) {
+		return
+	}
+	if err := nr.Restart(); err != nil {
+		logging.Logger.Warn("pipelined round - can't reset",
+			zap.Int64("round", nr.GetRoundNumber()), zap.Error(err))
+		return
+	}
+	nr.SetVrfShare(nil)
+	if b := nr.resetPipeline(); b != nil {
+		mc.DeleteBlock(ctx, b)
+	}
+	logging.Logger.Info("pipelined round - reset", zap.Int64("round", nr.GetRoundNumber()))
+}
+
+// getPipelinedBlockToExtend returns the best ranked block of the round
+// verified by the miner with the state computed
+func (mc *Chain) getPipelinedBlockToExtend(r round.RoundI) (bvb *block.Block) {
+	for _, b := range r.GetProposedBlocks() {
+		if b.GetBlockState() != block.StateVerificationSuccessful || !b.IsStateComputed() {
+			continue
+		}
+		if bvb == nil || b.RoundRank < bvb.RoundRank {
+			bvb = b
+		}
+	}
+	return
+}
+
+// tryProposePipelinedBlock generates the round block on the best verified
+// block of the previous round not notarized yet. The block is held until the
+// previous round is notarized. Returns false if the previous round is
+// notarized already and the block is to be generated as usual.
+func (mc *Chain) tryProposePipelinedBlock(ctx context.Context, mr *Round, pr round.RoundI) bool {
+	started, notarized := mr.startPipeline()
+	if notarized {
+		return false
+	}
+	if !started {
+		return true
+	}
+
+	go func() {
+		var b *block.Block
+		if pb := mc.getPipelinedBlockToExtend(pr); pb != nil {
+			logging.Logger.Info("pipelined block generation",
+				zap.Int64("round", mr.GetRoundNumber()),
+				zap.String("prev_block", pb.Hash),
+				zap.Int("prev_block_rank", pb.RoundRank))
+			var err error
+			if b, err = mc.buildRoundBlock(ctx, mr, pb); err != nil {
+				logging.Logger.Error("pipelined block generation failed",
+					zap.Int64("round", mr.GetRoundNumber()), zap.Error(err))
+				b = nil
+			}
+		}
+		held, resolve := mr.finishPipeline(b)
+		if !held && b != nil {
+			mc.DeleteBlock(ctx, b)
+		}
+		if resolve {
+			mc.resolvePipelinedBlock(ctx, mr)
+		}
+	}()
+	return true
+}
+
+// notarizePipelinedRound resolves the pipelined block proposal of the next
+// round on the round notarization
+func (mc *Chain) notarizePipelinedRound(r *Round) {
+	nr := mc.GetMinerRound(r.GetRoundNumber() + 1)
+	if nr != nil && nr.notarizePipeline() {
+		go mc.resolvePipelinedBlock(common.GetRootContext(), nr)
+	}
+}
+
+// resolvePipelinedBlock proposes the held pipelined block if it extends the
+// notarized block of the previous round, otherwise the block is rolled back
+// and the round block is generated on the notarized block
+func (mc *Chain) resolvePipelinedBlock(ctx context.Context, r *Round) {
+	var (
+		rn = r.GetRoundNumber()
+		b  = r.takePipelinedBlock()
+		nb *block.Block
+	)
+	if pr := mc.GetMinerRound(rn - 1); pr != nil {
+		nb = pr.GetHeaviestNotarizedBlock()
+	}
+
+	if b != nil && nb != nil && b.PrevHash == nb.Hash && areRoundAndBlockSeedsEqual(r, b) {
+		if r.IsVerificationComplete() {
+			logging.Logger.Warn("pipelined block - verification complete, we are late",
+				zap.Int64("round", rn), zap.String("block", b.Hash))
+			return
+		}
+		b.SetPrevBlockVerificationTickets(nb.GetVerificationTickets())
+		logging.Logger.Info("pipelined block proposed",
+			zap.Int64("round", rn), zap.String("block", b.Hash))
+		mc.proposeRoundBlock(ctx, r, b)
+		return
+	}
+
+	if b != nil {
+		var nbHash string
+		if nb != nil {
+			nbHash = nb.Hash
+		}
+		logging.Logger.Info("pipelined block rolled back",
+			zap.Int64("round", rn),
+			zap.String("block", b.Hash),
+			zap.String("prev_block", b.PrevHash),
+			zap.String("notarized_block", nbHash))
+		mc.DeleteBlock(ctx, b)
+	}
+
+	if rn < mc.GetCurrentRound() || !mc.IsRoundGenerator(r, node.Self.Underlying()) {
+		return
+	}
+	if _, err := mc.GenerateRoundBlock(ctx, r); err != nil {
+		logging.Logger.Error("generate round block failed", zap.Error(err))
+	}
+}
@@ -54,7 +54,13 @@ func (mc *Chain) sendDKGShare(ctx context.Context, to string) (err error) {
 	var state = crpc.Client().State()
 	switch nodeID := n.GetKey(); {
 	case state.Shares.IsGood(state, nodeID):
-		params.Add("secret_share", secShare.GetHexString())
+		shareSign, err := mc.signDKGShare(n.ID, secShare.GetHexString())
+	if err != nil {
+		return common.NewErrorf("send_dkg_share", "signing share: %v", err)
+	}
+
+	params.Add("secret_share", secShare.GetHexString())
+	params.Add("share_sign", shareSign)
 	case state.Shares.IsBad(state, nodeID):
 		params.Add("secret_share", util.RevertString(secShare.GetHexString()))
 	default:
@@ -126,6 +132,25 @@ func (mc *Chain) PublishShareOrSigns(ctx context.Context, lfb *block.Block,
 		return // (nil, nil)
 	}
 
+	var dmn *minersc.DKGMinerNodes
+	if dmn, err = mc.getDKGMiners(ctx, lfb, mb, active); err != nil {
+		return nil, err
+	}
+	if len(dmn.SimpleNodes) == 0 {
+		return nil, common.NewError("publish_sos", "no miners in DKG")
+	}
+
+	// the new miners get the shares resharing too
+	var parties = make(map[string]struct{}, len(mpks.Mpks))
+	for k := range mpks.Mpks {
+		parties[k] = struct{}{}
+	}
+	if dmn.Reshare {
+		for k := range dmn.SimpleNodes {
+			parties[k] = struct{}{}
+		}
+	}
+
 	var sos = mc.viewChangeProcess.shareOrSigns // local reference
 
 	var (
@@ -133,7 +158,7 @@ func (mc *Chain) PublishShareOrSigns(ctx context.Context, lfb *block.Block,
 		isRevealed = state.IsRevealed
 	)
 
-	for k := range mpks.Mpks {
+	for k := range parties {
 		if k == selfNodeKey {
 			continue
 		}
@@ -142,12 +167,9 @@ func (mc *Chain) PublishShareOrSigns(ctx context.Context, lfb *block.Block,
 		}
 	}
 
-	var dmn *minersc.DKGMinerNodes
-	if dmn, err = mc.getDKGMiners(ctx, lfb, mb, active); err != nil {
-		return nil, err
-	}
-	if len(dmn.SimpleNodes) == 0 {
-		return nil, common.NewError("publish_sos", "no miners in DKG")
+	// the dealers disqualified by complaints are out of the DKG
+	for id := range dmn.Disqualified {
+		delete(sos.ShareOrSigns, id)
 	}
 
 	var publicKeys = make(map[string]string)
@@ -250,21 +272,19 @@ func (mc *Chain) ContributeMpk(ctx context.Context, lfb *block.Block,
 	mc.viewChangeProcess.Lock()
 	defer mc.viewChangeProcess.Unlock()
 
-	if !mc.viewChangeProcess.isDKGSet() {
-		if dmn.N == 0 {
-			return nil, common.NewError("contribute_mpk",
-				"failed to contribute mpk: dkg is not set yet")
-		}
-
-		var vc = bls.MakeDKG(dmn.T, dmn.N, selfNodeKey)
-		vc.MagicBlockNumber = mb.MagicBlockNumber + 1
-		mc.viewChangeProcess.viewChangeDKG = vc
+	deals, err := mc.setViewChangeDKG(lfb, mb, dmn)
+	if err != nil {
+		return nil, err
+	}
+	if !deals {
+		logging.Logger.Debug("[vc] contribute_mpk: new miner doesn't deal resharing")
+		return // (nil, nil)
 	}
 
 	logging.Logger.Debug("[vc] contribute_mpk", zap.Int("T", dmn.T),
 		zap.Int("K", dmn.K), zap.Int("N", dmn.N),
 		zap.Int64("mb_number",
-			mc.viewChangeProcess.viewChangeDKG.MagicBlockNumber))
+			mc.viewChangeProcess.viewChangeDKG.GetMagicBlockNumber()))
 
 	for _, v := range mc.viewChangeProcess.viewChangeDKG.GetMPKs() {
 		mpk.Mpk = append(mpk.Mpk, v.GetHexString())
@@ -35,6 +35,7 @@ const (
 	// transactions
 	scNameContributeMpk = "contributeMpk"
 	scNamePublishShares = "shareSignsOrShares"
+	scNameComplainDKG   = "complainDKG"
 	scNameWait          = "wait"
 	// REST API requests
 	scRestAPIGetDKGMiners  = "/getDkgList"
@@ -56,7 +57,11 @@ type viewChangeProcess struct {
 	currentPhase  minersc.Phase
 	shareOrSigns  *block.ShareOrSigns
 	mpks          *block.Mpks
-	viewChangeDKG *bls.DKG
+	viewChangeDKG bls.DKGI
+	// minDealers is the number of dealers enough for the DKG
+	minDealers int
+	// complaints about the dealers of invalid shares
+	complaints map[string]*minersc.DKGComplaint
 
 	// round we expect next view change (can be adjusted)
 	nvcmx          sync.RWMutex
@@ -76,6 +81,7 @@ func (vcp *viewChangeProcess) init(mc *Chain) {
 		minersc.Start:      mc.DKGProcessStart,
 		minersc.Contribute: mc.ContributeMpk,
 		minersc.Share:      mc.SendSijs,
+		minersc.Complain:   mc.ComplainDKG,
 		minersc.Publish:    mc.PublishShareOrSigns,
 		minersc.Wait:       mc.Wait,
 	}
@@ -83,6 +89,7 @@ func (vcp *viewChangeProcess) init(mc *Chain) {
 	vcp.shareOrSigns.ID = node.Self.Underlying().GetKey()
 	vcp.currentPhase = minersc.Unknown
 	vcp.mpks = block.NewMpks()
+	vcp.complaints = make(map[string]*minersc.DKGComplaint)
 }
 
 // DKGProcess starts DKG process and works on it. It blocks.
@@ -239,6 +246,8 @@ func (vcp *viewChangeProcess) clearViewChange() {
 	vcp.shareOrSigns.ID = node.Self.Underlying().GetKey()
 	vcp.mpks = block.NewMpks()
 	vcp.viewChangeDKG = nil
+	vcp.minDealers = 0
+	vcp.complaints = make(map[string]*minersc.DKGComplaint)
 }
 
 //
@@ -256,9 +265,58 @@ func (mc *Chain) DKGProcessStart(context.Context, *block.Block,
 	return nil, nil
 }
 
+// setViewChangeDKG creates the DKG of the view change if it's not set yet;
+// resharing, a miner of the magic block deals its group secret key share,
+// and a new miner doesn't deal at all, it returns false then.
+func (mc *Chain) setViewChangeDKG(lfb *block.Block, mb *block.MagicBlock,
+	dmn *minersc.DKGMinerNodes) (deals bool, err error) {
+
+	var selfNodeKey = node.Self.Underlying().GetKey()
+	deals = !dmn.Reshare || mb.Miners.HasNode(selfNodeKey)
+
+	if mc.viewChangeProcess.isDKGSet() {
+		return
+	}
+	if dmn.N == 0 {
+		return false, common.NewError("contribute_mpk",
+			"failed to contribute mpk: dkg is not set yet")
+	}
+
+	scheme, err := bls.GetScheme(dmn.Scheme)
+	if err != nil {
+		return false, common.NewErrorf("contribute_mpk",
+			"getting DKG scheme: %v", err)
+	}
+
+	var vc bls.DKGI
+	if dmn.Reshare && deals {
+		var rs, ok = scheme.(bls.ResharingScheme)
+		if !ok {
+			return false, common.NewErrorf("contribute_mpk",
+				"DKG scheme %q can't reshare", dmn.Scheme)
+		}
+		var current = mc.GetDKG(lfb.Round)
+		if current == nil || current.MagicBlockNumber != mb.MagicBlockNumber {
+			return false, common.NewErrorf("contribute_mpk",
+				"no DKG of magic block %d to reshare", mb.MagicBlockNumber)
+		}
+		vc = rs.NewReshareDKG(dmn.T, dmn.N, selfNodeKey, current.Si)
+	} else {
+		vc = scheme.NewDKG(dmn.T, dmn.N, selfNodeKey)
+	}
+	vc.SetViewChange(mb.MagicBlockNumber+1, 0, dmn.T, dmn.N)
+	mc.viewChangeProcess.viewChangeDKG = vc
+
+	mc.viewChangeProcess.minDealers = dmn.T
+	if dmn.Reshare {
+		mc.viewChangeProcess.minDealers = dmn.PrevT
+	}
+	return
+}
+
 func (vcp *viewChangeProcess) isNeedCreateSijs() (ok bool) {
 	return vcp.viewChangeDKG != nil &&
-		vcp.viewChangeDKG.GetSijLen() < vcp.viewChangeDKG.T
+		vcp.viewChangeDKG.GetSijLen() < vcp.viewChangeDKG.GetT()
 }
 
 func (mc *Chain) getMinersMpks(ctx context.Context, lfb *block.Block, mb *block.MagicBlock,
@@ -361,11 +419,25 @@ func (mc *Chain) createSijs(ctx context.Context, lfb *block.Block, mb *block.Mag
 		return
 	}
 
+	// the new miners don't deal resharing, but get the shares
+	var parties = make(map[string]struct{}, len(mpks.Mpks))
 	for k := range mpks.Mpks {
+		parties[k] = struct{}{}
+	}
+	if dmn.Reshare {
+		for k := range dmn.SimpleNodes {
+			parties[k] = struct{}{}
+		}
+	}
+
+	for k := range parties {
 		if node.GetNode(k) != nil {
 			continue // already registered
 		}
-		v := dmn.SimpleNodes[k]
+		v, ok := dmn.SimpleNodes[k]
+		if !ok {
+			continue // not in the DKG set anymore
+		}
 		n := node.Provider()
 		n.ID = v.ID
 		n.N2NHost = v.N2NHost
@@ -386,16 +458,23 @@ func (mc *Chain) createSijs(ctx context.Context, lfb *block.Block, mb *block.Mag
 
 	mc.viewChangeProcess.mpks = mpks // set
 
-	var foundSelf = false
+	var (
+		selfNodeKey = node.Self.Underlying().GetKey()
+		_, deals    = mpks.Mpks[selfNodeKey]
+		foundSelf   = false
+	)
+	if !deals {
+		return nil // a new miner resharing
+	}
 
-	for k := range mc.mpks.GetMpks() {
+	for k := range parties {
 		id := bls.ComputeIDdkg(k)
 		share, err := mc.viewChangeDKG.ComputeDKGKeyShare(id)
 		if err != nil {
 			logging.Logger.Error("can't compute secret share", zap.Error(err))
 			return err
 		}
-		if k == node.Self.Underlying().GetKey() {
+		if k == selfNodeKey {
 			if err := mc.viewChangeDKG.AddSecretShare(id, share.GetHexString(), false); err != nil {
 				return err
 			}
@@ -444,6 +523,10 @@ func (mc *Chain) sendSijsPrepare(ctx context.Context, lfb *block.Block,
 		return // error
 	}
 
+	if _, ok := mc.viewChangeProcess.mpks.Mpks[selfNodeKey]; !ok {
+		return // (nil, nil), doesn't deal
+	}
+
 	// we haven't to make sure all nodes are registered, because the
 	// createSijs registers them; and after a restart ('deregister')
 	// we have to restart DKG for this miner, since secret key is lost
@@ -476,6 +559,19 @@ func (mc *Chain) getNodeSij(nodeID hbls.ID) (*hbls.SecretKey, bool) {
 	return &k, true
 }
 
+// signDKGShare signs the share dealt to the node, the signature makes an
+// invalid share a proof the node can complain about the dealer with
+func (mc *Chain) signDKGShare(to, share string) (string, error) {
+	mc.viewChangeProcess.Lock()
+	var mpk, ok = mc.viewChangeProcess.mpks.Mpks[node.Self.Underlying().GetKey()]
+	mc.viewChangeProcess.Unlock()
+
+	if !ok {
+		return "", common.NewError("sign_dkg_share", "no mpk of the node")
+	}
+	return node.Self.Sign(bls.ShareMessage(to, share, mpk.Mpk))
+}
+
 func (mc *Chain) setSecretShares(shareOrSignSuccess map[string]*bls.DKGKeyShare) {
 
 	mc.viewChangeProcess.Lock()
@@ -523,6 +619,48 @@ func (mc *Chain) SendSijs(ctx context.Context, lfb *block.Block,
 	return // (nil, nil)
 }
 
+//
+//                              C O M P L A I N
+//
+
+// ComplainDKG sends the proofs of the invalid shares dealt to the node,
+// the miner SC disqualifies the dealers proven.
+func (mc *Chain) ComplainDKG(ctx context.Context, lfb *block.Block,
+	mb *block.MagicBlock, active bool) (tx *httpclientutil.Transaction,
+	err error) {
+
+	mc.viewChangeProcess.Lock()
+	defer mc.viewChangeProcess.Unlock()
+
+	if !mc.viewChangeProcess.isDKGSet() {
+		return nil, common.NewError("complain_dkg", "DKG is not set")
+	}
+	if len(mc.viewChangeProcess.complaints) == 0 {
+		return // (nil, nil)
+	}
+
+	var complaints = new(minersc.DKGComplaints)
+	for _, c := range mc.viewChangeProcess.complaints {
+		complaints.Complaints = append(complaints.Complaints, c)
+	}
+
+	var selfNode = node.Self.Underlying()
+	tx = httpclientutil.NewTransactionEntity(selfNode.GetKey(), mc.ID,
+		selfNode.PublicKey)
+	tx.ToClientID = minersc.ADDRESS
+
+	var data = new(httpclientutil.SmartContractTxnData)
+	data.Name = scNameComplainDKG
+	data.InputArgs = complaints
+
+	logging.Logger.Info("[vc] complain about dkg dealers",
+		zap.Int("complaints", len(complaints.Complaints)))
+
+	err = httpclientutil.SendSmartContractTxn(tx, minersc.ADDRESS, 0, 0, data,
+		mb.Miners.N2NURLs(), mb.Sharders.N2NURLs())
+	return
+}
+
 func (mc *Chain) GetMagicBlockFromSC(ctx context.Context, lfb *block.Block, mb *block.MagicBlock,
 	active bool) (magicBlock *block.MagicBlock, err error) {
 
@@ -705,16 +843,19 @@ func (mc *Chain) Wait(ctx context.Context, lfb *block.Block,
 	if err != nil {
 		return nil, err
 	}
+	if magicBlock.Reshared {
+		vcdkg.SetReshare(magicBlockParties(magicBlock))
+	}
 	if err := vcdkg.AggregatePublicKeyShares(mpkMap); err != nil {
 		return nil, err
 	}
 
-	vcdkg.AggregateSecretKeyShares()
-	vcdkg.StartingRound = magicBlock.StartingRound
-	vcdkg.MagicBlockNumber = magicBlock.MagicBlockNumber
+	if err := vcdkg.AggregateSecretKeyShares(); err != nil {
+		return nil, common.NewErrorf("vc_wait", "aggregating secret shares: %v", err)
+	}
 	// set T and N from the magic block
-	vcdkg.T = magicBlock.T
-	vcdkg.N = magicBlock.N
+	vcdkg.SetViewChange(magicBlock.MagicBlockNumber, magicBlock.StartingRound,
+		magicBlock.T, magicBlock.N)
 
 	// save DKG and MB
 	if err = StoreDKGSummary(ctx, vcdkg.GetDKGSummary()); err != nil {
@@ -742,6 +883,15 @@ func (mc *Chain) Wait(ctx context.Context, lfb *block.Block,
 //                            other VC helpers                                //
 // ========================================================================== //
 
+// magicBlockParties returns the DKG IDs of the miners of the magic block
+func magicBlockParties(mb *block.MagicBlock) []bls.PartyID {
+	var parties []bls.PartyID
+	for id := range mb.Miners.CopyNodesMap() {
+		parties = append(parties, bls.ComputeIDdkg(id))
+	}
+	return parties
+}
+
 // MB save / load
 
 func StoreMagicBlock(ctx context.Context, magicBlock *block.MagicBlock) (
@@ -969,9 +1119,10 @@ func SignShareRequestHandler(ctx context.Context, r *http.Request) (
 	resp interface{}, err error) {
 
 	var (
-		nodeID   = r.Header.Get(node.HeaderNodeID)
-		secShare = r.FormValue("secret_share")
-		mc       = GetMinerChain()
+		nodeID    = r.Header.Get(node.HeaderNodeID)
+		secShare  = r.FormValue("secret_share")
+		shareSign = r.FormValue("share_sign")
+		mc        = GetMinerChain()
 	)
 
 	mc.viewChangeProcess.Lock()
@@ -982,12 +1133,16 @@ func SignShareRequestHandler(ctx context.Context, r *http.Request) (
 	}
 
 	var (
-		mpks        = mc.viewChangeProcess.mpks.GetMpks()
-		lmpks, dkgt = len(mpks), mc.viewChangeProcess.viewChangeDKG.T
+		mpks              = mc.viewChangeProcess.mpks.GetMpks()
+		lmpks, minDealers = len(mpks), mc.viewChangeProcess.minDealers
 	)
-	if lmpks < dkgt {
+	if lmpks < minDealers {
 		return nil, common.NewErrorf("sign_share", "don't have enough mpks"+
-			" yet, l mpks (%d) < dkg t (%d)", lmpks, dkgt)
+			" yet, l mpks (%d) < min dealers (%d)", lmpks, minDealers)
+	}
+	if _, ok := mpks[nodeID]; !ok {
+		return nil, common.NewErrorf("sign_share", "no mpk of the dealer %s",
+			nodeID)
 	}
 
 	var (
@@ -1008,9 +1163,21 @@ func SignShareRequestHandler(ctx context.Context, r *http.Request) (
 		return nil, err
 	}
 
+	err = verifyDKGShareSign(nodeID, secShare, shareSign, mpks[nodeID].Mpk)
+	if err != nil {
+		return nil, common.NewErrorf("sign_share",
+			"verifying DKG share signature: %v", err)
+	}
+
 	if !mc.viewChangeProcess.viewChangeDKG.ValidateShare(mpk, share) {
 		logging.Logger.Error("failed to verify dkg share", zap.String("share", secShare),
 			zap.String("node_id", nodeID))
+		// the share signed by the dealer proves it's invalid
+		mc.viewChangeProcess.complaints[nodeID] = &minersc.DKGComplaint{
+			DealerID: nodeID,
+			Share:    secShare,
+			Sign:     shareSign,
+		}
 		return nil, common.NewError("sign_share", "failed to verify DKG share")
 	}
 
@@ -1031,3 +1198,23 @@ func SignShareRequestHandler(ctx context.Context, r *http.Request) (
 
 	return afterSignShareRequestHandler(message, nodeID)
 }
+
+// verifyDKGShareSign verifies the signature of the dealer of the share
+func verifyDKGShareSign(dealerID, share, sign string, mpk []string) error {
+	var n = node.GetNode(dealerID)
+	if n == nil {
+		return common.NewErrorf("verify_dkg_share_sign",
+			"node %q not found", dealerID)
+	}
+
+	var signatureScheme = chain.GetServerChain().GetSignatureScheme()
+	if err := signatureScheme.SetPublicKey(n.PublicKey); err != nil {
+		return err
+	}
+
+	var message = bls.ShareMessage(node.Self.Underlying().GetKey(), share, mpk)
+	if ok, err := signatureScheme.Verify(sign, message); err != nil || !ok {
+		return common.NewError("verify_dkg_share_sign", "invalid signature")
+	}
+	return nil
+}
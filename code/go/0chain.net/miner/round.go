@@ -25,6 +25,15 @@ type Round struct {
 	vrfShare              *round.VRFShare
 	vrfSharesCache        *vrfSharesCache
 	ownVerificationTicket *block.BlockVerificationTicket
+
+	// the block proposal pipelined on a not notarized block of the
+	// previous round
+	pipelineGuard      sync.Mutex
+	pipelineStarted    bool
+	pipelineGenerating bool
+	pipelinedBlock     *block.Block
+	prevNotarized      bool
+	pipelineReset      bool
 }
 
 func (r *Round) SetGenerationCancelf(generationCancelf context.CancelFunc) {
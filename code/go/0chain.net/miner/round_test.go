@@ -1,11 +1,15 @@
 package miner
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/chain"
 	"0chain.net/chaincore/node"
 	"0chain.net/chaincore/round"
+	"0chain.net/core/memorystore"
 	"github.com/stretchr/testify/require"
 )
 
@@ -141,3 +145,123 @@ func setupVRFSharesCache(t *testing.T, n int) *vrfSharesCache {
 
 	return vrfc
 }
+
+func TestRoundPipeline(t *testing.T) {
+	b := &block.Block{}
+
+	// the previous round is notarized during the generation
+	r := &Round{}
+	started, notarized := r.startPipeline()
+	require.True(t, started)
+	require.False(t, notarized)
+	started, notarized = r.startPipeline()
+	require.False(t, started)
+	require.False(t, notarized)
+	require.False(t, r.notarizePipeline())
+	held, resolve := r.finishPipeline(b)
+	require.True(t, held)
+	require.True(t, resolve)
+	require.Equal(t, b, r.takePipelinedBlock())
+	require.Nil(t, r.takePipelinedBlock())
+
+	// the previous round is notarized after the generation
+	r = &Round{}
+	started, _ = r.startPipeline()
+	require.True(t, started)
+	held, resolve = r.finishPipeline(b)
+	require.True(t, held)
+	require.False(t, resolve)
+	require.True(t, r.notarizePipeline())
+	require.False(t, r.notarizePipeline())
+
+	// the previous round is notarized before the pipeline is started
+	r = &Round{}
+	require.False(t, r.notarizePipeline())
+	started, notarized = r.startPipeline()
+	require.False(t, started)
+	require.True(t, notarized)
+
+	// the pipeline is reset during the generation
+	r = &Round{}
+	started, _ = r.startPipeline()
+	require.True(t, started)
+	require.Nil(t, r.resetPipeline())
+	started, _ = r.startPipeline()
+	require.False(t, started)
+	held, resolve = r.finishPipeline(b)
+	require.False(t, held)
+	require.False(t, resolve)
+	require.Nil(t, r.takePipelinedBlock())
+	started, _ = r.startPipeline()
+	require.True(t, started)
+}
+
+func TestResetPipelinedRound(t *testing.T) {
+	var (
+		ctx = context.Background()
+		c   = chain.Provider().(*chain.Chain)
+	)
+	round.SetupEntity(memorystore.GetStorageProvider())
+	c.ChainConfig = chain.NewConfigImpl(&chain.ConfigData{BlockProposalPipelining: true})
+	mc := &Chain{Chain: c}
+
+	r := mc.AddRound(mc.CreateRound(round.NewRound(5))).(*Round)
+	r.SetRandomSeed(5, 1)
+	mc.SetCurrentRound(5)
+
+	// the early VRF share of the next round is sent, the next round gets
+	// its seed and the pipelined block is held
+	nr := mc.getOrCreateRound(ctx, 6)
+	n := &node.Node{}
+	n.ID = "miner"
+	share := &round.VRFShare{Round: 6, Share: "share"}
+	share.SetParty(n)
+	nr.SetVrfShare(share)
+	nr.AddVRFShare(share, 1)
+	nr.SetRandomSeed(6, 1)
+	started, _ := nr.startPipeline()
+	require.True(t, started)
+	b := &block.Block{}
+	b.Round, b.Hash = 6, "pipelined"
+	mc.AddBlock(b)
+	held, _ := nr.finishPipeline(b)
+	require.True(t, held)
+
+	// the round is restarted
+	require.NoError(t, r.Restart())
+	mc.resetPipelinedRound(ctx, r)
+
+	require.Nil(t, nr.VrfShare())
+	require.Empty(t, nr.GetVRFShares())
+	require.False(t, nr.HasRandomSeed())
+	require.Nil(t, nr.takePipelinedBlock())
+	_, err := mc.GetBlock(ctx, b.Hash)
+	require.Error(t, err)
+	started, _ = nr.startPipeline()
+	require.True(t, started)
+}
+
+func TestGetPipelinedBlockToExtend(t *testing.T) {
+	var (
+		mc = &Chain{}
+		r  = &round.Round{Number: 1}
+	)
+	newBlock := func(rank int, state int8, computed bool) *block.Block {
+		b := &block.Block{}
+		b.Hash = fmt.Sprintf("block_%d", rank)
+		b.RoundRank = rank
+		b.SetBlockState(state)
+		if computed {
+			b.SetStateStatus(block.StateSuccessful)
+		}
+		r.AddProposedBlock(b)
+		return b
+	}
+
+	require.Nil(t, mc.getPipelinedBlockToExtend(r))
+	newBlock(0, block.StateVerificationFailed, true)
+	newBlock(1, block.StateVerificationSuccessful, false)
+	b2 := newBlock(2, block.StateVerificationSuccessful, true)
+	newBlock(3, block.StateVerificationSuccessful, true)
+	require.Equal(t, b2, mc.getPipelinedBlockToExtend(r))
+}
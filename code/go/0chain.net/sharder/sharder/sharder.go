@@ -307,6 +307,9 @@ func main() {
 		return
 	}
 
+	// refuse to run if a hard fork is active and the build doesn't know it
+	sc.CheckHardForks(sc.GetLatestFinalizedBlock().Round)
+
 	sharder.SetupWorkers(ctx)
 
 	startBlocksInfoLogs(sc)
@@ -198,6 +198,7 @@ const (
 	VestingMinDuration          = SmartContract + VestingSc + "min_duration"
 	VestingMaxDuration          = SmartContract + VestingSc + "max_duration"
 	VestingMaxDescriptionLength = SmartContract + VestingSc + "max_description_length"
+	VestingMaxTranches          = SmartContract + VestingSc + "max_tranches"
 
 	FaucetOwner = SmartContract + FaucetSc + "owner_id"
 
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	bk "0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/benchmark/main/cmd/log"
+	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"0chain.net/smartcontract/storagesc"
+)
+
+var simulateFlags struct {
+	provider      string
+	providerType  string
+	stake         int64
+	serviceCharge float64
+	fees          int64
+	writePrice    int64
+	readPrice     int64
+	totalData     float64
+	dataRead      float64
+	challenges    int
+	rounds        int64
+	seed          int64
+}
+
+func init() {
+	var f = simulateCmd.Flags()
+	f.StringVar(&simulateFlags.provider, "provider", "", "provider id, a hypothetical provider if empty")
+	f.StringVar(&simulateFlags.providerType, "provider-type", spenum.Miner.String(), "miner, sharder or blobber")
+	f.Int64Var(&simulateFlags.stake, "stake", 0, "stake of the hypothetical provider, in SAS")
+	f.Float64Var(&simulateFlags.serviceCharge, "service-charge", 0, "service charge of the hypothetical provider")
+	f.Int64Var(&simulateFlags.fees, "fees", 0, "fees of every simulated block, in SAS")
+	f.Int64Var(&simulateFlags.writePrice, "write-price", 0, "write price of the hypothetical blobber, in SAS")
+	f.Int64Var(&simulateFlags.readPrice, "read-price", 0, "read price of the hypothetical blobber, in SAS")
+	f.Float64Var(&simulateFlags.totalData, "total-data", 0, "GB stored by the hypothetical blobber")
+	f.Float64Var(&simulateFlags.dataRead, "data-read", 0, "GB read from the hypothetical blobber in a period")
+	f.IntVar(&simulateFlags.challenges, "challenges", 0, "challenges the hypothetical blobber passes in a period")
+	f.Int64Var(&simulateFlags.rounds, "rounds", 1000, "number of rounds to simulate")
+	f.Int64Var(&simulateFlags.seed, "seed", 0, "seed of the simulation")
+	rootCmd.AddCommand(simulateCmd)
+}
+
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate rewards of a provider",
+	Long: `Simulate rewards of a miner, a sharder or a blobber over the next rounds,
+under the global settings of the benchmark blockchain`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		loadPath := viper.GetString("load")
+		configPath := viper.GetString("config")
+		if loadPath != "" {
+			configPath = path.Join(loadPath, "benchmark.yaml")
+		}
+		GetViper(loadPath)
+		common.SetupRootContext(context.Background())
+
+		executor := common.NewWithContextFunc(viper.GetInt(bk.OptionsLoadConcurrency))
+		mpt, root, data := getMpt(loadPath, configPath, executor)
+		log.Println("finished setting up blockchain", "root", string(root))
+
+		_, balances := getBalances(&transaction.Transaction{}, extractMpt(mpt, root), data)
+
+		var (
+			rs  interface{}
+			err error
+			sf  = simulateFlags
+		)
+		switch pt := spenum.ToProviderType(sf.providerType); pt {
+		case spenum.Miner, spenum.Sharder:
+			rs, err = minersc.SimulateRewards(balances, &minersc.SimulateRewardsRequest{
+				ProviderID:    sf.provider,
+				ProviderType:  pt,
+				Stake:         currency.Coin(sf.stake),
+				ServiceCharge: sf.serviceCharge,
+				Fees:          currency.Coin(sf.fees),
+				Rounds:        sf.rounds,
+				Seed:          sf.seed,
+			})
+		case spenum.Blobber:
+			rs, err = storagesc.SimulateRewards(balances, &storagesc.SimulateRewardsRequest{
+				BlobberID:         sf.provider,
+				Stake:             currency.Coin(sf.stake),
+				ServiceCharge:     sf.serviceCharge,
+				WritePrice:        currency.Coin(sf.writePrice),
+				ReadPrice:         currency.Coin(sf.readPrice),
+				TotalData:         sf.totalData,
+				DataRead:          sf.dataRead,
+				SuccessChallenges: sf.challenges,
+				Rounds:            sf.rounds,
+				Seed:              sf.seed,
+			})
+		default:
+			return fmt.Errorf("unsupported provider type %q", sf.providerType)
+		}
+		if err != nil {
+			return err
+		}
+
+		out, err := json.MarshalIndent(rs, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	},
+}
@@ -51,6 +51,7 @@ type TestConfigData struct {
 
 	BlockProposalMaxWaitTime time.Duration `json:"block_proposal_max_wait_time"` // max time to wait to receive a block proposal
 	BlockProposalWaitMode    int8          `json:"block_proposal_wait_mode"`     // wait time for the block proposal is static (0) or dynamic (1)
+	BlockProposalPipelining  bool          `json:"block_proposal_pipelining"`    // generate the next round block on the best verified block before notarization
 
 	ReuseTransactions bool `json:"reuse_txns"` // indicates if transactions from unrelated blocks can be reused
 
@@ -69,6 +70,11 @@ type TestConfigData struct {
 	DbsEvents   config.DbAccess   `json:"dbs_event"`
 	DbsSettings config.DbSettings `json:"dbs_settings"`
 	TxnExempt   map[string]bool   `json:"txn_exempt"`
+
+	BaseFeeChangeDenominator int     `json:"base_fee_change_denominator"`
+	BaseFeeTargetFullness    float64 `json:"base_fee_target_fullness"`
+
+	ThresholdByStakePercent int `json:"threshold_by_stake_percent"`
 }
 
 func (t *TestConfig) IsStateEnabled() bool {
@@ -119,6 +125,10 @@ func (t *TestConfig) OwnerID() datastore.Key {
 	return t.conf.OwnerID
 }
 
+func (t *TestConfig) BlockSize() int32 {
+	return t.conf.BlockSize
+}
+
 func (t *TestConfig) MinBlockSize() int32 {
 	return t.conf.MinBlockSize
 }
@@ -151,6 +161,10 @@ func (t *TestConfig) ThresholdByStake() int {
 	return t.conf.ThresholdByStake
 }
 
+func (t *TestConfig) ThresholdByStakePercent() int {
+	return t.conf.ThresholdByStakePercent
+}
+
 func (t *TestConfig) ValidationBatchSize() int {
 	return t.conf.ValidationBatchSize
 }
@@ -191,6 +205,10 @@ func (t *TestConfig) BlockProposalWaitMode() int8 {
 	return t.conf.BlockProposalWaitMode
 }
 
+func (t *TestConfig) BlockProposalPipelining() bool {
+	return t.conf.BlockProposalPipelining
+}
+
 func (t *TestConfig) ReuseTransactions() bool {
 	return t.conf.ReuseTransactions
 }
@@ -256,3 +274,11 @@ func (t *TestConfig) MinTxnFee() currency.Coin {
 func (t *TestConfig) TxnTransferCost() int {
 	return t.conf.TxnTransferCost
 }
+
+func (t *TestConfig) BaseFeeChangeDenominator() int {
+	return t.conf.BaseFeeChangeDenominator
+}
+
+func (t *TestConfig) BaseFeeTargetFullness() float64 {
+	return t.conf.BaseFeeTargetFullness
+}
@@ -26,6 +26,7 @@ type DelegatePool struct {
 	Status               spenum.PoolStatus `json:"status" gorm:"index:idx_dprov_active,priority:3;index:idx_ddel_active,priority:3;index:idx_dp_total_staked,priority:2"`
 	RoundCreated         int64             `json:"round_created"`
 	RoundPoolLastUpdated int64             `json:"round_pool_last_updated"`
+	AutoCompound         bool              `json:"auto_compound"`
 }
 
 func (edb *EventDb) GetDelegatePools(id string) ([]DelegatePool, error) {
@@ -110,6 +110,10 @@ const (
 	TagBlobberHealthCheck
 	TagAuthorizerHealthCheck
 	TagValidatorHealthCheck
+	TagUpdateProviderCommission
+	TagAddEquivocation
+	TagAddHTLC
+	TagUpdateHTLC
 	NumberOfTags
 )
 
@@ -204,6 +208,10 @@ func initTagString() {
 	TagString[TagBlobberHealthCheck] = "TagBlobberHealthCheck"
 	TagString[TagAuthorizerHealthCheck] = "TagAuthorizerHealthCheck"
 	TagString[TagValidatorHealthCheck] = "TagValidatorHealthCheck"
+	TagString[TagUpdateProviderCommission] = "TagUpdateProviderCommission"
+	TagString[TagAddEquivocation] = "TagAddEquivocation"
+	TagString[TagAddHTLC] = "TagAddHTLC"
+	TagString[TagUpdateHTLC] = "TagUpdateHTLC"
 	TagString[NumberOfTags] = "invalid"
 }
 
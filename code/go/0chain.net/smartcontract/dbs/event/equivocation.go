@@ -0,0 +1,38 @@
+package event
+
+import (
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// Equivocation is a proven double signing of a miner.
+// swagger:model Equivocation
+type Equivocation struct {
+	model.ImmutableModel
+	MinerID     string        `json:"miner_id" gorm:"index:idx_equivocation_miner"`
+	Round       int64         `json:"round"`
+	Type        string        `json:"type"`         // block or ticket
+	FirstHash   string        `json:"first_hash"`   // hash of first of the conflicting blocks
+	SecondHash  string        `json:"second_hash"`  // hash of second of the conflicting blocks
+	ReporterID  string        `json:"reporter_id"`  // client submitted the evidence
+	Slashed     currency.Coin `json:"slashed"`      // tokens slashed from the miner stake pool
+	BlockNumber int64         `json:"block_number"` // round the evidence was accepted
+}
+
+func (edb *EventDb) addEquivocation(eq Equivocation) error {
+	return edb.Store.Get().Create(&eq).Error
+}
+
+func (edb *EventDb) GetEquivocations(minerID string, limit common.Pagination) ([]Equivocation, error) {
+	var eqs []Equivocation
+	query := edb.Store.Get().Model(&Equivocation{})
+	if minerID != "" {
+		query = query.Where("miner_id = ?", minerID)
+	}
+	return eqs, query.Offset(limit.Offset).Limit(limit.Limit).Order(clause.OrderByColumn{
+		Column: clause.Column{Name: "id"},
+		Desc:   limit.IsDescending,
+	}).Find(&eqs).Error
+}
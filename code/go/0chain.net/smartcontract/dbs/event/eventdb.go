@@ -149,6 +149,8 @@ func (edb *EventDb) AutoMigrate() error {
 		&ChallengePool{},
 		&RewardDelegate{},
 		&RewardProvider{},
+		&Equivocation{},
+		&HTLC{},
 	); err != nil {
 		return err
 	}
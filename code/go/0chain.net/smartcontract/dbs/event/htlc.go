@@ -0,0 +1,55 @@
+package event
+
+import (
+	"0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/dbs/model"
+	"github.com/0chain/common/core/currency"
+	"gorm.io/gorm/clause"
+)
+
+// HTLC is a hash and time locked transfer.
+// swagger:model HTLC
+type HTLC struct {
+	model.UpdatableModel
+	HTLCID     string        `json:"htlc_id" gorm:"uniqueIndex"`
+	SenderID   string        `json:"sender_id" gorm:"index:idx_htlc_sender"`
+	ReceiverID string        `json:"receiver_id" gorm:"index:idx_htlc_receiver"`
+	HashLock   string        `json:"hash_lock" gorm:"index:idx_htlc_hash_lock"` // hex of sha256 of the preimage
+	Amount     currency.Coin `json:"amount"`
+	Expiry     int64         `json:"expiry"`   // the lock can be refunded from the time
+	Status     string        `json:"status"`   // locked, claimed or refunded
+	Preimage   string        `json:"preimage"` // hex of the preimage revealed by claim
+	LockRound  int64         `json:"lock_round"`
+	CloseRound int64         `json:"close_round"` // round of the claim or the refund
+}
+
+func (edb *EventDb) addHTLC(htlc HTLC) error {
+	return edb.Store.Get().Create(&htlc).Error
+}
+
+func (edb *EventDb) updateHTLC(htlc HTLC) error {
+	return edb.Store.Get().Model(&HTLC{}).
+		Where("htlc_id = ?", htlc.HTLCID).
+		Updates(map[string]interface{}{
+			"status":      htlc.Status,
+			"preimage":    htlc.Preimage,
+			"close_round": htlc.CloseRound,
+		}).Error
+}
+
+// GetHTLCs returns HTLCs sent or received by the client, all if the client
+// is empty; the status filters them if given
+func (edb *EventDb) GetHTLCs(clientID, status string, limit common.Pagination) ([]HTLC, error) {
+	var htlcs []HTLC
+	query := edb.Store.Get().Model(&HTLC{})
+	if clientID != "" {
+		query = query.Where("sender_id = ? OR receiver_id = ?", clientID, clientID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	return htlcs, query.Offset(limit.Offset).Limit(limit.Limit).Order(clause.OrderByColumn{
+		Column: clause.Column{Name: "id"},
+		Desc:   limit.IsDescending,
+	}).Find(&htlcs).Error
+}
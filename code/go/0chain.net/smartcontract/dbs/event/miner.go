@@ -27,6 +27,7 @@ type Miner struct {
 	Active        bool
 	Longitude     float64
 	Latitude      float64
+	Jailed        bool
 	CreationRound int64 `json:"creation_round" gorm:"index:idx_miner_creation_round"`
 }
 
@@ -782,6 +782,33 @@ func (edb *EventDb) addStat(event Event) (err error) {
 			return ErrInvalidEventData
 		}
 		return edb.updateProvidersHealthCheck(*healthCheckUpdates, ValidatorTable)
+	case TagUpdateProviderCommission:
+		pc, ok := fromEvent[dbs.ProviderCommission](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		return edb.updateProviderCommission(*pc)
+	case TagAddEquivocation:
+		eq, ok := fromEvent[Equivocation](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		eq.BlockNumber = event.BlockNumber
+		return edb.addEquivocation(*eq)
+	case TagAddHTLC:
+		htlc, ok := fromEvent[HTLC](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		htlc.LockRound = event.BlockNumber
+		return edb.addHTLC(*htlc)
+	case TagUpdateHTLC:
+		htlc, ok := fromEvent[HTLC](event.Data)
+		if !ok {
+			return ErrInvalidEventData
+		}
+		htlc.CloseRound = event.BlockNumber
+		return edb.updateHTLC(*htlc)
 	default:
 		logging.Logger.Debug("skipping event", zap.String("tag", event.Tag.String()))
 		return nil
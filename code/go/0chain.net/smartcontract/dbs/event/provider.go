@@ -1,12 +1,14 @@
 package event
 
 import (
+	"fmt"
 	"math/big"
 	"time"
 
 	"0chain.net/chaincore/config"
 	"0chain.net/core/common"
 	"0chain.net/smartcontract/dbs"
+	"0chain.net/smartcontract/stakepool/spenum"
 	"github.com/0chain/common/core/currency"
 	"gorm.io/gorm"
 )
@@ -26,6 +28,10 @@ type Provider struct {
 	Rewards         ProviderRewards  `json:"rewards" gorm:"foreignKey:ProviderID"`
 	Downtime        uint64           `json:"downtime"`
 	LastHealthCheck common.Timestamp `json:"last_health_check"`
+
+	// service charge increase waiting for its notice period to end
+	PendingServiceCharge        float64 `json:"pending_service_charge"`
+	ServiceChargeEffectiveRound int64   `json:"service_charge_effective_round"`
 }
 
 type ProviderAggregate interface {
@@ -106,3 +112,35 @@ func (edb *EventDb) updateProvidersHealthCheck(updates []dbs.DbHealthCheck, tabl
 		AddUpdate("downtime", downtime, table+".downtime + t.downtime").
 		AddUpdate("last_health_check", lastHealthCheck).Exec(edb).Error
 }
+
+func providerTable(providerType spenum.Provider) (ProviderTable, error) {
+	switch providerType {
+	case spenum.Blobber:
+		return BlobberTable, nil
+	case spenum.Validator:
+		return ValidatorTable, nil
+	case spenum.Miner:
+		return MinerTable, nil
+	case spenum.Sharder:
+		return SharderTable, nil
+	case spenum.Authorizer:
+		return AuthorizerTable, nil
+	default:
+		return "", fmt.Errorf("not implented provider type %v", providerType)
+	}
+}
+
+func (edb *EventDb) updateProviderCommission(pc dbs.ProviderCommission) error {
+	table, err := providerTable(pc.ProviderType)
+	if err != nil {
+		return err
+	}
+
+	return edb.Store.Get().Table(string(table)).
+		Where("id = ?", pc.ProviderId).
+		Updates(map[string]interface{}{
+			"service_charge":                 pc.ServiceCharge,
+			"pending_service_charge":         pc.PendingServiceCharge,
+			"service_charge_effective_round": pc.EffectiveRound,
+		}).Error
+}
@@ -15,6 +15,7 @@ type RewardDelegate struct {
 	BlockNumber int64         `json:"block_number" gorm:"index:idx_rew_del_prov,priority:1"`
 	PoolID      string        `json:"pool_id" gorm:"index:idx_rew_del_prov,priority:2"`
 	RewardType  spenum.Reward `json:"reward_type"`
+	Compounded  currency.Coin `json:"compounded"` // part of amount added to stake
 }
 
 func (edb *EventDb) insertDelegateReward(inserts []dbs.StakePoolReward, round int64) error {
@@ -26,6 +27,7 @@ func (edb *EventDb) insertDelegateReward(inserts []dbs.StakePoolReward, round in
 				BlockNumber: round,
 				PoolID:      poolId,
 				RewardType:  sp.RewardType,
+				Compounded:  sp.DelegateCompounded[poolId],
 			}
 			drs = append(drs, dr)
 		}
@@ -8,6 +8,7 @@ import (
 
 	"0chain.net/smartcontract/dbs"
 	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
@@ -38,11 +39,13 @@ func aggregateProviderRewards(
 
 		// merge delegate rewards and penalties
 		for k, v := range spus[i].DelegateRewards {
+			compounded := spus[i].DelegateCompounded[k]
 			delegatePools = append(delegatePools, DelegatePool{
 				ProviderID:           sp.ProviderId,
 				ProviderType:         sp.ProviderType,
 				PoolID:               k,
-				Reward:               v,
+				Balance:              compounded,
+				Reward:               v - compounded,
 				TotalReward:          v,
 				TotalPenalty:         spus[i].DelegatePenalties[k],
 				RoundPoolLastUpdated: round,
@@ -102,6 +105,14 @@ func withProviderRewardsPenaltiesAdded() eventMergeMiddleware {
 			a.DelegatePenalties[k] += v
 		}
 
+		// merge delegate pool compounded rewards
+		for k, v := range b.DelegateCompounded {
+			if a.DelegateCompounded == nil {
+				a.DelegateCompounded = make(map[string]currency.Coin)
+			}
+			a.DelegateCompounded[k] += v
+		}
+
 		return a, nil
 	})
 }
@@ -182,16 +193,21 @@ func (edb *EventDb) rewardProviders(prRewards []ProviderRewards) error {
 func (edb *EventDb) rewardProviderDelegates(dps []DelegatePool) error {
 	var poolIds []string
 	var reward []uint64
+	var totalReward []uint64
+	var compounded []uint64
 	var lastUpdated []uint64
 	for _, r := range dps {
 		poolIds = append(poolIds, r.PoolID)
 		reward = append(reward, uint64(r.Reward))
+		totalReward = append(totalReward, uint64(r.TotalReward))
+		compounded = append(compounded, uint64(r.Balance))
 		lastUpdated = append(lastUpdated, uint64(r.RoundPoolLastUpdated))
 	}
 
 	ret := CreateBuilder("delegate_pools", "pool_id", poolIds).
 		AddUpdate("reward", reward, "delegate_pools.reward + t.reward").
-		AddUpdate("total_reward", reward, "delegate_pools.total_reward + t.reward").
+		AddUpdate("total_reward", totalReward, "delegate_pools.total_reward + t.total_reward").
+		AddUpdate("balance", compounded, "delegate_pools.balance + t.balance").
 		AddUpdate("round_pool_last_updated", lastUpdated).
 		Exec(edb)
 	return ret.Error
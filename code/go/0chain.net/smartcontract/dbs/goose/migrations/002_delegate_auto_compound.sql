@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.delegate_pools ADD COLUMN auto_compound boolean DEFAULT false;
+ALTER TABLE public.reward_delegates ADD COLUMN compounded bigint DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.delegate_pools DROP COLUMN auto_compound;
+ALTER TABLE public.reward_delegates DROP COLUMN compounded;
+-- +goose StatementEnd
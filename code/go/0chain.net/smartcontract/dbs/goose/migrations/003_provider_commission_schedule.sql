@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.blobbers ADD COLUMN pending_service_charge numeric DEFAULT 0;
+ALTER TABLE public.blobbers ADD COLUMN service_charge_effective_round bigint DEFAULT 0;
+ALTER TABLE public.validators ADD COLUMN pending_service_charge numeric DEFAULT 0;
+ALTER TABLE public.validators ADD COLUMN service_charge_effective_round bigint DEFAULT 0;
+ALTER TABLE public.miners ADD COLUMN pending_service_charge numeric DEFAULT 0;
+ALTER TABLE public.miners ADD COLUMN service_charge_effective_round bigint DEFAULT 0;
+ALTER TABLE public.sharders ADD COLUMN pending_service_charge numeric DEFAULT 0;
+ALTER TABLE public.sharders ADD COLUMN service_charge_effective_round bigint DEFAULT 0;
+ALTER TABLE public.authorizers ADD COLUMN pending_service_charge numeric DEFAULT 0;
+ALTER TABLE public.authorizers ADD COLUMN service_charge_effective_round bigint DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.blobbers DROP COLUMN pending_service_charge;
+ALTER TABLE public.blobbers DROP COLUMN service_charge_effective_round;
+ALTER TABLE public.validators DROP COLUMN pending_service_charge;
+ALTER TABLE public.validators DROP COLUMN service_charge_effective_round;
+ALTER TABLE public.miners DROP COLUMN pending_service_charge;
+ALTER TABLE public.miners DROP COLUMN service_charge_effective_round;
+ALTER TABLE public.sharders DROP COLUMN pending_service_charge;
+ALTER TABLE public.sharders DROP COLUMN service_charge_effective_round;
+ALTER TABLE public.authorizers DROP COLUMN pending_service_charge;
+ALTER TABLE public.authorizers DROP COLUMN service_charge_effective_round;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.miners ADD COLUMN jailed boolean DEFAULT false;
+
+CREATE TABLE public.equivocations (
+    id bigint NOT NULL,
+    created_at timestamp with time zone,
+    miner_id text,
+    round bigint,
+    type text,
+    first_hash text,
+    second_hash text,
+    reporter_id text,
+    slashed bigint,
+    block_number bigint
+);
+
+ALTER TABLE public.equivocations OWNER TO zchain_user;
+
+CREATE SEQUENCE public.equivocations_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+ALTER TABLE public.equivocations_id_seq OWNER TO zchain_user;
+
+ALTER SEQUENCE public.equivocations_id_seq OWNED BY public.equivocations.id;
+
+ALTER TABLE ONLY public.equivocations ALTER COLUMN id SET DEFAULT nextval('public.equivocations_id_seq'::regclass);
+
+ALTER TABLE ONLY public.equivocations
+    ADD CONSTRAINT equivocations_pkey PRIMARY KEY (id);
+
+CREATE INDEX idx_equivocation_miner ON public.equivocations USING btree (miner_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE public.equivocations;
+ALTER TABLE public.miners DROP COLUMN jailed;
+-- +goose StatementEnd
@@ -37,6 +37,8 @@ type StakePoolReward struct {
 	DelegateRewards map[string]currency.Coin `json:"delegate_rewards"`
 	// penalties delegate pools
 	DelegatePenalties map[string]currency.Coin `json:"delegate_penalties"`
+	// part of the delegate pools rewards added to their stake
+	DelegateCompounded map[string]currency.Coin `json:"delegate_compounded"`
 }
 
 type DelegatePoolId struct {
@@ -70,6 +72,15 @@ type SpReward struct {
 	DelegateReward map[string]int64 `json:"delegate_reward"`
 }
 
+// ProviderCommission is the service charge of a provider with the increase
+// waiting for its notice period, if any
+type ProviderCommission struct {
+	StakePoolId
+	ServiceCharge        float64 `json:"service_charge"`
+	PendingServiceCharge float64 `json:"pending_service_charge"`
+	EffectiveRound       int64   `json:"effective_round"`
+}
+
 type ChallengeResult struct {
 	BlobberId string `json:"blobberId"`
 	Passed    bool   `json:"passed"`
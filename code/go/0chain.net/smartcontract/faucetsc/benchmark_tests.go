@@ -2,6 +2,7 @@ package faucetsc
 
 import (
 	"0chain.net/core/common"
+	"encoding/json"
 	"testing"
 
 	"0chain.net/core/viper"
@@ -56,6 +57,8 @@ func (bt BenchTest) Run(balances cstate.TimedQueryStateContext, b *testing.B) er
 		_, err = fsc.pour(bt.Transaction(), bt.input, balances, gn)
 	case "refill":
 		_, err = fsc.refill(bt.Transaction(), balances, gn)
+	case "updateAllowlist":
+		_, err = fsc.updateAllowlist(bt.Transaction(), bt.input, balances, gn)
 	default:
 		b.Errorf("unknown endpoint" + bt.endpoint)
 	}
@@ -90,6 +93,10 @@ func BenchmarkTests(
 					Settings[IndividualReset]: "7s",
 					Settings[GlobalReset]:     "11m",
 					Settings[OwnerId]:         owner,
+					Settings[PourPolicy]:      PourPolicyPoW,
+					Settings[PowDifficulty]:   "16",
+					Settings[MinWalletAge]:    "100",
+					Settings[RegistrationFee]: "0.1",
 				},
 			}).Encode(),
 		},
@@ -114,6 +121,21 @@ func BenchmarkTests(
 			},
 			input: nil,
 		},
+		{
+			name:     "faucet.update-allowlist",
+			endpoint: "updateAllowlist",
+			txn: &transaction.Transaction{
+				ClientID:     viper.GetString(bk.FaucetOwner),
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&allowlistRequest{
+					Add:    data.Clients[1:3],
+					Remove: data.Clients[:1],
+				})
+				return bytes
+			}(),
+		},
 	}
 	var testsI []bk.BenchTestI
 	for _, test := range tests {
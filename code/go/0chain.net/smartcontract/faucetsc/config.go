@@ -21,6 +21,11 @@ const (
 	IndividualReset
 	GlobalReset
 	OwnerId
+	PourPolicy
+	PowDifficulty
+	MinWalletAge
+	RegistrationFee
+	Curators
 	Cost
 )
 
@@ -33,6 +38,11 @@ var (
 		"individual_reset",
 		"global_rest",
 		"owner_id",
+		"pour_policy",
+		"pow_difficulty",
+		"min_wallet_age",
+		"registration_fee",
+		"curators",
 		"cost",
 	}
 
@@ -40,6 +50,7 @@ var (
 		"update-settings",
 		"pour",
 		"refill",
+		"update-allowlist",
 	}
 )
 
@@ -51,6 +62,11 @@ type FaucetConfig struct {
 	IndividualReset time.Duration  `json:"individual_reset"`
 	GlobalReset     time.Duration  `json:"global_rest"`
 	OwnerId         string         `json:"owner_id"`
+	PourPolicy      string         `json:"pour_policy"`
+	PowDifficulty   int            `json:"pow_difficulty"`
+	MinWalletAge    int64          `json:"min_wallet_age"`
+	RegistrationFee currency.Coin  `json:"registration_fee"`
+	Curators        []string       `json:"curators"`
 	Cost            map[string]int `json:"cost"`
 }
 
@@ -77,6 +93,17 @@ func getFaucetConfig() (conf *FaucetConfig, err error) {
 	conf.IndividualReset = config.SmartContractConfig.GetDuration("smart_contracts.faucetsc.individual_reset")
 	conf.GlobalReset = config.SmartContractConfig.GetDuration("smart_contracts.faucetsc.global_reset")
 	conf.OwnerId = config.SmartContractConfig.GetString("smart_contracts.faucetsc.owner_id")
+	conf.PourPolicy = config.SmartContractConfig.GetString("smart_contracts.faucetsc.pour_policy")
+	if conf.PourPolicy == "" {
+		conf.PourPolicy = PourPolicyOpen
+	}
+	conf.PowDifficulty = config.SmartContractConfig.GetInt("smart_contracts.faucetsc.pow_difficulty")
+	conf.MinWalletAge = config.SmartContractConfig.GetInt64("smart_contracts.faucetsc.min_wallet_age")
+	conf.RegistrationFee, err = currency.ParseZCN(config.SmartContractConfig.GetFloat64("smart_contracts.faucetsc.registration_fee"))
+	if err != nil {
+		return nil, err
+	}
+	conf.Curators = config.SmartContractConfig.GetStringSlice("smart_contracts.faucetsc.curators")
 	conf.Cost = config.SmartContractConfig.GetStringMapInt("smart_contracts.faucetsc.cost")
 	return
 }
@@ -9,9 +9,9 @@ import (
 // MarshalMsg implements msgp.Marshaler
 func (z *FaucetConfig) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 8
+	// map header, size 13
 	// string "PourAmount"
-	o = append(o, 0x88, 0xaa, 0x50, 0x6f, 0x75, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
+	o = append(o, 0x8d, 0xaa, 0x50, 0x6f, 0x75, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
 	o, err = z.PourAmount.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "PourAmount")
@@ -47,18 +47,40 @@ func (z *FaucetConfig) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "OwnerId"
 	o = append(o, 0xa7, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64)
 	o = msgp.AppendString(o, z.OwnerId)
+	// string "PourPolicy"
+	o = append(o, 0xaa, 0x50, 0x6f, 0x75, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79)
+	o = msgp.AppendString(o, z.PourPolicy)
+	// string "PowDifficulty"
+	o = append(o, 0xad, 0x50, 0x6f, 0x77, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79)
+	o = msgp.AppendInt(o, z.PowDifficulty)
+	// string "MinWalletAge"
+	o = append(o, 0xac, 0x4d, 0x69, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x67, 0x65)
+	o = msgp.AppendInt64(o, z.MinWalletAge)
+	// string "RegistrationFee"
+	o = append(o, 0xaf, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65)
+	o, err = z.RegistrationFee.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "RegistrationFee")
+		return
+	}
+	// string "Curators"
+	o = append(o, 0xa8, 0x43, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Curators)))
+	for za0001 := range z.Curators {
+		o = msgp.AppendString(o, z.Curators[za0001])
+	}
 	// string "Cost"
 	o = append(o, 0xa4, 0x43, 0x6f, 0x73, 0x74)
 	o = msgp.AppendMapHeader(o, uint32(len(z.Cost)))
-	keys_za0001 := make([]string, 0, len(z.Cost))
+	keys_za0002 := make([]string, 0, len(z.Cost))
 	for k := range z.Cost {
-		keys_za0001 = append(keys_za0001, k)
+		keys_za0002 = append(keys_za0002, k)
 	}
-	msgp.Sort(keys_za0001)
-	for _, k := range keys_za0001 {
-		za0002 := z.Cost[k]
+	msgp.Sort(keys_za0002)
+	for _, k := range keys_za0002 {
+		za0003 := z.Cost[k]
 		o = msgp.AppendString(o, k)
-		o = msgp.AppendInt(o, za0002)
+		o = msgp.AppendInt(o, za0003)
 	}
 	return
 }
@@ -123,35 +145,78 @@ func (z *FaucetConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "OwnerId")
 				return
 			}
-		case "Cost":
+		case "PourPolicy":
+			z.PourPolicy, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PourPolicy")
+				return
+			}
+		case "PowDifficulty":
+			z.PowDifficulty, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PowDifficulty")
+				return
+			}
+		case "MinWalletAge":
+			z.MinWalletAge, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinWalletAge")
+				return
+			}
+		case "RegistrationFee":
+			bts, err = z.RegistrationFee.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "RegistrationFee")
+				return
+			}
+		case "Curators":
 			var zb0002 uint32
-			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Curators")
+				return
+			}
+			if cap(z.Curators) >= int(zb0002) {
+				z.Curators = (z.Curators)[:zb0002]
+			} else {
+				z.Curators = make([]string, zb0002)
+			}
+			for za0001 := range z.Curators {
+				z.Curators[za0001], bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Curators", za0001)
+					return
+				}
+			}
+		case "Cost":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Cost")
 				return
 			}
 			if z.Cost == nil {
-				z.Cost = make(map[string]int, zb0002)
+				z.Cost = make(map[string]int, zb0003)
 			} else if len(z.Cost) > 0 {
 				for key := range z.Cost {
 					delete(z.Cost, key)
 				}
 			}
-			for zb0002 > 0 {
-				var za0001 string
-				var za0002 int
-				zb0002--
-				za0001, bts, err = msgp.ReadStringBytes(bts)
+			for zb0003 > 0 {
+				var za0002 string
+				var za0003 int
+				zb0003--
+				za0002, bts, err = msgp.ReadStringBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Cost")
 					return
 				}
-				za0002, bts, err = msgp.ReadIntBytes(bts)
+				za0003, bts, err = msgp.ReadIntBytes(bts)
 				if err != nil {
-					err = msgp.WrapError(err, "Cost", za0001)
+					err = msgp.WrapError(err, "Cost", za0002)
 					return
 				}
-				z.Cost[za0001] = za0002
+				z.Cost[za0002] = za0003
 			}
 		default:
 			bts, err = msgp.Skip(bts)
@@ -167,11 +232,15 @@ func (z *FaucetConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *FaucetConfig) Msgsize() (s int) {
-	s = 1 + 11 + z.PourAmount.Msgsize() + 14 + z.MaxPourAmount.Msgsize() + 14 + z.PeriodicLimit.Msgsize() + 12 + z.GlobalLimit.Msgsize() + 16 + msgp.DurationSize + 12 + msgp.DurationSize + 8 + msgp.StringPrefixSize + len(z.OwnerId) + 5 + msgp.MapHeaderSize
+	s = 1 + 11 + z.PourAmount.Msgsize() + 14 + z.MaxPourAmount.Msgsize() + 14 + z.PeriodicLimit.Msgsize() + 12 + z.GlobalLimit.Msgsize() + 16 + msgp.DurationSize + 12 + msgp.DurationSize + 8 + msgp.StringPrefixSize + len(z.OwnerId) + 11 + msgp.StringPrefixSize + len(z.PourPolicy) + 14 + msgp.IntSize + 13 + msgp.Int64Size + 16 + z.RegistrationFee.Msgsize() + 9 + msgp.ArrayHeaderSize
+	for za0001 := range z.Curators {
+		s += msgp.StringPrefixSize + len(z.Curators[za0001])
+	}
+	s += 5 + msgp.MapHeaderSize
 	if z.Cost != nil {
-		for za0001, za0002 := range z.Cost {
-			_ = za0002
-			s += msgp.StringPrefixSize + len(za0001) + msgp.IntSize
+		for za0002, za0003 := range z.Cost {
+			_ = za0003
+			s += msgp.StringPrefixSize + len(za0002) + msgp.IntSize
 		}
 	}
 	return
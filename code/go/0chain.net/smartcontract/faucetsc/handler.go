@@ -94,6 +94,11 @@ func (frh *FaucetscRestHandler) getConfig(w http.ResponseWriter, r *http.Request
 		NoResourceOrErrInternal(w, r, err)
 		return
 	}
+	registrationFee, err := faucetConfig.RegistrationFee.ToZCN()
+	if err != nil {
+		NoResourceOrErrInternal(w, r, err)
+		return
+	}
 
 	fields := map[string]string{
 		Settings[PourAmount]:      fmt.Sprintf("%v", pourAmount),
@@ -103,6 +108,11 @@ func (frh *FaucetscRestHandler) getConfig(w http.ResponseWriter, r *http.Request
 		Settings[IndividualReset]: fmt.Sprintf("%v", faucetConfig.IndividualReset),
 		Settings[GlobalReset]:     fmt.Sprintf("%v", faucetConfig.GlobalReset),
 		Settings[OwnerId]:         fmt.Sprintf("%v", faucetConfig.OwnerId),
+		Settings[PourPolicy]:      faucetConfig.PourPolicy,
+		Settings[PowDifficulty]:   fmt.Sprintf("%v", faucetConfig.PowDifficulty),
+		Settings[MinWalletAge]:    fmt.Sprintf("%v", faucetConfig.MinWalletAge),
+		Settings[RegistrationFee]: fmt.Sprintf("%v", registrationFee),
+		Settings[Curators]:        strings.Join(faucetConfig.Curators, ","),
 	}
 
 	for _, key := range costFunctions {
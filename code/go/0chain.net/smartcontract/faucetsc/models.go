@@ -118,6 +118,46 @@ func (gn *GlobalNode) updateConfig(fields map[string]string) error {
 				return fmt.Errorf("key %s, %v should be valid hex string", key, value)
 			}
 			gn.OwnerId = value
+		case Settings[PourPolicy]:
+			switch value {
+			case PourPolicyOpen, PourPolicyAllowlist, PourPolicyPoW:
+			default:
+				return fmt.Errorf("key %s, unknown pour policy %v", key, value)
+			}
+			gn.PourPolicy = value
+		case Settings[PowDifficulty]:
+			difficulty, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("key %s, unable to convert %v to integer", key, value)
+			}
+			gn.PowDifficulty = difficulty
+		case Settings[MinWalletAge]:
+			age, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("key %s, unable to convert %v to int64", key, value)
+			}
+			gn.MinWalletAge = age
+		case Settings[RegistrationFee]:
+			fAmount, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("key %s, unable to convert %v to state.balance", key, value)
+			}
+			gn.RegistrationFee, err = currency.ParseZCN(fAmount)
+			if err != nil {
+				return err
+			}
+		case Settings[Curators]:
+			var curators []string
+			for _, id := range strings.Split(value, ",") {
+				if id = strings.TrimSpace(id); id == "" {
+					continue
+				}
+				if _, err := hex.DecodeString(id); err != nil {
+					return fmt.Errorf("key %s, %v should be valid hex string", key, id)
+				}
+				curators = append(curators, id)
+			}
+			gn.Curators = curators
 
 		default:
 			return gn.setCostValue(key, value)
@@ -166,6 +206,10 @@ func (gn *GlobalNode) validate() error {
 		return common.NewError("failed to validate global node", fmt.Sprintf("individual reset(%v) is too short", gn.IndividualReset))
 	case gn.GlobalReset < gn.IndividualReset:
 		return common.NewError("failed to validate global node", fmt.Sprintf("global reset(%v) is less than individual reset(%v)", gn.GlobalReset, gn.IndividualReset))
+	case gn.PowDifficulty < 0 || gn.PowDifficulty > maxPowDifficulty:
+		return common.NewError("failed to validate global node", fmt.Sprintf("proof of work difficulty(%v) is out of range [0, %v]", gn.PowDifficulty, maxPowDifficulty))
+	case gn.MinWalletAge < 0:
+		return common.NewError("failed to validate global node", fmt.Sprintf("min wallet age(%v) is negative", gn.MinWalletAge))
 	}
 
 	return nil
@@ -175,6 +219,11 @@ type UserNode struct {
 	ID        string        `json:"id"`
 	StartTime time.Time     `json:"start_time"`
 	Used      currency.Coin `json:"used"`
+	// FirstSeen is the round of the first pour request of the wallet,
+	// zero for the users poured before the wallet age was tracked. The
+	// chain doesn't record the round it first sees a client, so the wallet
+	// age is counted from the paid registration with the faucet.
+	FirstSeen int64 `json:"first_seen_round"`
 }
 
 func (un *UserNode) GetKey(globalKey string) datastore.Key {
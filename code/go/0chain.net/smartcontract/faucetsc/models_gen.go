@@ -117,9 +117,9 @@ func (z *GlobalNode) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *UserNode) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 3
+	// map header, size 4
 	// string "ID"
-	o = append(o, 0x83, 0xa2, 0x49, 0x44)
+	o = append(o, 0x84, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "StartTime"
 	o = append(o, 0xa9, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65)
@@ -131,6 +131,9 @@ func (z *UserNode) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Used")
 		return
 	}
+	// string "FirstSeen"
+	o = append(o, 0xa9, 0x46, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e)
+	o = msgp.AppendInt64(o, z.FirstSeen)
 	return
 }
 
@@ -170,6 +173,12 @@ func (z *UserNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Used")
 				return
 			}
+		case "FirstSeen":
+			z.FirstSeen, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FirstSeen")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -184,6 +193,6 @@ func (z *UserNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *UserNode) Msgsize() (s int) {
-	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 10 + msgp.TimeSize + 5 + z.Used.Msgsize()
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 10 + msgp.TimeSize + 5 + z.Used.Msgsize() + 10 + msgp.Int64Size
 	return
 }
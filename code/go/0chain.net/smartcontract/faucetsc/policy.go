@@ -0,0 +1,203 @@
+package faucetsc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strconv"
+
+	c_state "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"github.com/0chain/common/core/util"
+)
+
+//go:generate msgp -io=false -tests=false -v
+
+// Pour policies of the faucet. Allowlisted clients pass the proof of work
+// and the wallet age checks whatever the policy is.
+const (
+	// PourPolicyOpen lets any client pour
+	PourPolicyOpen = "open"
+	// PourPolicyAllowlist lets only the allowlisted clients pour
+	PourPolicyAllowlist = "allowlist"
+	// PourPolicyPoW requires a proof of work in the pour input
+	PourPolicyPoW = "pow"
+
+	// maxPowDifficulty is the maximal number of leading zero bits of the
+	// proof of work hash
+	maxPowDifficulty = 64
+)
+
+var allowlistKey = ADDRESS + encryption.Hash("faucetsc_allowlist")
+
+// AllowedClient is an allowlist entry of the faucet
+type AllowedClient struct {
+	ID      string `json:"id"`
+	AddedBy string `json:"added_by"`
+}
+
+func (ac *AllowedClient) GetKey() datastore.Key {
+	return allowlistKey + ac.ID
+}
+
+// pourRequest is the optional input of the pour transaction
+type pourRequest struct {
+	// Nonce is the proof of work of the client, see ValidPoW
+	Nonce uint64 `json:"nonce"`
+}
+
+// allowlistRequest is the input of the update-allowlist transaction
+type allowlistRequest struct {
+	Add    []string `json:"add"`
+	Remove []string `json:"remove"`
+}
+
+// PoWHash returns the hash a client has to find the nonce for, the hash is
+// bound to the client so every new wallet has to do the work anew.
+func PoWHash(clientID string, nonce uint64) []byte {
+	return encryption.RawHash(ADDRESS + ":" + clientID + ":" +
+		strconv.FormatUint(nonce, 10))
+}
+
+// ValidPoW returns true if the hash of the client and the nonce has at least
+// the difficulty leading zero bits.
+func ValidPoW(clientID string, nonce uint64, difficulty int) bool {
+	var zeros int
+	for _, b := range PoWHash(clientID, nonce) {
+		if b != 0 {
+			zeros += bits.LeadingZeros8(b)
+			break
+		}
+		zeros += 8
+	}
+	return zeros >= difficulty
+}
+
+func (gn *GlobalNode) isCurator(clientID string) bool {
+	for _, id := range gn.Curators {
+		if id == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+func isAllowed(clientID string, balances c_state.StateContextI) (bool, error) {
+	ac := &AllowedClient{ID: clientID}
+	err := balances.GetTrieNode(ac.GetKey(), ac)
+	switch err {
+	case nil:
+		return true, nil
+	case util.ErrValueNotPresent:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// checkPourPolicy returns an error if the pour policy of the faucet
+// doesn't let the client pour, it returns true for allowlisted clients
+func (gn *GlobalNode) checkPourPolicy(
+	t *transaction.Transaction,
+	inputData []byte,
+	balances c_state.StateContextI,
+) (allowed bool, err error) {
+	allowed, err = isAllowed(t.ClientID, balances)
+	if err != nil {
+		return false, common.NewError("pour", "checking allowlist: "+err.Error())
+	}
+	if allowed {
+		return true, nil
+	}
+
+	switch gn.PourPolicy {
+	case PourPolicyAllowlist:
+		return false, common.NewError("pour", "client is not in the faucet allowlist")
+	case PourPolicyPoW:
+		var req pourRequest
+		if err := json.Unmarshal(inputData, &req); err != nil {
+			return false, common.NewError("pour", "proof of work required: "+err.Error())
+		}
+		if !ValidPoW(t.ClientID, req.Nonce, gn.PowDifficulty) {
+			return false, common.NewError("pour", fmt.Sprintf(
+				"invalid proof of work, difficulty %d", gn.PowDifficulty))
+		}
+	}
+	return false, nil
+}
+
+// checkWalletAge returns an error if the wallet was first seen less than
+// the min wallet age rounds ago. The first request of a wallet only
+// registers it, since the state changes of a failed transaction are lost,
+// it returns true in this case. The chain doesn't record the round it first
+// sees a client, the wallet is first seen by the faucet on the registration,
+// so the registration costs the registration fee.
+func (gn *GlobalNode) checkWalletAge(un *UserNode, round int64) (
+	register bool, err error) {
+
+	if un.FirstSeen == 0 || round-un.FirstSeen >= gn.MinWalletAge {
+		return false, nil
+	}
+	if un.FirstSeen == round {
+		return true, nil
+	}
+	return false, common.NewErrorf("pour", "wallet is too young, can pour from round %d",
+		un.FirstSeen+gn.MinWalletAge)
+}
+
+// checkRegistrationFee returns an error if the value of the pour request
+// registering the wallet doesn't cover the registration fee
+func (gn *GlobalNode) checkRegistrationFee(t *transaction.Transaction) error {
+	if t.Value < gn.RegistrationFee {
+		return common.NewErrorf("pour", "registration fee of %v is required, got %v",
+			gn.RegistrationFee, t.Value)
+	}
+	return nil
+}
+
+// updateAllowlist adds or removes allowlisted clients, the owner and the
+// curators of the faucet can update the allowlist
+func (fc *FaucetSmartContract) updateAllowlist(
+	t *transaction.Transaction,
+	inputData []byte,
+	balances c_state.StateContextI,
+	gn *GlobalNode,
+) (string, error) {
+	if err := smartcontractinterface.AuthorizeWithOwner("update_allowlist", func() bool {
+		return gn.OwnerId == t.ClientID || gn.isCurator(t.ClientID)
+	}); err != nil {
+		return "", err
+	}
+
+	var req allowlistRequest
+	if err := json.Unmarshal(inputData, &req); err != nil {
+		return "", common.NewError("update_allowlist", "request not formatted correctly: "+err.Error())
+	}
+	if len(req.Add) == 0 && len(req.Remove) == 0 {
+		return "", common.NewError("update_allowlist", "empty request")
+	}
+
+	for _, id := range req.Add {
+		if !encryption.IsHash(id) {
+			return "", common.NewErrorf("update_allowlist", "invalid client id %q", id)
+		}
+		ac := &AllowedClient{ID: id, AddedBy: t.ClientID}
+		if _, err := balances.InsertTrieNode(ac.GetKey(), ac); err != nil {
+			return "", common.NewError("update_allowlist", "saving allowlist entry: "+err.Error())
+		}
+	}
+	for _, id := range req.Remove {
+		ac := &AllowedClient{ID: id}
+		_, err := balances.DeleteTrieNode(ac.GetKey())
+		if err != nil && err != util.ErrValueNotPresent {
+			return "", common.NewError("update_allowlist", "removing allowlist entry: "+err.Error())
+		}
+	}
+
+	buff, _ := json.Marshal(req)
+	return string(buff), nil
+}
@@ -0,0 +1,63 @@
+package faucetsc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/encryption"
+)
+
+func TestValidPoW(t *testing.T) {
+	const difficulty = 12
+	var client = encryption.Hash("client")
+
+	var nonce uint64
+	for !ValidPoW(client, nonce, difficulty) {
+		nonce++
+	}
+	assert.True(t, ValidPoW(client, nonce, difficulty-1))
+	assert.True(t, ValidPoW(client, nonce, 0))
+	assert.False(t, ValidPoW(client, nonce, maxPowDifficulty))
+
+	// the work can't be reused by another wallet
+	var other = encryption.Hash("other")
+	for i := 0; ValidPoW(other, nonce, difficulty); i++ {
+		other = encryption.Hash(other)
+	}
+	assert.False(t, ValidPoW(other, nonce, difficulty))
+}
+
+func TestCheckWalletAge(t *testing.T) {
+	var gn = &GlobalNode{FaucetConfig: &FaucetConfig{MinWalletAge: 10}}
+
+	register, err := gn.checkWalletAge(&UserNode{FirstSeen: 100}, 100)
+	require.NoError(t, err)
+	assert.True(t, register)
+
+	_, err = gn.checkWalletAge(&UserNode{FirstSeen: 100}, 109)
+	require.EqualError(t, err, "pour: wallet is too young, can pour from round 110")
+
+	register, err = gn.checkWalletAge(&UserNode{FirstSeen: 100}, 110)
+	require.NoError(t, err)
+	assert.False(t, register)
+
+	// users poured before the wallet age was tracked
+	register, err = gn.checkWalletAge(&UserNode{}, 5)
+	require.NoError(t, err)
+	assert.False(t, register)
+}
+
+func TestCheckRegistrationFee(t *testing.T) {
+	var (
+		gn = &GlobalNode{FaucetConfig: &FaucetConfig{RegistrationFee: 10}}
+		tx = &transaction.Transaction{Value: 9}
+	)
+	require.EqualError(t, gn.checkRegistrationFee(tx),
+		"pour: registration fee of 10 is required, got 9")
+
+	tx.Value = 10
+	require.NoError(t, gn.checkRegistrationFee(tx))
+}
@@ -76,6 +76,7 @@ func (fc *FaucetSmartContract) setSC(sc *smartcontractinterface.SmartContract, _
 	fc.SmartContractExecutionStats["update-settings"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "update-settings"), nil)
 	fc.SmartContractExecutionStats["pour"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "pour"), nil)
 	fc.SmartContractExecutionStats["refill"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "refill"), nil)
+	fc.SmartContractExecutionStats["update-allowlist"] = metrics.GetOrRegisterTimer(fmt.Sprintf("sc:%v:func:%v", fc.ID, "update-allowlist"), nil)
 	fc.SmartContractExecutionStats["tokens Poured"] = metrics.GetOrRegisterHistogram(fmt.Sprintf("sc:%v:func:%v", fc.ID, "tokens Poured"), nil, metrics.NewUniformSample(1024))
 	fc.SmartContractExecutionStats["token refills"] = metrics.GetOrRegisterHistogram(fmt.Sprintf("sc:%v:func:%v", fc.ID, "token refills"), nil, metrics.NewUniformSample(1024))
 }
@@ -129,7 +130,7 @@ func (fc *FaucetSmartContract) updateSettings(
 	balances c_state.StateContextI,
 	gn *GlobalNode,
 ) (string, error) {
-	if err := smartcontractinterface.AuthorizeWithOwner("update_settings", func() bool {
+	if err := smartcontractinterface.AuthorizeWithGovernance("update_settings", t.ClientID, func() bool {
 		return gn.FaucetConfig.OwnerId == t.ClientID
 	}); err != nil {
 		return "", err
@@ -159,8 +160,34 @@ func toSeconds(dur time.Duration) common.Timestamp {
 	return common.Timestamp(dur / time.Second)
 }
 
-func (fc *FaucetSmartContract) pour(t *transaction.Transaction, _ []byte, balances c_state.StateContextI, gn *GlobalNode) (string, error) {
+func (fc *FaucetSmartContract) pour(t *transaction.Transaction, inputData []byte, balances c_state.StateContextI, gn *GlobalNode) (string, error) {
+	allowed, err := gn.checkPourPolicy(t, inputData, balances)
+	if err != nil {
+		return "", err
+	}
 	user := fc.getUserVariables(t, gn, balances)
+	if !allowed && gn.MinWalletAge > 0 {
+		register, err := gn.checkWalletAge(user, balances.GetBlock().Round)
+		if err != nil {
+			return "", err
+		}
+		if register {
+			if err := gn.checkRegistrationFee(t); err != nil {
+				return "", err
+			}
+			if gn.RegistrationFee > 0 {
+				transfer := state.NewTransfer(t.ClientID, t.ToClientID, gn.RegistrationFee)
+				if err := balances.AddTransfer(transfer); err != nil {
+					return "", common.NewErrorf("pour", "paying registration fee: %v", err)
+				}
+			}
+			if _, err := balances.InsertTrieNode(user.GetKey(gn.ID), user); err != nil {
+				return "", common.NewErrorf("pour", "error inserting user: %v", err)
+			}
+			return fmt.Sprintf("wallet registered, can pour from round %d",
+				user.FirstSeen+gn.MinWalletAge), nil
+		}
+	}
 	ok, err := user.validPourRequest(t, balances, gn)
 	if ok {
 		var pourAmount = gn.PourAmount
@@ -239,6 +266,9 @@ func (fc *FaucetSmartContract) getUserVariables(t *transaction.Transaction, gn *
 	if err != nil {
 		un.StartTime = common.ToTime(t.CreationDate)
 		un.Used = 0
+		if b := balances.GetBlock(); b != nil {
+			un.FirstSeen = b.Round
+		}
 	}
 	if common.ToTime(t.CreationDate).Sub(un.StartTime) >= gn.IndividualReset ||
 		common.ToTime(t.CreationDate).Sub(un.StartTime) >= gn.GlobalReset {
@@ -285,6 +315,8 @@ func (fc *FaucetSmartContract) Execute(t *transaction.Transaction, funcName stri
 		return fc.pour(t, inputData, balances, gn)
 	case "refill":
 		return fc.refill(t, balances, gn)
+	case "update-allowlist":
+		return fc.updateAllowlist(t, inputData, balances, gn)
 	default:
 		return "", common.NewErrorf("failed execution", "no faucet smart contract method with name %s", funcName)
 	}
@@ -0,0 +1,333 @@
+package governancesc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	configpkg "0chain.net/chaincore/config"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+type Setting int
+
+const (
+	VotingPeriod Setting = iota
+	Timelock
+	Quorum
+	Threshold
+	MaxVotePools
+	MaxVoters
+	MaxDescriptionLength
+	OwnerId
+	Cost
+)
+
+var (
+	Settings = []string{
+		"voting_period",
+		"timelock",
+		"quorum",
+		"threshold",
+		"max_vote_pools",
+		"max_voters",
+		"max_description_length",
+		"owner_id",
+		"cost",
+	}
+
+	costFunctions = []string{
+		"propose",
+		"vote",
+		"execute",
+		"governancesc-update-settings",
+	}
+)
+
+func scConfigKey(scKey string) datastore.Key {
+	return scKey + encryption.Hash("governancesc_config")
+}
+
+// config represents SC configurations ('governancesc:' from sc.yaml)
+type config struct {
+	// VotingPeriod is duration of voting for a proposal.
+	VotingPeriod time.Duration `json:"voting_period"`
+	// Timelock is delay between end of voting and execution of a passed
+	// proposal.
+	Timelock time.Duration `json:"timelock"`
+	// Quorum is minimal stake voted for a proposal to pass.
+	Quorum currency.Coin `json:"quorum"`
+	// Threshold is minimal share of the voted stake supporting a proposal
+	// to pass.
+	Threshold            float64        `json:"threshold"`
+	MaxVotePools         int            `json:"max_vote_pools"`
+	MaxVoters            int            `json:"max_voters"`
+	MaxDescriptionLength int            `json:"max_description_length"`
+	OwnerId              string         `json:"owner_id"`
+	Cost                 map[string]int `json:"cost"`
+}
+
+func (c *config) validate() (err error) {
+	switch {
+	case toSeconds(c.VotingPeriod) < 1:
+		return errors.New("invalid voting_period (< 1s)")
+	case c.Timelock < 0:
+		return errors.New("invalid timelock (< 0)")
+	case c.Quorum == 0:
+		return errors.New("invalid quorum (0)")
+	case c.Threshold <= 0 || c.Threshold > 1:
+		return errors.New("invalid threshold, out of (0; 1]")
+	case c.MaxVotePools < 1:
+		return errors.New("invalid max_vote_pools (< 1)")
+	case c.MaxVoters < 1:
+		return errors.New("invalid max_voters (< 1)")
+	case c.MaxDescriptionLength < 1:
+		return errors.New("invalid max_description_length (< 1)")
+	case c.OwnerId == "":
+		return errors.New("owner_id is not set or empty")
+	}
+	return
+}
+
+func (c *config) Encode() (b []byte) {
+	var err error
+	if b, err = json.Marshal(c); err != nil {
+		panic(err) // must not happens
+	}
+	return
+}
+
+func (c *config) Decode(b []byte) error {
+	return json.Unmarshal(b, c)
+}
+
+func (c *config) update(changes *smartcontract.StringMap) error {
+	for key, value := range changes.Fields {
+		switch key {
+		case Settings[VotingPeriod]:
+			if dValue, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to time.Duration, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.VotingPeriod = dValue
+			}
+		case Settings[Timelock]:
+			if dValue, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to time.Duration, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.Timelock = dValue
+			}
+		case Settings[Quorum]:
+			if fValue, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("value %v cannot be converted to currency.Coin, "+
+					"failing to set config key %s", value, key)
+			} else {
+				quorum, err := currency.ParseZCN(fValue)
+				if err != nil {
+					return err
+				}
+				c.Quorum = quorum
+			}
+		case Settings[Threshold]:
+			if fValue, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("value %v cannot be converted to float64, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.Threshold = fValue
+			}
+		case Settings[MaxVotePools]:
+			if iValue, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxVotePools = iValue
+			}
+		case Settings[MaxVoters]:
+			if iValue, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxVoters = iValue
+			}
+		case Settings[MaxDescriptionLength]:
+			if iValue, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxDescriptionLength = iValue
+			}
+		case Settings[OwnerId]:
+			if _, err := hex.DecodeString(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int with 16 base, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.OwnerId = value
+			}
+
+		default:
+			return c.setCostValue(key, value)
+		}
+	}
+	return nil
+}
+
+func (c *config) setCostValue(key, value string) error {
+	if !strings.HasPrefix(key, Settings[Cost]) {
+		return fmt.Errorf("config setting %s not found", key)
+	}
+
+	costKey := strings.ToLower(strings.TrimPrefix(key, Settings[Cost]+"."))
+	for _, costFunction := range costFunctions {
+		if costKey != strings.ToLower(costFunction) {
+			continue
+		}
+		costValue, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to integer", key, value)
+		}
+
+		if costValue < 0 {
+			return fmt.Errorf("cost.%s contains invalid value %s", key, value)
+		}
+
+		c.Cost[costKey] = costValue
+
+		return nil
+	}
+
+	return fmt.Errorf("cost config setting %s not found", costKey)
+}
+
+func (c *config) getConfigMap() smartcontract.StringMap {
+	fields := map[string]string{
+		Settings[VotingPeriod]:         fmt.Sprintf("%v", c.VotingPeriod),
+		Settings[Timelock]:             fmt.Sprintf("%v", c.Timelock),
+		Settings[Quorum]:               fmt.Sprintf("%v", float64(c.Quorum)/1e10),
+		Settings[Threshold]:            fmt.Sprintf("%v", c.Threshold),
+		Settings[MaxVotePools]:         fmt.Sprintf("%v", c.MaxVotePools),
+		Settings[MaxVoters]:            fmt.Sprintf("%v", c.MaxVoters),
+		Settings[MaxDescriptionLength]: fmt.Sprintf("%v", c.MaxDescriptionLength),
+		Settings[OwnerId]:              fmt.Sprintf("%v", c.OwnerId),
+	}
+
+	for _, key := range costFunctions {
+		fields[fmt.Sprintf("cost.%s", key)] = fmt.Sprintf("%0v", c.Cost[strings.ToLower(key)])
+	}
+
+	return smartcontract.StringMap{
+		Fields: fields,
+	}
+}
+
+func (gsc *GovernanceSmartContract) updateConfig(
+	txn *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (resp string, err error) {
+	var conf *config
+	if conf, err = getConfig(balances); err != nil {
+		return "", common.NewError("update_config",
+			"can't get config: "+err.Error())
+	}
+
+	// own settings are changed by a passed proposal only
+	if txn.ClientID != ADDRESS {
+		return "", common.NewError("update_config",
+			"unauthorized access - only the governance SC can access")
+	}
+
+	update := &smartcontract.StringMap{}
+	if err = update.Decode(input); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := conf.update(update); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := conf.validate(); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	if err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	return "", nil
+}
+
+//
+// helpers
+//
+
+// configurations from sc.yaml
+func getConfiguredConfig() (conf *config, err error) {
+	const prefix = "smart_contracts.governancesc."
+
+	conf = new(config)
+
+	// short hand
+	var scconf = configpkg.SmartContractConfig
+	conf.VotingPeriod = scconf.GetDuration(prefix + "voting_period")
+	conf.Timelock = scconf.GetDuration(prefix + "timelock")
+	conf.Quorum, err = currency.ParseZCN(scconf.GetFloat64(prefix + "quorum"))
+	if err != nil {
+		return nil, err
+	}
+	conf.Threshold = scconf.GetFloat64(prefix + "threshold")
+	conf.MaxVotePools = scconf.GetInt(prefix + "max_vote_pools")
+	conf.MaxVoters = scconf.GetInt(prefix + "max_voters")
+	conf.MaxDescriptionLength = scconf.GetInt(prefix + "max_description_length")
+	conf.OwnerId = scconf.GetString(prefix + "owner_id")
+	conf.Cost = scconf.GetStringMapInt(prefix + "cost")
+
+	err = conf.validate()
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func getConfig(
+	balances chainstate.CommonStateContextI,
+) (conf *config, err error) {
+	conf = new(config)
+	err = balances.GetTrieNode(scConfigKey(ADDRESS), conf)
+	switch err {
+	case nil:
+		return conf, nil
+	case util.ErrValueNotPresent:
+		return getConfiguredConfig()
+	default:
+		return nil, err
+	}
+}
+
+func InitConfig(balances chainstate.StateContextI) error {
+	err := balances.GetTrieNode(scConfigKey(ADDRESS), &config{})
+	if err == util.ErrValueNotPresent {
+		conf, err := getConfiguredConfig()
+		if err != nil {
+			return err
+		}
+		_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+		return err
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package governancesc
+
+import (
+	"net/http"
+
+	"0chain.net/core/common"
+	"0chain.net/smartcontract"
+	"0chain.net/smartcontract/rest"
+)
+
+type GovernanceRestHandler struct {
+	rest.RestHandlerI
+}
+
+func NewGovernanceRestHandler(rh rest.RestHandlerI) *GovernanceRestHandler {
+	return &GovernanceRestHandler{rh}
+}
+
+func SetupRestHandler(rh rest.RestHandlerI) {
+	rh.Register(GetEndpoints(rh))
+}
+
+func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
+	grh := NewGovernanceRestHandler(rh)
+	governance := "/v1/screst/" + ADDRESS
+	return []rest.Endpoint{
+		rest.MakeEndpoint(governance+"/getProposal", common.UserRateLimit(grh.getProposal)),
+		rest.MakeEndpoint(governance+"/governance-config", common.UserRateLimit(grh.getConfig)),
+	}
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e1/getProposal getProposal
+// get proposal, tally of a proposal in voting is current stake of the voters
+//
+// parameters:
+//    + name: proposal_id
+//      description: proposal id
+//      required: true
+//      in: query
+//      type: string
+//
+// responses:
+//  200: proposal
+//  400:
+//  500:
+func (grh *GovernanceRestHandler) getProposal(w http.ResponseWriter, r *http.Request) {
+	var (
+		proposalID = r.URL.Query().Get("proposal_id")
+		balances   = grh.GetQueryStateContext()
+	)
+
+	p, err := getProposal(proposalID, balances)
+	if err != nil {
+		common.Respond(w, r, nil, smartcontract.NewErrNoResourceOrErrInternal(err, true, "can't get proposal"))
+		return
+	}
+
+	if p.Status == proposalVoting {
+		tl, err := p.tally(balances)
+		if err != nil {
+			common.Respond(w, r, nil, common.NewErrInternal("can't tally votes", err.Error()))
+			return
+		}
+		p.Yes, p.No = tl.Yes, tl.No
+	}
+
+	common.Respond(w, r, p, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e1/governance-config governance-config
+// get governance configuration settings
+//
+// responses:
+//  200: StringMap
+//  500:
+func (grh *GovernanceRestHandler) getConfig(w http.ResponseWriter, r *http.Request) {
+	conf, err := getConfig(grh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get config", err.Error()))
+		return
+	}
+	common.Respond(w, r, conf.getConfigMap(), nil)
+}
@@ -0,0 +1,106 @@
+package governancesc
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0chain/common/core/logging"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	configpkg "0chain.net/chaincore/config"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/viper"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+	logging.Logger = zap.NewNop()
+	configpkg.SmartContractConfig = viper.New()
+}
+
+func randString(n int) string {
+	const hexLetters = "abcdef0123456789"
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		sb.WriteByte(hexLetters[rand.Intn(len(hexLetters))])
+	}
+	return sb.String()
+}
+
+func requireErrMsg(t *testing.T, err error, msg string) {
+	t.Helper()
+	if msg == "" {
+		require.Nil(t, err)
+	} else {
+		require.NotNil(t, err)
+		require.Equal(t, msg, err.Error())
+	}
+}
+
+func mustEncode(t *testing.T, val interface{}) (b []byte) {
+	var err error
+	b, err = json.Marshal(val)
+	require.NoError(t, err)
+	return
+}
+
+func newTransaction(f, t datastore.Key, now common.Timestamp) (
+	tx *transaction.Transaction) {
+
+	tx = new(transaction.Transaction)
+	tx.Hash = randString(32)
+	tx.ClientID = f
+	tx.ToClientID = t
+	tx.CreationDate = now
+	return
+}
+
+func newTestGovernanceSC() (gsc *GovernanceSmartContract) {
+	gsc = new(GovernanceSmartContract)
+	gsc.SmartContract = smartcontractinterface.NewSC(ADDRESS)
+	return
+}
+
+const testOwner = "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745802"
+
+func configureConfig() {
+	const pfx = "smart_contracts.governancesc."
+
+	configpkg.SmartContractConfig.Set(pfx+"voting_period", 10*time.Second)
+	configpkg.SmartContractConfig.Set(pfx+"timelock", 5*time.Second)
+	configpkg.SmartContractConfig.Set(pfx+"quorum", 100)
+	configpkg.SmartContractConfig.Set(pfx+"threshold", 0.66)
+	configpkg.SmartContractConfig.Set(pfx+"max_vote_pools", 2)
+	configpkg.SmartContractConfig.Set(pfx+"max_voters", 3)
+	configpkg.SmartContractConfig.Set(pfx+"max_description_length", 20)
+	configpkg.SmartContractConfig.Set(pfx+"owner_id", testOwner)
+	configpkg.SmartContractConfig.Set(pfx+"cost", "{\"1\":1, \"2\":2, \"3\":3}")
+}
+
+// withTestStakePool replaces miners stake pools by single stake pool in
+// memory
+func withTestStakePool(t *testing.T) (sp *stakepool.StakePool) {
+	sp = stakepool.NewStakePool()
+
+	var orig = stakePoolGetters[spenum.Miner]
+	stakePoolGetters[spenum.Miner] = func(_ spenum.Provider, providerID string,
+		_ chainstate.CommonStateContextI) (stakepool.AbstractStakePool, error) {
+		return sp, nil
+	}
+	t.Cleanup(func() { stakePoolGetters[spenum.Miner] = orig })
+	return
+}
+
+func s(n time.Duration) time.Duration {
+	return n * time.Second
+}
@@ -0,0 +1,440 @@
+package governancesc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	sc "0chain.net/smartcontract"
+	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/htlcsc"
+	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/paychansc"
+	"0chain.net/smartcontract/schedulersc"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"0chain.net/smartcontract/storagesc"
+	"0chain.net/smartcontract/vestingsc"
+	"0chain.net/smartcontract/zcnsc"
+)
+
+//msgp:ignore proposeRequest voteRequest proposalRequest tally
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+// settingsFunctions are settings update functions of smart contracts
+// proposals can call
+var settingsFunctions = map[string][]string{
+	faucetsc.ADDRESS:    {"update-settings"},
+	minersc.ADDRESS:     {"update_settings", "update_globals"},
+	storagesc.ADDRESS:   {"update_settings"},
+	vestingsc.ADDRESS:   {"vestingsc-update-settings"},
+	zcnsc.ADDRESS:       {zcnsc.UpdateGlobalConfigFunc},
+	schedulersc.ADDRESS: {"schedulersc-update-settings"},
+	htlcsc.ADDRESS:      {"htlcsc-update-settings"},
+	paychansc.ADDRESS:   {"paychansc-update-settings"},
+	ADDRESS:             {"governancesc-update-settings"},
+}
+
+func isSettingsFunction(target, function string) bool {
+	for _, f := range settingsFunctions[target] {
+		if f == function {
+			return true
+		}
+	}
+	return false
+}
+
+// stakePoolGetters of providers, stake of delegate pools of the providers
+// is weight of votes
+var stakePoolGetters = map[spenum.Provider]func(providerType spenum.Provider,
+	providerID string, balances chainstate.CommonStateContextI) (
+	stakepool.AbstractStakePool, error){
+
+	spenum.Blobber:   storagesc.GetStakePoolAdapter,
+	spenum.Validator: storagesc.GetStakePoolAdapter,
+	spenum.Miner:     minersc.GetStakePoolAdapter,
+	spenum.Sharder:   minersc.GetStakePoolAdapter,
+}
+
+type proposalStatus string
+
+const (
+	proposalVoting   proposalStatus = "voting"
+	proposalRejected proposalStatus = "rejected"
+	proposalExecuted proposalStatus = "executed"
+)
+
+//
+// requests
+//
+
+type proposeRequest struct {
+	Description string `json:"description"`
+	// Target is address of the smart contract to change settings of.
+	Target string `json:"target"`
+	// Function is the settings update function of the target.
+	Function string            `json:"function"`
+	Changes  map[string]string `json:"changes"`
+}
+
+func (pr *proposeRequest) decode(b []byte) error {
+	return json.Unmarshal(b, pr)
+}
+
+func (pr *proposeRequest) validate(conf *config) error {
+	switch {
+	case len(pr.Description) > conf.MaxDescriptionLength:
+		return errors.New("entry description is too long")
+	case !isSettingsFunction(pr.Target, pr.Function):
+		return fmt.Errorf("%q of %q is not a settings update function",
+			pr.Function, pr.Target)
+	case len(pr.Changes) == 0:
+		return errors.New("no changes")
+	}
+	return nil
+}
+
+type voteRequest struct {
+	ProposalID string `json:"proposal_id"`
+	Support    bool   `json:"support"`
+	// Pools are stake pools the voter votes with own delegate pools of.
+	Pools []poolRef `json:"pools"`
+}
+
+func (vr *voteRequest) decode(b []byte) error {
+	return json.Unmarshal(b, vr)
+}
+
+type proposalRequest struct {
+	ProposalID string `json:"proposal_id"`
+}
+
+func (pr *proposalRequest) decode(b []byte) error {
+	return json.Unmarshal(b, pr)
+}
+
+//
+// proposal
+//
+
+// poolRef refers stake pool of a provider
+type poolRef struct {
+	ProviderType spenum.Provider `json:"provider_type"`
+	ProviderID   string          `json:"provider_id"`
+}
+
+type vote struct {
+	ClientID string    `json:"client_id"`
+	Support  bool      `json:"support"`
+	Pools    []poolRef `json:"pools"`
+}
+
+// weight of the vote is stake of delegate pools of the voter at the moment,
+// the stake unlocked after voting doesn't count
+func (v *vote) weight(balances chainstate.CommonStateContextI) (
+	weight currency.Coin, err error) {
+
+	for _, ref := range v.Pools {
+		var get, ok = stakePoolGetters[ref.ProviderType]
+		if !ok {
+			continue
+		}
+		var sp stakepool.AbstractStakePool
+		switch sp, err = get(ref.ProviderType, ref.ProviderID, balances); err {
+		case nil:
+		case util.ErrValueNotPresent:
+			continue // removed provider
+		default:
+			return 0, fmt.Errorf("can't get stake pool of %s %s: %v",
+				ref.ProviderType, ref.ProviderID, err)
+		}
+		var dp = sp.GetPools()[v.ClientID]
+		if dp == nil ||
+			(dp.Status != spenum.Active && dp.Status != spenum.Pending) {
+			continue
+		}
+		if weight, err = currency.AddCoin(weight, dp.Balance); err != nil {
+			return 0, err
+		}
+	}
+	return
+}
+
+func proposalKey(gscKey, proposalID datastore.Key) datastore.Key {
+	return gscKey + ":proposal:" + proposalID
+}
+
+type proposal struct {
+	ID          string            `json:"id"`
+	Proposer    string            `json:"proposer"`
+	Description string            `json:"description"`
+	Target      string            `json:"target"`
+	Function    string            `json:"function"`
+	Changes     map[string]string `json:"changes"`
+	// VotingEnd is end of the voting.
+	VotingEnd common.Timestamp `json:"voting_end"`
+	// ExecuteAt is the time the proposal can be executed after, if passed.
+	ExecuteAt common.Timestamp `json:"execute_at"`
+	Status    proposalStatus   `json:"status"`
+	Votes     []*vote          `json:"votes"`
+	// Tally of the votes, set by the execution.
+	Yes currency.Coin `json:"yes"`
+	No  currency.Coin `json:"no"`
+}
+
+func (p *proposal) save(balances chainstate.StateContextI) (err error) {
+	_, err = balances.InsertTrieNode(p.ID, p)
+	return
+}
+
+func getProposal(proposalID datastore.Key,
+	balances chainstate.CommonStateContextI) (p *proposal, err error) {
+
+	p = new(proposal)
+	if err = balances.GetTrieNode(proposalID, p); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// tally is stake voted for and against a proposal
+type tally struct {
+	Yes currency.Coin `json:"yes"`
+	No  currency.Coin `json:"no"`
+}
+
+func (p *proposal) tally(balances chainstate.CommonStateContextI) (
+	t tally, err error) {
+
+	for _, v := range p.Votes {
+		var w currency.Coin
+		if w, err = v.weight(balances); err != nil {
+			return
+		}
+		if v.Support {
+			t.Yes, err = currency.AddCoin(t.Yes, w)
+		} else {
+			t.No, err = currency.AddCoin(t.No, w)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// passed returns true if the voted stake reaches the quorum and share of
+// the stake supporting the proposal reaches the threshold
+func (t tally) passed(conf *config) bool {
+	var voted = t.Yes + t.No
+	return voted >= conf.Quorum &&
+		float64(t.Yes) >= conf.Threshold*float64(voted)
+}
+
+//
+// SC functions
+//
+
+// propose a change of settings of a smart contract
+func (gsc *GovernanceSmartContract) propose(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var conf *config
+	if conf, err = getConfig(balances); err != nil {
+		return "", common.NewError("propose_failed",
+			"can't get SC configurations: "+err.Error())
+	}
+
+	var pr proposeRequest
+	if err = pr.decode(input); err != nil {
+		return "", common.NewError("propose_failed",
+			"malformed request: "+err.Error())
+	}
+	if err = pr.validate(conf); err != nil {
+		return "", common.NewError("propose_failed",
+			"invalid request: "+err.Error())
+	}
+
+	var p = &proposal{
+		ID:          proposalKey(gsc.ID, t.Hash),
+		Proposer:    t.ClientID,
+		Description: pr.Description,
+		Target:      pr.Target,
+		Function:    pr.Function,
+		Changes:     pr.Changes,
+		VotingEnd:   t.CreationDate + toSeconds(conf.VotingPeriod),
+		Status:      proposalVoting,
+	}
+	p.ExecuteAt = p.VotingEnd + toSeconds(conf.Timelock)
+
+	if err = p.save(balances); err != nil {
+		return "", common.NewError("propose_failed",
+			"saving proposal: "+err.Error())
+	}
+
+	var b []byte
+	if b, err = json.Marshal(p); err != nil {
+		return "", common.NewError("propose_failed", err.Error())
+	}
+	return string(b), nil
+}
+
+// vote for or against a proposal, a repeated vote replaces previous one
+func (gsc *GovernanceSmartContract) vote(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var conf *config
+	if conf, err = getConfig(balances); err != nil {
+		return "", common.NewError("vote_failed",
+			"can't get SC configurations: "+err.Error())
+	}
+
+	var vr voteRequest
+	if err = vr.decode(input); err != nil {
+		return "", common.NewError("vote_failed",
+			"malformed request: "+err.Error())
+	}
+
+	switch {
+	case len(vr.Pools) == 0:
+		return "", common.NewError("vote_failed", "no stake pools to vote with")
+	case len(vr.Pools) > conf.MaxVotePools:
+		return "", common.NewError("vote_failed", "too many stake pools")
+	}
+	var refs = make(map[poolRef]struct{}, len(vr.Pools))
+	for _, ref := range vr.Pools {
+		if _, ok := refs[ref]; ok {
+			return "", common.NewError("vote_failed", "duplicate stake pool")
+		}
+		refs[ref] = struct{}{}
+	}
+
+	var p *proposal
+	if p, err = getProposal(vr.ProposalID, balances); err != nil {
+		return "", common.NewError("vote_failed",
+			"can't get proposal: "+err.Error())
+	}
+	if p.Status != proposalVoting || t.CreationDate >= p.VotingEnd {
+		return "", common.NewError("vote_failed", "voting is over")
+	}
+
+	var v = &vote{ClientID: t.ClientID, Support: vr.Support, Pools: vr.Pools}
+	var w currency.Coin
+	if w, err = v.weight(balances); err != nil {
+		return "", common.NewError("vote_failed", err.Error())
+	}
+	if w == 0 {
+		return "", common.NewError("vote_failed",
+			"no stake in the stake pools to vote with")
+	}
+
+	var replaced bool
+	for i, pv := range p.Votes {
+		if pv.ClientID == v.ClientID {
+			p.Votes[i], replaced = v, true
+			break
+		}
+	}
+	if !replaced {
+		if len(p.Votes) >= conf.MaxVoters {
+			return "", common.NewError("vote_failed", "max voters reached")
+		}
+		p.Votes = append(p.Votes, v)
+	}
+
+	if err = p.save(balances); err != nil {
+		return "", common.NewError("vote_failed",
+			"saving proposal: "+err.Error())
+	}
+
+	return "", nil
+}
+
+// execute tallies votes of a proposal after the timelock and applies the
+// change if the proposal is passed, a rejected proposal is just marked
+func (gsc *GovernanceSmartContract) execute(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var conf *config
+	if conf, err = getConfig(balances); err != nil {
+		return "", common.NewError("execute_failed",
+			"can't get SC configurations: "+err.Error())
+	}
+
+	var pr proposalRequest
+	if err = pr.decode(input); err != nil {
+		return "", common.NewError("execute_failed",
+			"malformed request: "+err.Error())
+	}
+
+	var p *proposal
+	if p, err = getProposal(pr.ProposalID, balances); err != nil {
+		return "", common.NewError("execute_failed",
+			"can't get proposal: "+err.Error())
+	}
+	switch {
+	case p.Status != proposalVoting:
+		return "", common.NewError("execute_failed",
+			"proposal is already "+string(p.Status))
+	case t.CreationDate < p.ExecuteAt:
+		return "", common.NewError("execute_failed",
+			fmt.Sprintf("proposal is locked until %d", p.ExecuteAt))
+	}
+
+	var tl tally
+	if tl, err = p.tally(balances); err != nil {
+		return "", common.NewError("execute_failed",
+			"tallying votes: "+err.Error())
+	}
+	p.Yes, p.No = tl.Yes, tl.No
+
+	if !tl.passed(conf) {
+		p.Status = proposalRejected
+	} else {
+		if err = p.apply(t, balances); err != nil {
+			return "", common.NewError("execute_failed",
+				"applying changes: "+err.Error())
+		}
+		p.Status = proposalExecuted
+	}
+
+	if err = p.save(balances); err != nil {
+		return "", common.NewError("execute_failed",
+			"saving proposal: "+err.Error())
+	}
+
+	var b []byte
+	if b, err = json.Marshal(p); err != nil {
+		return "", common.NewError("execute_failed", err.Error())
+	}
+	return string(b), nil
+}
+
+// apply the changes calling the settings update function of the target on
+// behalf of the governance SC
+func (p *proposal) apply(t *transaction.Transaction,
+	balances chainstate.StateContextI) error {
+
+	var target = smartcontract.GetSmartContract(p.Target)
+	if target == nil {
+		return fmt.Errorf("smart contract %s is not enabled", p.Target)
+	}
+
+	var changes = sc.StringMap{Fields: p.Changes}
+	var tx = t.Clone()
+	tx.ClientID = ADDRESS
+	tx.ToClientID = p.Target
+	tx.Value = 0
+
+	_, err := smartcontract.ExecuteWithStats(target, tx, p.Function,
+		changes.Encode(), balances)
+	return err
+}
@@ -0,0 +1,483 @@
+package governancesc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *poolRef) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "ProviderType"
+	o = append(o, 0x82, 0xac, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65)
+	o, err = z.ProviderType.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ProviderType")
+		return
+	}
+	// string "ProviderID"
+	o = append(o, 0xaa, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ProviderID)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *poolRef) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ProviderType":
+			bts, err = z.ProviderType.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ProviderType")
+				return
+			}
+		case "ProviderID":
+			z.ProviderID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ProviderID")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *poolRef) Msgsize() (s int) {
+	s = 1 + 13 + z.ProviderType.Msgsize() + 11 + msgp.StringPrefixSize + len(z.ProviderID)
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *proposal) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 12
+	// string "ID"
+	o = append(o, 0x8c, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "Proposer"
+	o = append(o, 0xa8, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72)
+	o = msgp.AppendString(o, z.Proposer)
+	// string "Description"
+	o = append(o, 0xab, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.Description)
+	// string "Target"
+	o = append(o, 0xa6, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74)
+	o = msgp.AppendString(o, z.Target)
+	// string "Function"
+	o = append(o, 0xa8, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.Function)
+	// string "Changes"
+	o = append(o, 0xa7, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Changes)))
+	keys_za0001 := make([]string, 0, len(z.Changes))
+	for k := range z.Changes {
+		keys_za0001 = append(keys_za0001, k)
+	}
+	msgp.Sort(keys_za0001)
+	for _, k := range keys_za0001 {
+		za0002 := z.Changes[k]
+		o = msgp.AppendString(o, k)
+		o = msgp.AppendString(o, za0002)
+	}
+	// string "VotingEnd"
+	o = append(o, 0xa9, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64)
+	o, err = z.VotingEnd.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "VotingEnd")
+		return
+	}
+	// string "ExecuteAt"
+	o = append(o, 0xa9, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x74)
+	o, err = z.ExecuteAt.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "ExecuteAt")
+		return
+	}
+	// string "Status"
+	o = append(o, 0xa6, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73)
+	o = msgp.AppendString(o, string(z.Status))
+	// string "Votes"
+	o = append(o, 0xa5, 0x56, 0x6f, 0x74, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Votes)))
+	for za0003 := range z.Votes {
+		if z.Votes[za0003] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Votes[za0003].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Votes", za0003)
+				return
+			}
+		}
+	}
+	// string "Yes"
+	o = append(o, 0xa3, 0x59, 0x65, 0x73)
+	o, err = z.Yes.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Yes")
+		return
+	}
+	// string "No"
+	o = append(o, 0xa2, 0x4e, 0x6f)
+	o, err = z.No.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "No")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *proposal) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "Proposer":
+			z.Proposer, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Proposer")
+				return
+			}
+		case "Description":
+			z.Description, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Description")
+				return
+			}
+		case "Target":
+			z.Target, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Target")
+				return
+			}
+		case "Function":
+			z.Function, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Function")
+				return
+			}
+		case "Changes":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Changes")
+				return
+			}
+			if z.Changes == nil {
+				z.Changes = make(map[string]string, zb0002)
+			} else if len(z.Changes) > 0 {
+				for key := range z.Changes {
+					delete(z.Changes, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 string
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Changes")
+					return
+				}
+				za0002, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Changes", za0001)
+					return
+				}
+				z.Changes[za0001] = za0002
+			}
+		case "VotingEnd":
+			bts, err = z.VotingEnd.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VotingEnd")
+				return
+			}
+		case "ExecuteAt":
+			bts, err = z.ExecuteAt.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ExecuteAt")
+				return
+			}
+		case "Status":
+			{
+				var zb0003 string
+				zb0003, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Status")
+					return
+				}
+				z.Status = proposalStatus(zb0003)
+			}
+		case "Votes":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Votes")
+				return
+			}
+			if cap(z.Votes) >= int(zb0004) {
+				z.Votes = (z.Votes)[:zb0004]
+			} else {
+				z.Votes = make([]*vote, zb0004)
+			}
+			for za0003 := range z.Votes {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Votes[za0003] = nil
+				} else {
+					if z.Votes[za0003] == nil {
+						z.Votes[za0003] = new(vote)
+					}
+					bts, err = z.Votes[za0003].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Votes", za0003)
+						return
+					}
+				}
+			}
+		case "Yes":
+			bts, err = z.Yes.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Yes")
+				return
+			}
+		case "No":
+			bts, err = z.No.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "No")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *proposal) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 9 + msgp.StringPrefixSize + len(z.Proposer) + 12 + msgp.StringPrefixSize + len(z.Description) + 7 + msgp.StringPrefixSize + len(z.Target) + 9 + msgp.StringPrefixSize + len(z.Function) + 8 + msgp.MapHeaderSize
+	if z.Changes != nil {
+		for za0001, za0002 := range z.Changes {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
+	s += 10 + z.VotingEnd.Msgsize() + 10 + z.ExecuteAt.Msgsize() + 7 + msgp.StringPrefixSize + len(string(z.Status)) + 6 + msgp.ArrayHeaderSize
+	for za0003 := range z.Votes {
+		if z.Votes[za0003] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Votes[za0003].Msgsize()
+		}
+	}
+	s += 4 + z.Yes.Msgsize() + 3 + z.No.Msgsize()
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z proposalStatus) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendString(o, string(z))
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *proposalStatus) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 string
+		zb0001, bts, err = msgp.ReadStringBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = proposalStatus(zb0001)
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z proposalStatus) Msgsize() (s int) {
+	s = msgp.StringPrefixSize + len(string(z))
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *vote) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "ClientID"
+	o = append(o, 0x83, 0xa8, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ClientID)
+	// string "Support"
+	o = append(o, 0xa7, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74)
+	o = msgp.AppendBool(o, z.Support)
+	// string "Pools"
+	o = append(o, 0xa5, 0x50, 0x6f, 0x6f, 0x6c, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Pools)))
+	for za0001 := range z.Pools {
+		// map header, size 2
+		// string "ProviderType"
+		o = append(o, 0x82, 0xac, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65)
+		o, err = z.Pools[za0001].ProviderType.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Pools", za0001, "ProviderType")
+			return
+		}
+		// string "ProviderID"
+		o = append(o, 0xaa, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44)
+		o = msgp.AppendString(o, z.Pools[za0001].ProviderID)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *vote) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ClientID":
+			z.ClientID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ClientID")
+				return
+			}
+		case "Support":
+			z.Support, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Support")
+				return
+			}
+		case "Pools":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Pools")
+				return
+			}
+			if cap(z.Pools) >= int(zb0002) {
+				z.Pools = (z.Pools)[:zb0002]
+			} else {
+				z.Pools = make([]poolRef, zb0002)
+			}
+			for za0001 := range z.Pools {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Pools", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Pools", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ProviderType":
+						bts, err = z.Pools[za0001].ProviderType.UnmarshalMsg(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Pools", za0001, "ProviderType")
+							return
+						}
+					case "ProviderID":
+						z.Pools[za0001].ProviderID, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Pools", za0001, "ProviderID")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Pools", za0001)
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *vote) Msgsize() (s int) {
+	s = 1 + 9 + msgp.StringPrefixSize + len(z.ClientID) + 8 + msgp.BoolSize + 6 + msgp.ArrayHeaderSize
+	for za0001 := range z.Pools {
+		s += 1 + 13 + z.Pools[za0001].ProviderType.Msgsize() + 11 + msgp.StringPrefixSize + len(z.Pools[za0001].ProviderID)
+	}
+	return
+}
@@ -0,0 +1,247 @@
+package governancesc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+func Test_config_validate(t *testing.T) {
+	var valid = config{
+		VotingPeriod:         s(10),
+		Quorum:               1,
+		Threshold:            0.5,
+		MaxVotePools:         1,
+		MaxVoters:            1,
+		MaxDescriptionLength: 1,
+		OwnerId:              testOwner,
+	}
+	for _, tt := range []struct {
+		name   string
+		modify func(c *config)
+		err    string
+	}{
+		{"valid", func(*config) {}, ""},
+		{"voting_period", func(c *config) { c.VotingPeriod = 0 }, "invalid voting_period (< 1s)"},
+		{"timelock", func(c *config) { c.Timelock = -1 }, "invalid timelock (< 0)"},
+		{"quorum", func(c *config) { c.Quorum = 0 }, "invalid quorum (0)"},
+		{"threshold", func(c *config) { c.Threshold = 1.1 }, "invalid threshold, out of (0; 1]"},
+		{"max_vote_pools", func(c *config) { c.MaxVotePools = 0 }, "invalid max_vote_pools (< 1)"},
+		{"max_voters", func(c *config) { c.MaxVoters = 0 }, "invalid max_voters (< 1)"},
+		{"owner_id", func(c *config) { c.OwnerId = "" }, "owner_id is not set or empty"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var c = valid
+			tt.modify(&c)
+			requireErrMsg(t, c.validate(), tt.err)
+		})
+	}
+}
+
+func Test_tally_passed(t *testing.T) {
+	var conf = &config{Quorum: 100, Threshold: 0.66}
+	assert.True(t, tally{Yes: 66, No: 34}.passed(conf))
+	assert.False(t, tally{Yes: 65, No: 35}.passed(conf))
+	assert.False(t, tally{Yes: 60}.passed(conf), "quorum")
+}
+
+func TestGovernanceSmartContract_proposal(t *testing.T) {
+	var (
+		gsc      = newTestGovernanceSC()
+		balances = newTestBalances()
+		sp       = withTestStakePool(t)
+	)
+	configureConfig()
+	require.NoError(t, InitConfig(balances))
+
+	// the governance SC changes own settings
+	smartcontract.ContractMap[ADDRESS] = gsc
+	t.Cleanup(func() { delete(smartcontract.ContractMap, ADDRESS) })
+
+	sp.Pools["alice"] = &stakepool.DelegatePool{Balance: 80e10, Status: spenum.Active}
+	sp.Pools["bob"] = &stakepool.DelegatePool{Balance: 40e10, Status: spenum.Active}
+	sp.Pools["carol"] = &stakepool.DelegatePool{Balance: 10e10, Status: spenum.Unstaking}
+
+	// propose
+	var (
+		tx = newTransaction("alice", ADDRESS, 10)
+		pr = proposeRequest{
+			Target:   minersc.ADDRESS,
+			Function: "add_miner",
+			Changes:  map[string]string{"max_voters": "5"},
+		}
+	)
+	balances.txn = tx
+	_, err := gsc.propose(tx, mustEncode(t, &pr), balances)
+	requireErrMsg(t, err, "propose_failed: invalid request: "+
+		`"add_miner" of "`+minersc.ADDRESS+`" is not a settings update function`)
+
+	pr.Target, pr.Function, pr.Changes = ADDRESS, "governancesc-update-settings", nil
+	_, err = gsc.propose(tx, mustEncode(t, &pr), balances)
+	requireErrMsg(t, err, "propose_failed: invalid request: no changes")
+
+	pr.Changes = map[string]string{"max_voters": "5"}
+	resp, err := gsc.propose(tx, mustEncode(t, &pr), balances)
+	require.NoError(t, err)
+	var p proposal
+	require.NoError(t, json.Unmarshal([]byte(resp), &p))
+	assert.Equal(t, common.Timestamp(20), p.VotingEnd)
+	assert.Equal(t, common.Timestamp(25), p.ExecuteAt)
+	assert.Equal(t, proposalVoting, p.Status)
+
+	// vote
+	var (
+		miner = poolRef{ProviderType: spenum.Miner, ProviderID: "miner"}
+		vr    = voteRequest{ProposalID: p.ID, Support: true}
+	)
+	_, err = gsc.vote(tx, mustEncode(t, &vr), balances)
+	requireErrMsg(t, err, "vote_failed: no stake pools to vote with")
+
+	vr.Pools = []poolRef{miner, miner}
+	_, err = gsc.vote(tx, mustEncode(t, &vr), balances)
+	requireErrMsg(t, err, "vote_failed: duplicate stake pool")
+
+	vr.Pools = []poolRef{miner, {spenum.Sharder, "1"}, {spenum.Sharder, "2"}}
+	_, err = gsc.vote(tx, mustEncode(t, &vr), balances)
+	requireErrMsg(t, err, "vote_failed: too many stake pools")
+
+	vr.Pools = []poolRef{miner}
+	tx.ClientID = "carol"
+	_, err = gsc.vote(tx, mustEncode(t, &vr), balances)
+	requireErrMsg(t, err, "vote_failed: no stake in the stake pools to vote with")
+
+	tx.ClientID = "bob"
+	_, err = gsc.vote(tx, mustEncode(t, &vr), balances)
+	require.NoError(t, err)
+	vr.Support = false // changes the vote
+	_, err = gsc.vote(tx, mustEncode(t, &vr), balances)
+	require.NoError(t, err)
+
+	tx.ClientID, vr.Support = "alice", true
+	_, err = gsc.vote(tx, mustEncode(t, &vr), balances)
+	require.NoError(t, err)
+
+	got, err := getProposal(p.ID, balances)
+	require.NoError(t, err)
+	require.Len(t, got.Votes, 2)
+	tl, err := got.tally(balances)
+	require.NoError(t, err)
+	assert.Equal(t, tally{Yes: 80e10, No: 40e10}, tl)
+
+	tx.CreationDate = 20
+	_, err = gsc.vote(tx, mustEncode(t, &vr), balances)
+	requireErrMsg(t, err, "vote_failed: voting is over")
+
+	// execute
+	var er = proposalRequest{ProposalID: p.ID}
+	_, err = gsc.execute(tx, mustEncode(t, &er), balances)
+	requireErrMsg(t, err, "execute_failed: proposal is locked until 25")
+
+	tx.CreationDate = 25
+	resp, err = gsc.execute(tx, mustEncode(t, &er), balances)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(resp), &p))
+	assert.Equal(t, proposalExecuted, p.Status)
+	assert.Equal(t, currency.Coin(80e10), p.Yes)
+	assert.Equal(t, currency.Coin(40e10), p.No)
+
+	conf, err := getConfig(balances)
+	require.NoError(t, err)
+	assert.Equal(t, 5, conf.MaxVoters)
+
+	_, err = gsc.execute(tx, mustEncode(t, &er), balances)
+	requireErrMsg(t, err, "execute_failed: proposal is already executed")
+}
+
+func TestGovernanceSmartContract_execute_rejected(t *testing.T) {
+	var (
+		gsc      = newTestGovernanceSC()
+		balances = newTestBalances()
+		sp       = withTestStakePool(t)
+	)
+	configureConfig()
+	require.NoError(t, InitConfig(balances))
+
+	sp.Pools["alice"] = &stakepool.DelegatePool{Balance: 80e10, Status: spenum.Active}
+	sp.Pools["bob"] = &stakepool.DelegatePool{Balance: 40e10, Status: spenum.Active}
+
+	var tx = newTransaction("alice", ADDRESS, 10)
+	balances.txn = tx
+	resp, err := gsc.propose(tx, mustEncode(t, &proposeRequest{
+		Target:   ADDRESS,
+		Function: "governancesc-update-settings",
+		Changes:  map[string]string{"max_voters": "5"},
+	}), balances)
+	require.NoError(t, err)
+	var p proposal
+	require.NoError(t, json.Unmarshal([]byte(resp), &p))
+
+	var miner = []poolRef{{ProviderType: spenum.Miner, ProviderID: "miner"}}
+	_, err = gsc.vote(tx, mustEncode(t, &voteRequest{
+		ProposalID: p.ID, Support: true, Pools: miner,
+	}), balances)
+	require.NoError(t, err)
+	tx.ClientID = "bob"
+	_, err = gsc.vote(tx, mustEncode(t, &voteRequest{
+		ProposalID: p.ID, Support: false, Pools: miner,
+	}), balances)
+	require.NoError(t, err)
+
+	// stake unlocked after the voting doesn't count
+	sp.Pools["alice"].Status = spenum.Unstaking
+
+	tx.CreationDate = 25
+	resp, err = gsc.execute(tx, mustEncode(t, &proposalRequest{ProposalID: p.ID}), balances)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(resp), &p))
+	assert.Equal(t, proposalRejected, p.Status)
+	assert.Zero(t, p.Yes)
+	assert.Equal(t, currency.Coin(40e10), p.No)
+
+	conf, err := getConfig(balances)
+	require.NoError(t, err)
+	assert.Equal(t, 3, conf.MaxVoters)
+}
+
+func TestGovernanceSmartContract_updateConfig(t *testing.T) {
+	var (
+		gsc      = newTestGovernanceSC()
+		balances = newTestBalances()
+		input    = mustEncode(t, map[string]interface{}{
+			"fields": map[string]string{"threshold": "0.75"},
+		})
+	)
+	configureConfig()
+	require.NoError(t, InitConfig(balances))
+
+	var tx = newTransaction("alice", ADDRESS, 10)
+	balances.txn = tx
+	_, err := gsc.updateConfig(tx, input, balances)
+	requireErrMsg(t, err, "update_config: unauthorized access - only the governance SC can access")
+
+	// the owner can't bypass proposals
+	tx.ClientID = testOwner
+	_, err = gsc.updateConfig(tx, input, balances)
+	requireErrMsg(t, err, "update_config: unauthorized access - only the governance SC can access")
+
+	tx.ClientID = ADDRESS
+	_, err = gsc.updateConfig(tx, input, balances)
+	require.NoError(t, err)
+	conf, err := getConfig(balances)
+	require.NoError(t, err)
+	assert.Equal(t, 0.75, conf.Threshold)
+
+	_, err = gsc.updateConfig(tx, mustEncode(t, map[string]interface{}{
+		"fields": map[string]string{"threshold": "2"},
+	}), balances)
+	requireErrMsg(t, err, "update_config: invalid threshold, out of (0; 1]")
+}
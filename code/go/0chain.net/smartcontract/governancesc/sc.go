@@ -0,0 +1,116 @@
+package governancesc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+)
+
+const (
+	ADDRESS = "6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e1"
+)
+
+// GovernanceSmartContract lets stakeholders change settings of smart
+// contracts by proposals voted with their stake
+type GovernanceSmartContract struct {
+	*smartcontractinterface.SmartContract
+}
+
+// NewGovernanceSmartContract creates the enabled governance SC, settings of
+// all SCs are changed by its passed proposals only
+func NewGovernanceSmartContract() smartcontractinterface.SmartContractInterface {
+	smartcontractinterface.RegisterGovernance(ADDRESS)
+	var gscCopy = &GovernanceSmartContract{
+		smartcontractinterface.NewSC(ADDRESS),
+	}
+	gscCopy.setSC(gscCopy.SmartContract, &smartcontract.BCContext{})
+	return gscCopy
+}
+
+func (gsc *GovernanceSmartContract) GetHandlerStats(ctx context.Context, params url.Values) (interface{}, error) {
+	return gsc.SmartContract.HandlerStats(ctx, params)
+}
+
+func (gsc *GovernanceSmartContract) GetExecutionStats() map[string]interface{} {
+	return gsc.SmartContractExecutionStats
+}
+
+func (gsc *GovernanceSmartContract) GetName() string {
+	return "governance"
+}
+
+func (gsc *GovernanceSmartContract) GetAddress() string {
+	return ADDRESS
+}
+
+func (gsc *GovernanceSmartContract) GetCost(t *transaction.Transaction, funcName string, balances chainstate.StateContextI) (int, error) {
+	conf, err := getConfig(balances)
+	if err != nil {
+		return math.MaxInt32, err
+	}
+	if conf.Cost == nil {
+		return math.MaxInt32, errors.New("can't get cost")
+	}
+	cost, ok := conf.Cost[funcName]
+	if !ok {
+		return math.MaxInt32, errors.New("no cost given for " + funcName)
+	}
+	return cost, nil
+}
+
+func (gsc *GovernanceSmartContract) setSC(sc *smartcontractinterface.SmartContract,
+	bcContext smartcontractinterface.BCContextI) {
+
+	gsc.SmartContract = sc
+
+	// propose a change of settings of a smart contract
+	gsc.SmartContractExecutionStats["propose"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", gsc.ID, "propose"), nil)
+
+	// vote for or against a proposal with stake of delegate pools
+	gsc.SmartContractExecutionStats["vote"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", gsc.ID, "vote"), nil)
+
+	// tally votes of a proposal and apply the change if it's passed
+	gsc.SmartContractExecutionStats["execute"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", gsc.ID, "execute"), nil)
+
+	gsc.SmartContractExecutionStats["governancesc-update-settings"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", gsc.ID, "governancesc-update-settings"), nil)
+}
+
+func (gsc *GovernanceSmartContract) Execute(t *transaction.Transaction,
+	function string, input []byte, balances chainstate.StateContextI) (
+	resp string, err error) {
+
+	switch function {
+
+	case "propose":
+		resp, err = gsc.propose(t, input, balances)
+	case "vote":
+		resp, err = gsc.vote(t, input, balances)
+	case "execute":
+		resp, err = gsc.execute(t, input, balances)
+	case "governancesc-update-settings":
+		resp, err = gsc.updateConfig(t, input, balances)
+	default:
+		err = common.NewError("governance_sc_failed",
+			fmt.Sprintf("no function with %q name", function))
+	}
+	return
+}
+
+func toSeconds(dur time.Duration) common.Timestamp {
+	return common.Timestamp(dur / time.Second)
+}
@@ -0,0 +1,275 @@
+package htlcsc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	configpkg "0chain.net/chaincore/config"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+type Setting int
+
+const (
+	MinLock Setting = iota
+	MinDuration
+	MaxDuration
+	OwnerId
+	Cost
+)
+
+var (
+	Settings = []string{
+		"min_lock",
+		"min_duration",
+		"max_duration",
+		"owner_id",
+		"cost",
+	}
+
+	costFunctions = []string{
+		"lock",
+		"claim",
+		"refund",
+		"htlcsc-update-settings",
+	}
+)
+
+func scConfigKey(scKey string) datastore.Key {
+	return scKey + encryption.Hash("htlcsc_config")
+}
+
+// config represents SC configurations ('htlcsc:' from sc.yaml)
+type config struct {
+	// MinLock is minimal amount of tokens locked.
+	MinLock currency.Coin `json:"min_lock"`
+	// MinDuration and MaxDuration are limits of the time from locking tokens
+	// to expiry of the lock.
+	MinDuration time.Duration  `json:"min_duration"`
+	MaxDuration time.Duration  `json:"max_duration"`
+	OwnerId     string         `json:"owner_id"`
+	Cost        map[string]int `json:"cost"`
+}
+
+func (c *config) validate() (err error) {
+	switch {
+	case c.MinLock == 0:
+		return errors.New("invalid min_lock (0)")
+	case toSeconds(c.MinDuration) < 1:
+		return errors.New("invalid min_duration (< 1s)")
+	case c.MaxDuration < c.MinDuration:
+		return errors.New("invalid max_duration (< min_duration)")
+	case c.OwnerId == "":
+		return errors.New("owner_id is not set or empty")
+	}
+	return
+}
+
+func (c *config) Encode() (b []byte) {
+	var err error
+	if b, err = json.Marshal(c); err != nil {
+		panic(err) // must not happens
+	}
+	return
+}
+
+func (c *config) Decode(b []byte) error {
+	return json.Unmarshal(b, c)
+}
+
+func (c *config) update(changes *smartcontract.StringMap) error {
+	for key, value := range changes.Fields {
+		switch key {
+		case Settings[MinLock]:
+			if fValue, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("value %v cannot be converted to currency.Coin, "+
+					"failing to set config key %s", value, key)
+			} else {
+				minLock, err := currency.ParseZCN(fValue)
+				if err != nil {
+					return err
+				}
+				c.MinLock = minLock
+			}
+		case Settings[MinDuration]:
+			if dValue, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to time.Duration, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MinDuration = dValue
+			}
+		case Settings[MaxDuration]:
+			if dValue, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to time.Duration, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxDuration = dValue
+			}
+		case Settings[OwnerId]:
+			if _, err := hex.DecodeString(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int with 16 base, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.OwnerId = value
+			}
+
+		default:
+			return c.setCostValue(key, value)
+		}
+	}
+	return nil
+}
+
+func (c *config) setCostValue(key, value string) error {
+	if !strings.HasPrefix(key, Settings[Cost]) {
+		return fmt.Errorf("config setting %s not found", key)
+	}
+
+	costKey := strings.ToLower(strings.TrimPrefix(key, Settings[Cost]+"."))
+	for _, costFunction := range costFunctions {
+		if costKey != strings.ToLower(costFunction) {
+			continue
+		}
+		costValue, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to integer", key, value)
+		}
+
+		if costValue < 0 {
+			return fmt.Errorf("cost.%s contains invalid value %s", key, value)
+		}
+
+		c.Cost[costKey] = costValue
+
+		return nil
+	}
+
+	return fmt.Errorf("cost config setting %s not found", costKey)
+}
+
+func (c *config) getConfigMap() smartcontract.StringMap {
+	fields := map[string]string{
+		Settings[MinLock]:     fmt.Sprintf("%v", float64(c.MinLock)/1e10),
+		Settings[MinDuration]: fmt.Sprintf("%v", c.MinDuration),
+		Settings[MaxDuration]: fmt.Sprintf("%v", c.MaxDuration),
+		Settings[OwnerId]:     fmt.Sprintf("%v", c.OwnerId),
+	}
+
+	for _, key := range costFunctions {
+		fields[fmt.Sprintf("cost.%s", key)] = fmt.Sprintf("%0v", c.Cost[strings.ToLower(key)])
+	}
+
+	return smartcontract.StringMap{
+		Fields: fields,
+	}
+}
+
+func (hsc *HTLCSmartContract) updateConfig(
+	txn *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (resp string, err error) {
+	var conf *config
+	if conf, err = getConfig(balances); err != nil {
+		return "", common.NewError("update_config",
+			"can't get config: "+err.Error())
+	}
+
+	if err := smartcontractinterface.AuthorizeWithGovernance("update_config", txn.ClientID, func() bool {
+		return conf.OwnerId == txn.ClientID
+	}); err != nil {
+		return "", err
+	}
+
+	update := &smartcontract.StringMap{}
+	if err = update.Decode(input); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := conf.update(update); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := conf.validate(); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	if err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	return "", nil
+}
+
+//
+// helpers
+//
+
+// configurations from sc.yaml
+func getConfiguredConfig() (conf *config, err error) {
+	const prefix = "smart_contracts.htlcsc."
+
+	conf = new(config)
+
+	// short hand
+	var scconf = configpkg.SmartContractConfig
+	conf.MinLock, err = currency.ParseZCN(scconf.GetFloat64(prefix + "min_lock"))
+	if err != nil {
+		return nil, err
+	}
+	conf.MinDuration = scconf.GetDuration(prefix + "min_duration")
+	conf.MaxDuration = scconf.GetDuration(prefix + "max_duration")
+	conf.OwnerId = scconf.GetString(prefix + "owner_id")
+	conf.Cost = scconf.GetStringMapInt(prefix + "cost")
+
+	err = conf.validate()
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func getConfig(
+	balances chainstate.CommonStateContextI,
+) (conf *config, err error) {
+	conf = new(config)
+	err = balances.GetTrieNode(scConfigKey(ADDRESS), conf)
+	switch err {
+	case nil:
+		return conf, nil
+	case util.ErrValueNotPresent:
+		return getConfiguredConfig()
+	default:
+		return nil, err
+	}
+}
+
+func InitConfig(balances chainstate.StateContextI) error {
+	err := balances.GetTrieNode(scConfigKey(ADDRESS), &config{})
+	if err == util.ErrValueNotPresent {
+		conf, err := getConfiguredConfig()
+		if err != nil {
+			return err
+		}
+		_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+		return err
+	}
+	return err
+}
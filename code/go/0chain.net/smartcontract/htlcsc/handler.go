@@ -0,0 +1,125 @@
+package htlcsc
+
+import (
+	"net/http"
+
+	"0chain.net/core/common"
+	"0chain.net/smartcontract"
+	common2 "0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/rest"
+)
+
+type HTLCRestHandler struct {
+	rest.RestHandlerI
+}
+
+func NewHTLCRestHandler(rh rest.RestHandlerI) *HTLCRestHandler {
+	return &HTLCRestHandler{rh}
+}
+
+func SetupRestHandler(rh rest.RestHandlerI) {
+	rh.Register(GetEndpoints(rh))
+}
+
+func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
+	hrh := NewHTLCRestHandler(rh)
+	htlc := "/v1/screst/" + ADDRESS
+	return []rest.Endpoint{
+		rest.MakeEndpoint(htlc+"/getHTLC", common.UserRateLimit(hrh.getHTLC)),
+		rest.MakeEndpoint(htlc+"/getHTLCs", common.UserRateLimit(hrh.getHTLCs)),
+		rest.MakeEndpoint(htlc+"/htlc-config", common.UserRateLimit(hrh.getConfig)),
+	}
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e3/getHTLC getHTLC
+// get htlc, the preimage of a claimed htlc is given
+//
+// parameters:
+//    + name: htlc_id
+//      description: htlc id, the hash of the lock transaction
+//      required: true
+//      in: query
+//      type: string
+//
+// responses:
+//  200: HTLC
+//  400:
+//  500:
+func (hrh *HTLCRestHandler) getHTLC(w http.ResponseWriter, r *http.Request) {
+	var htlcID = r.URL.Query().Get("htlc_id")
+
+	h, err := getHTLC(htlcID, hrh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, smartcontract.NewErrNoResourceOrErrInternal(err, true, "can't get htlc"))
+		return
+	}
+	common.Respond(w, r, h, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e3/getHTLCs getHTLCs
+// get htlcs sent or received by a client
+//
+// parameters:
+//    + name: client_id
+//      description: client id, all clients if empty
+//      in: query
+//      type: string
+//    + name: status
+//      description: locked, claimed or refunded, any status if empty
+//      in: query
+//      type: string
+//    + name: offset
+//      description: offset
+//      in: query
+//      type: string
+//    + name: limit
+//      description: limit
+//      in: query
+//      type: string
+//    + name: is_descending
+//      description: is descending
+//      in: query
+//      type: string
+//
+// responses:
+//  200: []HTLC
+//  400:
+//  500:
+func (hrh *HTLCRestHandler) getHTLCs(w http.ResponseWriter, r *http.Request) {
+	var (
+		clientID = r.URL.Query().Get("client_id")
+		status   = r.URL.Query().Get("status")
+	)
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := hrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	htlcs, err := edb.GetHTLCs(clientID, status, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+		return
+	}
+	common.Respond(w, r, htlcs, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e3/htlc-config htlc-config
+// get htlc configuration settings
+//
+// responses:
+//  200: StringMap
+//  500:
+func (hrh *HTLCRestHandler) getConfig(w http.ResponseWriter, r *http.Request) {
+	conf, err := getConfig(hrh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get config", err.Error()))
+		return
+	}
+	common.Respond(w, r, conf.getConfigMap(), nil)
+}
@@ -0,0 +1,44 @@
+package htlcsc
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/0chain/common/core/logging"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"0chain.net/chaincore/chain/state/statetest"
+	configpkg "0chain.net/chaincore/config"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/viper"
+)
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+	logging.Logger = zap.NewNop()
+	configpkg.SmartContractConfig = viper.New()
+}
+
+func configureConfig() {
+	const pfx = "smart_contracts.htlcsc."
+
+	configpkg.SmartContractConfig.Set(pfx+"min_lock", 1)
+	configpkg.SmartContractConfig.Set(pfx+"min_duration", time.Minute)
+	configpkg.SmartContractConfig.Set(pfx+"max_duration", time.Hour)
+	configpkg.SmartContractConfig.Set(pfx+"owner_id", statetest.Owner)
+	configpkg.SmartContractConfig.Set(pfx+"cost", "{\"1\":1, \"2\":2, \"3\":3}")
+}
+
+// testChain runs the transactions of a test on an in-memory state
+type testChain struct {
+	*statetest.Chain
+}
+
+func newTestChain(t *testing.T) *testChain {
+	configureConfig()
+	var tc = &testChain{Chain: statetest.NewChain(t)}
+	require.NoError(t, InitConfig(tc.ContextAt(0, &transaction.Transaction{})))
+	return tc
+}
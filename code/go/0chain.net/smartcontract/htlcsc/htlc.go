@@ -0,0 +1,301 @@
+package htlcsc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/0chain/common/core/currency"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+//msgp:ignore lockRequest claimRequest refundRequest
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+const (
+	StatusLocked   = "locked"
+	StatusClaimed  = "claimed"
+	StatusRefunded = "refunded"
+
+	// maxPreimageLength bounds the preimages kept in the state
+	maxPreimageLength = 64
+)
+
+func htlcKey(hscKey, htlcID datastore.Key) datastore.Key {
+	return hscKey + ":htlc:" + htlcID
+}
+
+// HashPreimage returns the hash lock of the preimage, it's sha256 as used by
+// HTLCs of the other chains.
+func HashPreimage(preimage []byte) []byte {
+	var sum = sha256.Sum256(preimage)
+	return sum[:]
+}
+
+//
+// requests
+//
+
+type lockRequest struct {
+	ReceiverID string `json:"receiver_id"`
+	// HashLock is hex of sha256 of the preimage.
+	HashLock string `json:"hash_lock"`
+	// Expiry is the time the tokens can be refunded to the sender from.
+	Expiry common.Timestamp `json:"expiry"`
+}
+
+func (lr *lockRequest) decode(b []byte) error {
+	return json.Unmarshal(b, lr)
+}
+
+func (lr *lockRequest) validate(t *transaction.Transaction, conf *config,
+	now common.Timestamp) error {
+
+	switch {
+	case lr.ReceiverID == "":
+		return errors.New("missing receiver")
+	case lr.ReceiverID == t.ClientID:
+		return errors.New("can't lock tokens to the sender")
+	case currency.Coin(t.Value) < conf.MinLock:
+		return fmt.Errorf("locked tokens are less than min lock %v", conf.MinLock)
+	case lr.Expiry < now+toSeconds(conf.MinDuration):
+		return fmt.Errorf("expiry is earlier than min duration %v", conf.MinDuration)
+	case lr.Expiry > now+toSeconds(conf.MaxDuration):
+		return fmt.Errorf("expiry is later than max duration %v", conf.MaxDuration)
+	}
+	hashLock, err := hex.DecodeString(lr.HashLock)
+	if err != nil || len(hashLock) != sha256.Size {
+		return errors.New("hash lock is not hex of sha256")
+	}
+	return nil
+}
+
+type claimRequest struct {
+	HTLCID string `json:"htlc_id"`
+	// Preimage is hex of the secret hashed to the hash lock.
+	Preimage string `json:"preimage"`
+}
+
+func (cr *claimRequest) decode(b []byte) error {
+	return json.Unmarshal(b, cr)
+}
+
+type refundRequest struct {
+	HTLCID string `json:"htlc_id"`
+}
+
+func (rr *refundRequest) decode(b []byte) error {
+	return json.Unmarshal(b, rr)
+}
+
+//
+// HTLC
+//
+
+// HTLC is tokens locked to a receiver on the hash of a preimage and an
+// expiry time. It's kept after claim, so the preimage revealed can be read
+// by the other side of a swap.
+type HTLC struct {
+	ID         string           `json:"id"`
+	SenderID   string           `json:"sender_id"`
+	ReceiverID string           `json:"receiver_id"`
+	HashLock   string           `json:"hash_lock"`
+	Amount     currency.Coin    `json:"amount"`
+	Expiry     common.Timestamp `json:"expiry"`
+	Status     string           `json:"status"`
+	Preimage   string           `json:"preimage,omitempty"`
+}
+
+func (h *HTLC) save(balances chainstate.StateContextI) (err error) {
+	_, err = balances.InsertTrieNode(htlcKey(ADDRESS, h.ID), h)
+	return
+}
+
+func getHTLC(htlcID datastore.Key, balances chainstate.CommonStateContextI) (
+	h *HTLC, err error) {
+
+	h = new(HTLC)
+	if err = balances.GetTrieNode(htlcKey(ADDRESS, htlcID), h); err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (h *HTLC) emitUpdate(balances chainstate.StateContextI) {
+	balances.EmitEvent(event.TypeStats, event.TagUpdateHTLC, h.ID, event.HTLC{
+		HTLCID:   h.ID,
+		Status:   h.Status,
+		Preimage: h.Preimage,
+	})
+}
+
+// close the lock transferring its tokens to the client
+func (h *HTLC) close(status, to string, balances chainstate.StateContextI) error {
+	if err := balances.AddTransfer(state.NewTransfer(ADDRESS, to, h.Amount)); err != nil {
+		return fmt.Errorf("transferring tokens: %v", err)
+	}
+	h.Status = status
+	if err := h.save(balances); err != nil {
+		return fmt.Errorf("saving htlc: %v", err)
+	}
+	h.emitUpdate(balances)
+	return nil
+}
+
+func (h *HTLC) response() (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+//
+// smart contract functions
+//
+
+// lock the value of the transaction to the receiver
+func (hsc *HTLCSmartContract) lock(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	conf, err := getConfig(balances)
+	if err != nil {
+		return "", common.NewError("lock_failed",
+			"can't get config: "+err.Error())
+	}
+
+	var lr lockRequest
+	if err = lr.decode(input); err != nil {
+		return "", common.NewError("lock_failed",
+			"malformed request: "+err.Error())
+	}
+	if err = lr.validate(t, conf, balances.GetBlock().CreationDate); err != nil {
+		return "", common.NewError("lock_failed",
+			"invalid request: "+err.Error())
+	}
+
+	var h = &HTLC{
+		ID:         t.Hash,
+		SenderID:   t.ClientID,
+		ReceiverID: lr.ReceiverID,
+		HashLock:   lr.HashLock,
+		Amount:     currency.Coin(t.Value),
+		Expiry:     lr.Expiry,
+		Status:     StatusLocked,
+	}
+
+	if err = balances.AddTransfer(state.NewTransfer(t.ClientID, ADDRESS, h.Amount)); err != nil {
+		return "", common.NewError("lock_failed",
+			"transferring tokens: "+err.Error())
+	}
+	if err = h.save(balances); err != nil {
+		return "", common.NewError("lock_failed",
+			"saving htlc: "+err.Error())
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagAddHTLC, h.ID, event.HTLC{
+		HTLCID:     h.ID,
+		SenderID:   h.SenderID,
+		ReceiverID: h.ReceiverID,
+		HashLock:   h.HashLock,
+		Amount:     h.Amount,
+		Expiry:     int64(h.Expiry),
+		Status:     h.Status,
+	})
+
+	resp, err := h.response()
+	if err != nil {
+		return "", common.NewError("lock_failed", err.Error())
+	}
+	return resp, nil
+}
+
+// claim the tokens to the receiver revealing the preimage, anyone knowing
+// the preimage can do it before the expiry
+func (hsc *HTLCSmartContract) claim(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	var cr claimRequest
+	if err := cr.decode(input); err != nil {
+		return "", common.NewError("claim_failed",
+			"malformed request: "+err.Error())
+	}
+
+	h, err := getHTLC(cr.HTLCID, balances)
+	if err != nil {
+		return "", common.NewError("claim_failed",
+			"can't get htlc: "+err.Error())
+	}
+	switch {
+	case h.Status != StatusLocked:
+		return "", common.NewError("claim_failed", "htlc is "+h.Status)
+	case balances.GetBlock().CreationDate >= h.Expiry:
+		return "", common.NewError("claim_failed", "htlc is expired")
+	}
+
+	preimage, err := hex.DecodeString(cr.Preimage)
+	if err != nil || len(preimage) > maxPreimageLength {
+		return "", common.NewError("claim_failed", "invalid preimage")
+	}
+	hashLock, _ := hex.DecodeString(h.HashLock)
+	if !bytes.Equal(HashPreimage(preimage), hashLock) {
+		return "", common.NewError("claim_failed",
+			"preimage doesn't match hash lock")
+	}
+
+	h.Preimage = cr.Preimage
+	if err = h.close(StatusClaimed, h.ReceiverID, balances); err != nil {
+		return "", common.NewError("claim_failed", err.Error())
+	}
+
+	resp, err := h.response()
+	if err != nil {
+		return "", common.NewError("claim_failed", err.Error())
+	}
+	return resp, nil
+}
+
+// refund the tokens to the sender after the expiry
+func (hsc *HTLCSmartContract) refund(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	var rr refundRequest
+	if err := rr.decode(input); err != nil {
+		return "", common.NewError("refund_failed",
+			"malformed request: "+err.Error())
+	}
+
+	h, err := getHTLC(rr.HTLCID, balances)
+	if err != nil {
+		return "", common.NewError("refund_failed",
+			"can't get htlc: "+err.Error())
+	}
+	switch {
+	case h.SenderID != t.ClientID:
+		return "", common.NewError("refund_failed",
+			"only sender of the htlc can refund it")
+	case h.Status != StatusLocked:
+		return "", common.NewError("refund_failed", "htlc is "+h.Status)
+	case balances.GetBlock().CreationDate < h.Expiry:
+		return "", common.NewError("refund_failed", "htlc is not expired")
+	}
+
+	if err = h.close(StatusRefunded, h.SenderID, balances); err != nil {
+		return "", common.NewError("refund_failed", err.Error())
+	}
+
+	resp, err := h.response()
+	if err != nil {
+		return "", common.NewError("refund_failed", err.Error())
+	}
+	return resp, nil
+}
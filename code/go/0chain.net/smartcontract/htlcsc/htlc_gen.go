@@ -0,0 +1,130 @@
+package htlcsc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *HTLC) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 8
+	// string "ID"
+	o = append(o, 0x88, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "SenderID"
+	o = append(o, 0xa8, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.SenderID)
+	// string "ReceiverID"
+	o = append(o, 0xaa, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ReceiverID)
+	// string "HashLock"
+	o = append(o, 0xa8, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x6f, 0x63, 0x6b)
+	o = msgp.AppendString(o, z.HashLock)
+	// string "Amount"
+	o = append(o, 0xa6, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
+	o, err = z.Amount.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Amount")
+		return
+	}
+	// string "Expiry"
+	o = append(o, 0xa6, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79)
+	o, err = z.Expiry.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Expiry")
+		return
+	}
+	// string "Status"
+	o = append(o, 0xa6, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73)
+	o = msgp.AppendString(o, z.Status)
+	// string "Preimage"
+	o = append(o, 0xa8, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65)
+	o = msgp.AppendString(o, z.Preimage)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *HTLC) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "SenderID":
+			z.SenderID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SenderID")
+				return
+			}
+		case "ReceiverID":
+			z.ReceiverID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReceiverID")
+				return
+			}
+		case "HashLock":
+			z.HashLock, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "HashLock")
+				return
+			}
+		case "Amount":
+			bts, err = z.Amount.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Amount")
+				return
+			}
+		case "Expiry":
+			bts, err = z.Expiry.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Expiry")
+				return
+			}
+		case "Status":
+			z.Status, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		case "Preimage":
+			z.Preimage, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Preimage")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *HTLC) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 9 + msgp.StringPrefixSize + len(z.SenderID) + 11 + msgp.StringPrefixSize + len(z.ReceiverID) + 9 + msgp.StringPrefixSize + len(z.HashLock) + 7 + z.Amount.Msgsize() + 7 + z.Expiry.Msgsize() + 7 + msgp.StringPrefixSize + len(z.Status) + 9 + msgp.StringPrefixSize + len(z.Preimage)
+	return
+}
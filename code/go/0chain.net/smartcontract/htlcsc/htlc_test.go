@@ -0,0 +1,185 @@
+package htlcsc
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/chain/state/statetest"
+	"0chain.net/chaincore/state"
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/dbs/event"
+)
+
+const (
+	testSender   = "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745803"
+	testReceiver = "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745804"
+	testAmount   = currency.Coin(2e10)
+)
+
+var (
+	testPreimage = hex.EncodeToString([]byte("secret"))
+	testHashLock = hex.EncodeToString(HashPreimage([]byte("secret")))
+)
+
+func (tc *testChain) lock(now common.Timestamp, value currency.Coin,
+	lr *lockRequest) (*HTLC, error) {
+
+	var (
+		hsc      = &HTLCSmartContract{}
+		tx       = statetest.NewTransaction(testSender, ADDRESS, value, now)
+		balances = tc.ContextAt(now, tx)
+	)
+	_, err := hsc.lock(tx, statetest.MustEncode(tc.T, lr), balances)
+	if err != nil {
+		return nil, err
+	}
+	require.Equal(tc.T, []*state.Transfer{
+		state.NewTransfer(testSender, ADDRESS, value),
+	}, balances.GetTransfers())
+	require.Len(tc.T, balances.GetEvents(), 1)
+	require.Equal(tc.T, event.TagAddHTLC, balances.GetEvents()[0].Tag)
+	return getHTLC(tx.Hash, balances)
+}
+
+func (tc *testChain) claim(now common.Timestamp, htlcID, preimage string) (
+	[]*state.Transfer, error) {
+
+	var (
+		hsc      = &HTLCSmartContract{}
+		tx       = statetest.NewTransaction(statetest.Owner, ADDRESS, 0, now)
+		balances = tc.ContextAt(now, tx)
+	)
+	_, err := hsc.claim(tx, statetest.MustEncode(tc.T, &claimRequest{
+		HTLCID:   htlcID,
+		Preimage: preimage,
+	}), balances)
+	return balances.GetTransfers(), err
+}
+
+func (tc *testChain) refund(now common.Timestamp, from, htlcID string) (
+	[]*state.Transfer, error) {
+
+	var (
+		hsc      = &HTLCSmartContract{}
+		tx       = statetest.NewTransaction(from, ADDRESS, 0, now)
+		balances = tc.ContextAt(now, tx)
+	)
+	_, err := hsc.refund(tx, statetest.MustEncode(tc.T, &refundRequest{HTLCID: htlcID}), balances)
+	return balances.GetTransfers(), err
+}
+
+func TestLock(t *testing.T) {
+	var tc = newTestChain(t)
+
+	var request = func() *lockRequest {
+		return &lockRequest{
+			ReceiverID: testReceiver,
+			HashLock:   testHashLock,
+			Expiry:     200,
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(lr *lockRequest)
+		value  currency.Coin
+		err    string
+	}{
+		{"no receiver", func(lr *lockRequest) { lr.ReceiverID = "" }, testAmount,
+			"missing receiver"},
+		{"to sender", func(lr *lockRequest) { lr.ReceiverID = testSender }, testAmount,
+			"can't lock tokens to the sender"},
+		{"low amount", func(lr *lockRequest) {}, 1,
+			"locked tokens are less than min lock 10000000000"},
+		{"short", func(lr *lockRequest) { lr.Expiry = 159 }, testAmount,
+			"expiry is earlier than min duration 1m0s"},
+		{"long", func(lr *lockRequest) { lr.Expiry = 3701 }, testAmount,
+			"expiry is later than max duration 1h0m0s"},
+		{"invalid hash lock", func(lr *lockRequest) { lr.HashLock = "zz" }, testAmount,
+			"hash lock is not hex of sha256"},
+		{"short hash lock", func(lr *lockRequest) { lr.HashLock = testPreimage }, testAmount,
+			"hash lock is not hex of sha256"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var lr = request()
+			tt.modify(lr)
+			_, err := tc.lock(100, tt.value, lr)
+			statetest.RequireErrMsg(t, err, "lock_failed: invalid request: "+tt.err)
+		})
+	}
+
+	h, err := tc.lock(100, testAmount, request())
+	require.NoError(t, err)
+	assert.Equal(t, testSender, h.SenderID)
+	assert.Equal(t, testReceiver, h.ReceiverID)
+	assert.Equal(t, testAmount, h.Amount)
+	assert.EqualValues(t, 200, h.Expiry)
+	assert.Equal(t, StatusLocked, h.Status)
+}
+
+func TestClaim(t *testing.T) {
+	var tc = newTestChain(t)
+
+	h, err := tc.lock(100, testAmount, &lockRequest{
+		ReceiverID: testReceiver,
+		HashLock:   testHashLock,
+		Expiry:     200,
+	})
+	require.NoError(t, err)
+
+	_, err = tc.claim(150, h.ID, "zz")
+	statetest.RequireErrMsg(t, err, "claim_failed: invalid preimage")
+	_, err = tc.claim(150, h.ID, hex.EncodeToString([]byte("other")))
+	statetest.RequireErrMsg(t, err, "claim_failed: preimage doesn't match hash lock")
+	_, err = tc.claim(200, h.ID, testPreimage)
+	statetest.RequireErrMsg(t, err, "claim_failed: htlc is expired")
+
+	// anyone can claim the tokens to the receiver
+	transfers, err := tc.claim(199, h.ID, testPreimage)
+	require.NoError(t, err)
+	assert.Equal(t, []*state.Transfer{
+		state.NewTransfer(ADDRESS, testReceiver, testAmount),
+	}, transfers)
+
+	// the preimage is kept for the other side of the swap
+	h, err = getHTLC(h.ID, tc.ContextAt(199, nil))
+	require.NoError(t, err)
+	assert.Equal(t, StatusClaimed, h.Status)
+	assert.Equal(t, testPreimage, h.Preimage)
+
+	_, err = tc.claim(199, h.ID, testPreimage)
+	statetest.RequireErrMsg(t, err, "claim_failed: htlc is claimed")
+	_, err = tc.refund(300, testSender, h.ID)
+	statetest.RequireErrMsg(t, err, "refund_failed: htlc is claimed")
+}
+
+func TestRefund(t *testing.T) {
+	var tc = newTestChain(t)
+
+	h, err := tc.lock(100, testAmount, &lockRequest{
+		ReceiverID: testReceiver,
+		HashLock:   testHashLock,
+		Expiry:     200,
+	})
+	require.NoError(t, err)
+
+	_, err = tc.refund(199, testSender, h.ID)
+	statetest.RequireErrMsg(t, err, "refund_failed: htlc is not expired")
+	_, err = tc.refund(200, testReceiver, h.ID)
+	statetest.RequireErrMsg(t, err, "refund_failed: only sender of the htlc can refund it")
+
+	transfers, err := tc.refund(200, testSender, h.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []*state.Transfer{
+		state.NewTransfer(ADDRESS, testSender, testAmount),
+	}, transfers)
+
+	_, err = tc.claim(150, h.ID, testPreimage)
+	statetest.RequireErrMsg(t, err, "claim_failed: htlc is refunded")
+	_, err = tc.refund(200, testSender, h.ID)
+	statetest.RequireErrMsg(t, err, "refund_failed: htlc is refunded")
+}
@@ -0,0 +1,114 @@
+package htlcsc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+)
+
+const (
+	ADDRESS = "6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e3"
+)
+
+// HTLCSmartContract locks tokens to a receiver on a hash of a secret and
+// an expiry time, the receiver claims the tokens revealing the secret
+// before the expiry, otherwise the sender gets them back
+type HTLCSmartContract struct {
+	*smartcontractinterface.SmartContract
+}
+
+func NewHTLCSmartContract() smartcontractinterface.SmartContractInterface {
+	var hscCopy = &HTLCSmartContract{
+		smartcontractinterface.NewSC(ADDRESS),
+	}
+	hscCopy.setSC(hscCopy.SmartContract, &smartcontract.BCContext{})
+	return hscCopy
+}
+
+func (hsc *HTLCSmartContract) GetHandlerStats(ctx context.Context, params url.Values) (interface{}, error) {
+	return hsc.SmartContract.HandlerStats(ctx, params)
+}
+
+func (hsc *HTLCSmartContract) GetExecutionStats() map[string]interface{} {
+	return hsc.SmartContractExecutionStats
+}
+
+func (hsc *HTLCSmartContract) GetName() string {
+	return "htlc"
+}
+
+func (hsc *HTLCSmartContract) GetAddress() string {
+	return ADDRESS
+}
+
+func (hsc *HTLCSmartContract) GetCost(t *transaction.Transaction, funcName string, balances chainstate.StateContextI) (int, error) {
+	conf, err := getConfig(balances)
+	if err != nil {
+		return math.MaxInt32, err
+	}
+	if conf.Cost == nil {
+		return math.MaxInt32, errors.New("can't get cost")
+	}
+	cost, ok := conf.Cost[funcName]
+	if !ok {
+		return math.MaxInt32, errors.New("no cost given for " + funcName)
+	}
+	return cost, nil
+}
+
+func (hsc *HTLCSmartContract) setSC(sc *smartcontractinterface.SmartContract,
+	bcContext smartcontractinterface.BCContextI) {
+
+	hsc.SmartContract = sc
+
+	// lock tokens to a receiver
+	hsc.SmartContractExecutionStats["lock"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "lock"), nil)
+
+	// claim locked tokens with the preimage of the hash lock
+	hsc.SmartContractExecutionStats["claim"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "claim"), nil)
+
+	// refund expired lock to its sender
+	hsc.SmartContractExecutionStats["refund"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "refund"), nil)
+
+	hsc.SmartContractExecutionStats["htlcsc-update-settings"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", hsc.ID, "htlcsc-update-settings"), nil)
+}
+
+func (hsc *HTLCSmartContract) Execute(t *transaction.Transaction,
+	function string, input []byte, balances chainstate.StateContextI) (
+	resp string, err error) {
+
+	switch function {
+
+	case "lock":
+		resp, err = hsc.lock(t, input, balances)
+	case "claim":
+		resp, err = hsc.claim(t, input, balances)
+	case "refund":
+		resp, err = hsc.refund(t, input, balances)
+	case "htlcsc-update-settings":
+		resp, err = hsc.updateConfig(t, input, balances)
+	default:
+		err = common.NewError("htlc_sc_failed",
+			fmt.Sprintf("no function with %q name", function))
+	}
+	return
+}
+
+func toSeconds(dur time.Duration) common.Timestamp {
+	return common.Timestamp(dur / time.Second)
+}
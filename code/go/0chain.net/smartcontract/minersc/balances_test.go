@@ -25,6 +25,7 @@ type testBalances struct {
 	block         *block.Block
 	blockSharders []string
 	lfmb          *block.Block
+	magicBlock    *block.MagicBlock
 }
 
 func newTestBalances() *testBalances {
@@ -60,7 +61,7 @@ func (tb *testBalances) GetBlock() *block.Block {
 }
 
 func (tb *testBalances) GetMagicBlock(round int64) *block.MagicBlock {
-	return nil
+	return tb.magicBlock
 }
 
 func (tb *testBalances) SetMagicBlock(mb *block.MagicBlock) {
@@ -156,7 +157,7 @@ func (tb *testBalances) AddMint(mint *state.Mint) error {
 }
 
 func (tb *testBalances) GetChainCurrentMagicBlock() *block.MagicBlock {
-	return nil
+	return tb.magicBlock
 }
 
 func (tb *testBalances) GetClientState(clientID datastore.Key) (*state.State, error) {
@@ -168,6 +168,22 @@ func BenchmarkRestTests(
 				},
 				Endpoint: mrh.getDelegateRewards,
 			},
+			{
+				FuncName: "equivocations",
+				Params: map[string]string{
+					"miner_id": data.Miners[0],
+					"limit":    "20",
+				},
+				Endpoint: mrh.getEquivocations,
+			},
+			{
+				FuncName: "simulate-rewards",
+				Params: map[string]string{
+					"provider_id": data.Miners[0],
+					"rounds":      "100",
+				},
+				Endpoint: mrh.getSimulateRewards,
+			},
 		},
 		ADDRESS,
 		mrh,
@@ -59,13 +59,16 @@ func (bt BenchTest) Transaction() *transaction.Transaction {
 
 func (bt BenchTest) Run(balances cstate.TimedQueryStateContext, b *testing.B) error {
 	b.StopTimer()
-	if bt.name == "miner.shareSignsOrShares" {
+	if bt.name == "miner.shareSignsOrShares" || bt.name == "miner.complainDKG" {
 		var pn = PhaseNode{
 			Phase:        Publish,
 			StartRound:   1,
 			CurrentRound: 2,
 			Restarts:     0,
 		}
+		if bt.name == "miner.complainDKG" {
+			pn.Phase = Complain
+		}
 		_, err := balances.InsertTrieNode(pn.GetKey(), &pn)
 		if err != nil {
 			panic(err)
@@ -142,6 +145,20 @@ func BenchmarkTests(
 				},
 			}).Encode(),
 		},
+		{
+			name:     "miner.complainDKG",
+			endpoint: msc.complainDKG,
+			txn: &transaction.Transaction{
+				ClientID:     data.Miners[0],
+				CreationDate: creationTime,
+			},
+			input: (&DKGComplaints{
+				Complaints: []*DKGComplaint{{
+					DealerID: data.Miners[1],
+					Share:    data.Miners[0],
+				}},
+			}).Encode(),
+		},
 		{
 			name:     "miner.update_globals",
 			endpoint: msc.minerHealthCheck,
@@ -292,6 +309,9 @@ func BenchmarkTests(
 					"cost.addToDelegatePool":       "111",
 					"cost.deleteFromDelegatePool":  "111",
 					"cost.sharder_keep":            "111",
+					"cost.equivocation":            "111",
+					"cost.unjail":                  "111",
+					"cost.complainDKG":             "111",
 				},
 			}).Encode(),
 		},
@@ -421,6 +441,35 @@ func BenchmarkTests(
 				return bytes
 			}(),
 		},
+		{
+			name:     "miner.equivocation",
+			endpoint: msc.reportEquivocation,
+			txn: &transaction.Transaction{
+				ClientID:   data.Clients[0],
+				ToClientID: ADDRESS,
+			},
+			input: (&EquivocationEvidence{
+				Type:    EquivocationBlock,
+				MinerID: data.Miners[0],
+				First: SignedHeader{
+					Header: block.Header{MinerID: data.Miners[0], Round: 1, PrevHash: "a", MerkleRoot: "a"},
+				},
+				Second: SignedHeader{
+					Header: block.Header{MinerID: data.Miners[0], Round: 1, PrevHash: "a", MerkleRoot: "b"},
+				},
+			}).Encode(),
+		},
+		{
+			name:     "miner.unjail",
+			endpoint: msc.unjail,
+			txn: &transaction.Transaction{
+				ClientID:   data.Clients[0],
+				ToClientID: ADDRESS,
+			},
+			input: (&MinerNode{
+				SimpleNode: &SimpleNode{ID: data.Miners[0]},
+			}).Encode(),
+		},
 	}
 	var testsI []bk.BenchTestI
 	for _, test := range tests {
@@ -18,6 +18,12 @@ func (msc *MinerSmartContract) addToDelegatePool(t *transaction.Transaction,
 
 // getStakePool of given blobber
 func (msc *MinerSmartContract) getStakePoolAdapter(pType spenum.Provider, providerID string,
+	balances cstate.CommonStateContextI) (sp stakepool.AbstractStakePool, err error) {
+	return GetStakePoolAdapter(pType, providerID, balances)
+}
+
+// GetStakePoolAdapter returns stake pool of given miner or sharder
+func GetStakePoolAdapter(pType spenum.Provider, providerID string,
 	balances cstate.CommonStateContextI) (sp stakepool.AbstractStakePool, err error) {
 	var mn *MinerNode
 	switch pType {
@@ -24,6 +24,7 @@ var moveFunctions = make(map[Phase]movePhaseFunctions)
 - Start      : moveToContribute
 - Contribute : moveToShareOrPublish
 - Share      : moveToShareOrPublish
+- Complain   : moveToShareOrPublish
 - Publish    : moveToWait
 - Wait       : moveToStart
 */
@@ -144,10 +145,10 @@ func (msc *MinerSmartContract) moveToShareOrPublish(
 			int(balances.GetState().GetVersion()))
 	}
 
-	if len(mpks.Mpks) < dkgMinersList.K {
+	if len(mpks.Mpks) < dkgMinersList.minDealers() {
 		return common.NewErrorf("move_to_share_or_publish_failed",
-			"len(mpks.Mpks) < dkgMinersList.K, l_mpks: %d, K: %d",
-			len(mpks.Mpks), dkgMinersList.K)
+			"len(mpks.Mpks) < dkgMinersList.minDealers(), l_mpks: %d, min: %d, reshare: %t",
+			len(mpks.Mpks), dkgMinersList.minDealers(), dkgMinersList.Reshare)
 	}
 
 	Logger.Debug("miner sc: move phase to share or publish",
@@ -183,10 +184,10 @@ func (msc *MinerSmartContract) moveToWait(balances cstate.StateContextI,
 			len(gsos.Shares), dkgMinersList.K, int(balances.GetState().GetVersion()), gsos.Shares)
 	}
 
-	if len(gsos.Shares) < dkgMinersList.K {
+	if len(gsos.Shares) < dkgMinersList.minDealers() {
 		return common.NewErrorf("move_to_wait_failed",
-			"len(gsos.Shares) < dkgMinersList.K, l_gsos: %d, K: %d",
-			len(gsos.Shares), dkgMinersList.K)
+			"len(gsos.Shares) < dkgMinersList.minDealers(), l_gsos: %d, min: %d, reshare: %t",
+			len(gsos.Shares), dkgMinersList.minDealers(), dkgMinersList.Reshare)
 	}
 
 	Logger.Debug("miner sc: move phase to wait",
@@ -294,11 +295,8 @@ func (msc *MinerSmartContract) setPhaseNode(balances cstate.StateContextI,
 				}
 			}
 			if err == nil {
-				if pn.Phase >= Phase(len(PhaseRounds)-1) {
-					pn.Phase = 0
+				if pn.Phase = pn.Phase.next(); pn.Phase == Start {
 					pn.Restarts = 0
-				} else {
-					pn.Phase++
 				}
 				pn.StartRound = pn.CurrentRound
 				Logger.Debug("setPhaseNode", zap.String("next_phase", pn.Phase.String()))
@@ -326,8 +324,18 @@ func (msc *MinerSmartContract) createDKGMinersForContribute(
 		return err
 	}
 
-	if len(allMinersList.Nodes) < gn.MinN {
-		return common.NewErrorf("failed to create dkg miners", "too few miners for dkg, l_all_miners: %d, N: %d", len(allMinersList.Nodes), gn.MinN)
+	// jailed miners are excluded from view change, the list nodes are
+	// read by their own keys, so they have the jail state the jail and
+	// unjail saved
+	candidates := make([]*MinerNode, 0, len(allMinersList.Nodes))
+	for _, nd := range allMinersList.Nodes {
+		if !nd.Jailed {
+			candidates = append(candidates, nd)
+		}
+	}
+
+	if len(candidates) < gn.MinN {
+		return common.NewErrorf("failed to create dkg miners", "too few miners for dkg, l_all_miners: %d, N: %d", len(candidates), gn.MinN)
 	}
 
 	dkgMiners := NewDKGMinerNodes()
@@ -340,21 +348,25 @@ func (msc *MinerSmartContract) createDKGMinersForContribute(
 			dkgMiners.calculateTKN(gn, num)
 		} else {
 			Logger.Debug("Calculate TKN from all miner list",
-				zap.Int("all count", len(allMinersList.Nodes)),
+				zap.Int("all count", len(candidates)),
 				zap.Int64("gn.LastRound", gn.LastRound))
-			dkgMiners.calculateTKN(gn, len(allMinersList.Nodes))
+			dkgMiners.calculateTKN(gn, len(candidates))
 		}
 	} else {
 		Logger.Debug("Calculate TKN from all miner list",
-			zap.Int("all count", len(allMinersList.Nodes)),
+			zap.Int("all count", len(candidates)),
 			zap.Int64("gn.LastRound", gn.LastRound))
-		dkgMiners.calculateTKN(gn, len(allMinersList.Nodes))
+		dkgMiners.calculateTKN(gn, len(candidates))
 	}
 
-	for _, nd := range allMinersList.Nodes {
+	for _, nd := range candidates {
 		dkgMiners.SimpleNodes[nd.ID] = nd.SimpleNode
 	}
 
+	if gn.ReshareDKG {
+		dkgMiners.setReshare(balances.GetLastestFinalizedMagicBlock())
+	}
+
 	dkgMiners.StartRound = gn.LastRound
 	if err := updateDKGMinersList(balances, dkgMiners); err != nil {
 		return err
@@ -385,8 +397,10 @@ func (msc *MinerSmartContract) widdleDKGMinersForShare(
 		return err
 	}
 
+	// the new miners don't deal resharing
+	var pmb = balances.GetLastestFinalizedMagicBlock()
 	for k := range dkgMiners.SimpleNodes {
-		if _, ok := mpks.Mpks[k]; !ok {
+		if _, ok := mpks.Mpks[k]; !ok && dkgMiners.isDealer(k, pmb) {
 			delete(dkgMiners.SimpleNodes, k)
 		}
 	}
@@ -552,6 +566,12 @@ func (msc *MinerSmartContract) createMagicBlockForWait(
 			"len(dkgMinersList.SimpleNodes) [%d] < dkgMinersList.K [%d]", len(dkgMinersList.SimpleNodes), dkgMinersList.K)
 	}
 
+	if dkgMinersList.Reshare && len(mpks.Mpks) < dkgMinersList.PrevT {
+		return common.NewErrorf("create_magic_block_failed",
+			"not enough dealers to reshare, l_mpks: %d, prev T: %d",
+			len(mpks.Mpks), dkgMinersList.PrevT)
+	}
+
 	magicBlock, err := msc.createMagicBlock(balances, sharders, dkgMinersList, gsos, mpks, pn)
 	if err != nil {
 		return err
@@ -623,6 +643,13 @@ func (msc *MinerSmartContract) contributeMpk(t *transaction.Transaction,
 			"mpk sent (size: %v) is not correct size: %v", len(mpk.Mpk), dmn.T)
 	}
 
+	if dmn.Reshare {
+		if err := validateReshareMPK(dmn, mpk, balances); err != nil {
+			return "", common.NewErrorf("contribute_mpk_failed",
+				"resharing: %v", err)
+		}
+	}
+
 	mpks, err := getMinersMPKs(balances)
 	switch err {
 	case util.ErrValueNotPresent:
@@ -691,12 +718,22 @@ func (msc *MinerSmartContract) shareSignsOrShares(t *transaction.Transaction,
 			"getting miners DKG list %v", err)
 	}
 
+	if _, ok = dmn.Disqualified[t.ClientID]; ok {
+		return "", common.NewError("share_signs_or_shares",
+			"miner is disqualified by a complaint")
+	}
+
 	var sos = block.NewShareOrSigns()
 	if err = sos.Decode(inputData); err != nil {
 		return "", common.NewErrorf("share_signs_or_shares",
 			"decoding input %v", err)
 	}
 
+	// the shares and signs of the disqualified dealers don't count
+	for id := range dmn.Disqualified {
+		delete(sos.ShareOrSigns, id)
+	}
+
 	if len(sos.ShareOrSigns) < dmn.K-1 {
 		return "", common.NewErrorf("share_signs_or_shares",
 			"not enough share or signs for this dkg, l_sos: %d, K - 1: %d",
@@ -805,6 +842,7 @@ func (msc *MinerSmartContract) createMagicBlock(
 	magicBlock.T = dkgMinersList.T
 	magicBlock.K = dkgMinersList.K
 	magicBlock.N = dkgMinersList.N
+	magicBlock.Reshared = dkgMinersList.Reshare
 	magicBlock.MagicBlockNumber = pmb.MagicBlock.MagicBlockNumber + 1
 	magicBlock.PreviousMagicBlockHash = pmb.MagicBlock.Hash
 	magicBlock.StartingRound = pn.CurrentRound + PhaseRounds[Wait]
@@ -0,0 +1,162 @@
+package minersc
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"0chain.net/chaincore/block"
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/threshold/bls"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	. "github.com/0chain/common/core/logging"
+)
+
+// DKGComplaint is a proof of a dealer of the view change DKG dealt an
+// invalid share to the complaining miner: the share signed by the dealer
+// doesn't match the MPK the dealer contributed.
+type DKGComplaint struct {
+	DealerID string `json:"dealer_id"`
+	Share    string `json:"share"`
+	// Sign is the signature of the dealer of the share message
+	Sign string `json:"sign"`
+}
+
+// DKGComplaints are the complaints of a miner about the dealers of the
+// view change DKG.
+type DKGComplaints struct {
+	Complaints []*DKGComplaint `json:"complaints"`
+}
+
+func (dcs *DKGComplaints) Encode() []byte {
+	var b, err = json.Marshal(dcs)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (dcs *DKGComplaints) Decode(p []byte) error {
+	return json.Unmarshal(p, dcs)
+}
+
+// verify the complaint of the complainant, the dealer and its MPK must be
+// in the DKG, and the share must be signed by the dealer for the complainant
+func (c *DKGComplaint) verify(complainant string, dmn *DKGMinerNodes,
+	mpks *block.Mpks, scheme bls.Scheme,
+	signatureScheme encryption.SignatureScheme) error {
+
+	if c.DealerID == complainant {
+		return errors.New("complaining about itself")
+	}
+	dealer, ok := dmn.SimpleNodes[c.DealerID]
+	if !ok {
+		return errors.New("dealer not part of dkg set")
+	}
+	mpk, ok := mpks.Mpks[c.DealerID]
+	if !ok {
+		return errors.New("no mpk of the dealer")
+	}
+
+	if err := signatureScheme.SetPublicKey(dealer.PublicKey); err != nil {
+		return err
+	}
+	ok, err := signatureScheme.Verify(c.Sign,
+		bls.ShareMessage(complainant, c.Share, mpk.Mpk))
+	if err != nil || !ok {
+		return errors.New("invalid signature of the dealer")
+	}
+
+	if err := scheme.ValidateShare(mpk.Mpk, c.Share, complainant); err == nil {
+		return errors.New("the share is valid")
+	}
+	return nil
+}
+
+// complainDKG disqualifies the dealers proven to deal invalid shares to the
+// miner sending the complaints. A disqualified dealer is removed from the
+// DKG set along with its MPK, and can't publish its shares or signs.
+func (msc *MinerSmartContract) complainDKG(t *transaction.Transaction,
+	inputData []byte, gn *GlobalNode, balances cstate.StateContextI) (
+	resp string, err error) {
+
+	pn, err := GetPhaseNode(balances)
+	if err != nil {
+		return "", common.NewErrorf("complain_dkg_failed",
+			"can't get phase node: %v", err)
+	}
+	if pn.Phase != Complain {
+		return "", common.NewErrorf("complain_dkg_failed",
+			"this is not the correct phase to complain: %s", pn.Phase)
+	}
+
+	var complaints DKGComplaints
+	if err = complaints.Decode(inputData); err != nil {
+		return "", common.NewErrorf("complain_dkg_failed",
+			"decoding request: %v", err)
+	}
+	if len(complaints.Complaints) == 0 {
+		return "", common.NewError("complain_dkg_failed", "no complaints")
+	}
+
+	dmn, err := getDKGMinersList(balances)
+	if err != nil {
+		return "", common.NewErrorf("complain_dkg_failed",
+			"getting miners DKG list: %v", err)
+	}
+	if _, ok := dmn.SimpleNodes[t.ClientID]; !ok {
+		return "", common.NewError("complain_dkg_failed",
+			"miner not part of dkg set")
+	}
+	scheme, err := bls.GetScheme(dmn.Scheme)
+	if err != nil {
+		return "", common.NewError("complain_dkg_failed", err.Error())
+	}
+
+	msc.mutexMinerMPK.Lock()
+	defer msc.mutexMinerMPK.Unlock()
+
+	mpks, err := getMinersMPKs(balances)
+	if err != nil {
+		return "", common.NewErrorf("complain_dkg_failed",
+			"getting miners mpks: %v", err)
+	}
+
+	if dmn.Disqualified == nil {
+		dmn.Disqualified = make(map[string]string)
+	}
+	var disqualified []string
+	for _, c := range complaints.Complaints {
+		if _, ok := dmn.Disqualified[c.DealerID]; ok {
+			continue // proven by another miner
+		}
+		if err := c.verify(t.ClientID, dmn, mpks, scheme,
+			balances.GetSignatureScheme()); err != nil {
+			return "", common.NewErrorf("complain_dkg_failed",
+				"complaint about %s: %v", c.DealerID, err)
+		}
+		dmn.Disqualified[c.DealerID] = t.ClientID
+		delete(dmn.SimpleNodes, c.DealerID)
+		delete(mpks.Mpks, c.DealerID)
+		disqualified = append(disqualified, c.DealerID)
+	}
+
+	if err := updateMinersMPKs(balances, mpks); err != nil {
+		return "", common.NewErrorf("complain_dkg_failed",
+			"saving miners mpks: %v", err)
+	}
+	if err := updateDKGMinersList(balances, dmn); err != nil {
+		return "", common.NewErrorf("complain_dkg_failed",
+			"saving DKG miners: %v", err)
+	}
+
+	Logger.Info("complain_dkg: dealers disqualified",
+		zap.String("complainant", t.ClientID),
+		zap.Strings("dealers", disqualified))
+
+	return strings.Join(disqualified, ","), nil
+}
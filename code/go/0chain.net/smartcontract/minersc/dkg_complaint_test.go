@@ -0,0 +1,104 @@
+package minersc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/threshold/bls"
+	"0chain.net/chaincore/transaction"
+)
+
+func TestMinerSmartContract_complainDKG(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		msc      = newTestMinerSC()
+		gn       = setConfig(t, balances)
+		dealer   = newClient(0, balances)
+		miner    = newClient(0, balances)
+		dmn      = NewDKGMinerNodes()
+		mpks     = block.NewMpks()
+		dkg      = bls.MakeDKG(2, 2, dealer.id)
+		mpk      = &block.MPK{ID: dealer.id}
+	)
+	for _, c := range []*Client{dealer, miner} {
+		dmn.SimpleNodes[c.id] = &SimpleNode{ID: c.id, PublicKey: c.pk}
+	}
+	for _, pk := range dkg.GetMPKs() {
+		mpk.Mpk = append(mpk.Mpk, pk.GetHexString())
+	}
+	mpks.Mpks[dealer.id] = mpk
+	balances.block = &block.Block{}
+	mustSave(t, PhaseKey, &PhaseNode{Phase: Complain}, balances)
+	require.NoError(t, updateDKGMinersList(balances, dmn))
+	require.NoError(t, updateMinersMPKs(balances, mpks))
+
+	var complain = func(share string) (string, error) {
+		sign, err := dealer.scheme.Sign(bls.ShareMessage(miner.id, share, mpk.Mpk))
+		require.NoError(t, err)
+		var complaints = &DKGComplaints{Complaints: []*DKGComplaint{{
+			DealerID: dealer.id,
+			Share:    share,
+			Sign:     sign,
+		}}}
+		var txn = &transaction.Transaction{ClientID: miner.id}
+		return msc.complainDKG(txn, complaints.Encode(), gn, balances)
+	}
+
+	valid, err := dkg.ComputeDKGKeyShare(bls.ComputeIDdkg(miner.id))
+	require.NoError(t, err)
+	invalid, err := dkg.ComputeDKGKeyShare(bls.ComputeIDdkg(dealer.id))
+	require.NoError(t, err)
+
+	_, err = complain(valid.GetHexString())
+	require.EqualError(t, err, "complain_dkg_failed: complaint about "+
+		dealer.id+": the share is valid")
+
+	var txn = &transaction.Transaction{ClientID: miner.id}
+	_, err = msc.complainDKG(txn, (&DKGComplaints{Complaints: []*DKGComplaint{{
+		DealerID: dealer.id,
+		Share:    invalid.GetHexString(),
+	}}}).Encode(), gn, balances)
+	require.EqualError(t, err, "complain_dkg_failed: complaint about "+
+		dealer.id+": invalid signature of the dealer")
+
+	resp, err := complain(invalid.GetHexString())
+	require.NoError(t, err)
+	assert.Equal(t, dealer.id, resp)
+
+	dmn, err = getDKGMinersList(balances)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{dealer.id: miner.id}, dmn.Disqualified)
+	assert.NotContains(t, dmn.SimpleNodes, dealer.id)
+	mpks, err = getMinersMPKs(balances)
+	require.NoError(t, err)
+	assert.NotContains(t, mpks.Mpks, dealer.id)
+
+	// a disqualified dealer can't publish
+	mustSave(t, PhaseKey, &PhaseNode{Phase: Publish}, balances)
+	_, err = msc.shareSignsOrShares(&transaction.Transaction{ClientID: dealer.id},
+		block.NewShareOrSigns().Encode(), gn, balances)
+	require.EqualError(t, err, "share_signs_or_shares: miner is disqualified by a complaint")
+
+	_, err = complain(invalid.GetHexString())
+	require.EqualError(t, err, "complain_dkg_failed: this is not the correct phase to complain: publish")
+}
+
+func TestPhaseNext(t *testing.T) {
+	// the numbers of the phases are saved in the phase node
+	assert.EqualValues(t, 3, Publish)
+	assert.EqualValues(t, 4, Wait)
+
+	var (
+		p     = Start
+		order []Phase
+	)
+	for i := 0; i < 6; i++ {
+		order = append(order, p)
+		p = p.next()
+	}
+	assert.Equal(t, []Phase{Start, Contribute, Share, Complain, Publish, Wait}, order)
+	assert.Equal(t, Start, p)
+}
@@ -0,0 +1,82 @@
+package minersc
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"0chain.net/chaincore/block"
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/threshold/bls"
+	. "github.com/0chain/common/core/logging"
+)
+
+// setReshare makes the view change reshare the group secret of the previous
+// magic block instead of generating a new one. The resharing needs T miners
+// of the previous magic block in the DKG set, otherwise the view change
+// runs a new DKG.
+func (dkgmn *DKGMinerNodes) setReshare(pmb *block.Block) {
+	if pmb == nil || pmb.MagicBlock == nil || pmb.Mpks == nil ||
+		len(pmb.Mpks.Mpks) == 0 {
+		return
+	}
+
+	var prev int
+	for id := range dkgmn.SimpleNodes {
+		if pmb.Miners.HasNode(id) {
+			prev++
+		}
+	}
+	if prev < pmb.T {
+		Logger.Info("reshare dkg: not enough miners of previous magic block",
+			zap.Int("miners", prev), zap.Int("T", pmb.T))
+		return
+	}
+
+	dkgmn.Reshare = true
+	dkgmn.PrevT = pmb.T
+}
+
+// minDealers is the number of dealers enough for the DKG
+func (dkgmn *DKGMinerNodes) minDealers() int {
+	if dkgmn.Reshare {
+		return dkgmn.PrevT
+	}
+	return dkgmn.K
+}
+
+// isDealer returns true if the miner deals in the DKG, every miner deals in
+// a new DKG, and only the miners of previous magic block reshare.
+func (dkgmn *DKGMinerNodes) isDealer(id string, pmb *block.Block) bool {
+	if !dkgmn.Reshare {
+		return true
+	}
+	return pmb != nil && pmb.MagicBlock != nil && pmb.Miners.HasNode(id)
+}
+
+// validateReshareMPK checks the MPK of the dealer commits to its group secret
+// key share of the previous magic block
+func validateReshareMPK(dmn *DKGMinerNodes, mpk *block.MPK,
+	balances cstate.StateContextI) error {
+
+	var pmb = balances.GetLastestFinalizedMagicBlock()
+	if !dmn.isDealer(mpk.ID, pmb) {
+		return errors.New("miner not part of previous magic block")
+	}
+
+	scheme, err := bls.GetScheme(dmn.Scheme)
+	if err != nil {
+		return err
+	}
+	rs, ok := scheme.(bls.ResharingScheme)
+	if !ok {
+		return fmt.Errorf("DKG scheme %q can't reshare", dmn.Scheme)
+	}
+
+	prev, err := pmb.Mpks.GetMpkMap()
+	if err != nil {
+		return fmt.Errorf("invalid mpks of previous magic block: %v", err)
+	}
+	return rs.ValidateReshareMPK(prev, pmb.Reshared, mpk.ID, mpk.Mpk)
+}
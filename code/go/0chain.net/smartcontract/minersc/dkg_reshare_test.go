@@ -0,0 +1,99 @@
+package minersc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/node"
+	"0chain.net/chaincore/threshold/bls"
+	"0chain.net/chaincore/transaction"
+)
+
+func mpkOf(id string, dkg bls.DKGI) *block.MPK {
+	var mpk = &block.MPK{ID: id}
+	for _, pk := range dkg.GetMPKs() {
+		mpk.Mpk = append(mpk.Mpk, pk.GetHexString())
+	}
+	return mpk
+}
+
+func TestMinerSmartContract_contributeMpkReshare(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		msc      = newTestMinerSC()
+		gn       = setConfig(t, balances)
+		old      = []*Client{newClient(0, balances), newClient(0, balances)}
+		joined   = newClient(0, balances)
+		dkgs     = make(map[string]*bls.DKG)
+		pmb      = new(block.Block)
+	)
+
+	// the DKG of the previous magic block
+	pmb.MagicBlock = block.NewMagicBlock()
+	pmb.MagicBlock.Miners = node.NewPool(node.NodeTypeMiner)
+	pmb.MagicBlock.T = 2
+	for _, c := range old {
+		dkgs[c.id] = bls.MakeDKG(2, 2, c.id)
+		pmb.MagicBlock.Miners.NodesMap[c.id] = new(node.Node)
+		pmb.MagicBlock.Mpks.Mpks[c.id] = mpkOf(c.id, dkgs[c.id])
+	}
+	for _, c := range old {
+		for _, dealer := range old {
+			share, err := dkgs[dealer.id].ComputeDKGKeyShare(bls.ComputeIDdkg(c.id))
+			require.NoError(t, err)
+			require.NoError(t, dkgs[c.id].AddSecretShare(
+				bls.ComputeIDdkg(dealer.id), share.GetHexString(), false))
+		}
+		require.NoError(t, dkgs[c.id].AggregateSecretKeyShares())
+	}
+	balances.setLFMB(pmb)
+	balances.block = &block.Block{}
+
+	var dmn = NewDKGMinerNodes()
+	dmn.T, dmn.N = 2, 3
+	for _, c := range append(old, joined) {
+		dmn.SimpleNodes[c.id] = &SimpleNode{ID: c.id, PublicKey: c.pk}
+	}
+	dmn.setReshare(pmb)
+	require.True(t, dmn.Reshare)
+	assert.Equal(t, 2, dmn.PrevT)
+	assert.Equal(t, 2, dmn.minDealers())
+	assert.True(t, dmn.isDealer(old[0].id, pmb))
+	assert.False(t, dmn.isDealer(joined.id, pmb))
+
+	mustSave(t, PhaseKey, &PhaseNode{Phase: Contribute}, balances)
+	require.NoError(t, updateDKGMinersList(balances, dmn))
+
+	var contribute = func(id string, dkg bls.DKGI) error {
+		_, err := msc.contributeMpk(&transaction.Transaction{ClientID: id},
+			mpkOf(id, dkg).Encode(), gn, balances)
+		return err
+	}
+
+	err := contribute(joined.id, bls.MakeDKG(2, 3, joined.id))
+	require.EqualError(t, err, "contribute_mpk_failed: resharing: "+
+		"miner not part of previous magic block")
+
+	err = contribute(old[0].id, bls.MakeDKG(2, 3, old[0].id))
+	require.EqualError(t, err, "contribute_mpk_failed: resharing: "+
+		"master public key doesn't commit to the previous key share")
+
+	for _, c := range old {
+		var dkg = bls.MakeReshareDKG(2, 3, c.id, dkgs[c.id].Si)
+		require.NoError(t, validateReshareMPK(dmn, mpkOf(c.id, dkg), balances))
+	}
+
+	t.Run("not enough previous miners", func(t *testing.T) {
+		var dmn = NewDKGMinerNodes()
+		dmn.SimpleNodes[old[0].id] = &SimpleNode{ID: old[0].id}
+		dmn.SimpleNodes[joined.id] = &SimpleNode{ID: joined.id}
+		dmn.K = 2
+		dmn.setReshare(pmb)
+		assert.False(t, dmn.Reshare)
+		assert.Equal(t, 2, dmn.minDealers())
+		assert.True(t, dmn.isDealer(joined.id, pmb))
+	})
+}
@@ -30,6 +30,7 @@ const (
 	BlockGenerationRetryWaitTime // todo from chain
 	BlockProposalMaxWaitTime
 	BlockProposalWaitMode
+	BlockProposalPipelining
 	BlockConsensusThresholdByCount
 	BlockConsensusThresholdByStake
 	BlockShardingMinActiveSharders
@@ -161,6 +162,7 @@ func initGlobalSettingNames() {
 	GlobalSettingName[BlockGenerationRetryWaitTime] = "server_chain.block.generation.retry_wait_time"
 	GlobalSettingName[BlockProposalMaxWaitTime] = "server_chain.block.proposal.max_wait_time"
 	GlobalSettingName[BlockProposalWaitMode] = "server_chain.block.proposal.wait_mode"
+	GlobalSettingName[BlockProposalPipelining] = "server_chain.block.proposal.pipelining"
 	GlobalSettingName[BlockConsensusThresholdByCount] = "server_chain.block.consensus.threshold_by_count"
 	GlobalSettingName[BlockConsensusThresholdByStake] = "server_chain.block.consensus.threshold_by_stake"
 	GlobalSettingName[BlockShardingMinActiveSharders] = "server_chain.block.sharding.min_active_sharders"
@@ -284,6 +286,7 @@ func initGlobalSettings() {
 		GlobalSettingName[BlockGenerationRetryWaitTime]:      {smartcontract.Int, false},
 		GlobalSettingName[BlockProposalMaxWaitTime]:          {smartcontract.Duration, true},
 		GlobalSettingName[BlockProposalWaitMode]:             {smartcontract.String, true},
+		GlobalSettingName[BlockProposalPipelining]:           {smartcontract.Boolean, true},
 		GlobalSettingName[BlockConsensusThresholdByCount]:    {smartcontract.Int, true},
 		GlobalSettingName[BlockConsensusThresholdByStake]:    {smartcontract.Int, true},
 		GlobalSettingName[BlockShardingMinActiveSharders]:    {smartcontract.Int, true},
@@ -0,0 +1,283 @@
+package minersc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	"0chain.net/chaincore/block"
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+//msgp:ignore SignedHeader EquivocationEvidence
+
+// equivocation evidence types
+const (
+	// EquivocationBlock is two different blocks generated by the miner
+	// in the same round
+	EquivocationBlock = "block"
+	// EquivocationTicket is two verification tickets of the miner for
+	// different blocks of the same generator and round
+	EquivocationTicket = "ticket"
+)
+
+// SignedHeader is a block header with a signature of the block hash, the
+// signature of the generator for a proposed block, or the signature of a
+// verifier for a verification ticket.
+type SignedHeader struct {
+	block.Header
+	Signature string `json:"signature"`
+}
+
+// EquivocationEvidence is a proof of a miner signed conflicting blocks or
+// verification tickets in a round.
+type EquivocationEvidence struct {
+	Type    string       `json:"type"`
+	MinerID string       `json:"miner_id"`
+	First   SignedHeader `json:"first"`
+	Second  SignedHeader `json:"second"`
+}
+
+func (ee *EquivocationEvidence) Encode() []byte {
+	var b, err = json.Marshal(ee)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (ee *EquivocationEvidence) Decode(p []byte) error {
+	return json.Unmarshal(p, ee)
+}
+
+func (ee *EquivocationEvidence) validate(mb *block.MagicBlock,
+	scheme encryption.SignatureScheme) error {
+
+	switch ee.Type {
+	case EquivocationBlock:
+		if ee.First.MinerID != ee.MinerID || ee.Second.MinerID != ee.MinerID {
+			return errors.New("the blocks are not generated by the miner")
+		}
+	case EquivocationTicket:
+		// a verifier may sign blocks of different generators of a round
+		if ee.First.MinerID != ee.Second.MinerID {
+			return errors.New("the blocks are generated by different miners")
+		}
+	default:
+		return fmt.Errorf("unknown evidence type %q", ee.Type)
+	}
+
+	if ee.First.Round != ee.Second.Round {
+		return errors.New("the blocks are of different rounds")
+	}
+	// a miner restarting a round with a new seed, or on a new previous
+	// block, generates or verifies blocks again legitimately
+	if ee.First.RoundRandomSeed != ee.Second.RoundRandomSeed {
+		return errors.New("the blocks are of different round random seeds")
+	}
+	if ee.First.PrevHash != ee.Second.PrevHash {
+		return errors.New("the blocks are of different previous blocks")
+	}
+
+	var first, second = ee.First.ComputeHash(), ee.Second.ComputeHash()
+	if first == second {
+		return errors.New("the blocks are the same")
+	}
+
+	var mn = mb.Miners.GetNode(ee.MinerID)
+	if mn == nil {
+		return errors.New("the miner is not in the magic block")
+	}
+
+	if err := scheme.SetPublicKey(mn.PublicKey); err != nil {
+		return fmt.Errorf("invalid public key of the miner: %v", err)
+	}
+	for _, sh := range []struct {
+		hash, sig string
+	}{
+		{first, ee.First.Signature},
+		{second, ee.Second.Signature},
+	} {
+		if ok, err := scheme.Verify(sh.sig, sh.hash); err != nil || !ok {
+			return fmt.Errorf("invalid signature of block %s", sh.hash)
+		}
+	}
+
+	return nil
+}
+
+// equivocation is a punished equivocation; a miner is punished once
+// for a round
+type equivocation struct {
+	MinerID    string        `json:"miner_id"`
+	Round      int64         `json:"round"`
+	Type       string        `json:"type"`
+	FirstHash  string        `json:"first_hash"`
+	SecondHash string        `json:"second_hash"`
+	ReporterID string        `json:"reporter_id"`
+	Slashed    currency.Coin `json:"slashed"`
+}
+
+func equivocationKey(minerID string, round int64) datastore.Key {
+	return ADDRESS + encryption.Hash("equivocation:"+minerID+":"+
+		strconv.FormatInt(round, 10))
+}
+
+// slash moves given ratio of the stake of every delegate pool of the miner
+// out of the stake pool
+func (mn *MinerNode) slash(ratio float64, balances cstate.StateContextI) (
+	slashed currency.Coin, err error) {
+
+	if ratio <= 0 {
+		return 0, nil
+	}
+
+	var penalty = stakepool.NewStakePoolReward(mn.ID, spenum.Miner,
+		spenum.EquivocationSlashPenalty)
+	for id, dp := range mn.Pools {
+		dpSlash, err := currency.MultFloat64(dp.Balance, ratio)
+		if err != nil {
+			return 0, err
+		}
+		if dpSlash == 0 {
+			continue
+		}
+		if dp.Balance, err = currency.MinusCoin(dp.Balance, dpSlash); err != nil {
+			return 0, err
+		}
+		if dp.Status == spenum.Active {
+			if mn.TotalStaked, err = currency.MinusCoin(mn.TotalStaked, dpSlash); err != nil {
+				mn.TotalStaked = 0
+			}
+		}
+		if slashed, err = currency.AddCoin(slashed, dpSlash); err != nil {
+			return 0, err
+		}
+		penalty.DelegatePenalties[id] = dpSlash
+	}
+
+	if err = penalty.Emit(event.TagStakePoolReward, balances); err != nil {
+		return 0, err
+	}
+	return
+}
+
+// reportEquivocation accepts an evidence of a miner signed conflicting blocks
+// or verification tickets in a round. The miner stake pool is slashed, part
+// of the slashed tokens goes to the reporter, the rest is burned. The miner
+// is jailed and excluded from the next view change.
+func (msc *MinerSmartContract) reportEquivocation(t *transaction.Transaction,
+	inputData []byte, gn *GlobalNode, balances cstate.StateContextI) (
+	resp string, err error) {
+
+	var ev EquivocationEvidence
+	if err = ev.Decode(inputData); err != nil {
+		return "", common.NewErrorf("equivocation_failed",
+			"decoding request: %v", err)
+	}
+
+	var mb = balances.GetChainCurrentMagicBlock()
+	if mb == nil {
+		return "", common.NewError("equivocation_failed",
+			"can't get current magic block")
+	}
+	if err = ev.validate(mb, balances.GetSignatureScheme()); err != nil {
+		return "", common.NewErrorf("equivocation_failed",
+			"invalid evidence: %v", err)
+	}
+
+	var (
+		key = equivocationKey(ev.MinerID, ev.First.Round)
+		eq  = new(equivocation)
+	)
+	switch err = balances.GetTrieNode(key, eq); err {
+	case nil:
+		return "", common.NewErrorf("equivocation_failed",
+			"the miner is already punished for round %d", ev.First.Round)
+	case util.ErrValueNotPresent:
+	default:
+		return "", common.NewError("equivocation_failed", err.Error())
+	}
+
+	mn, err := getMinerNode(ev.MinerID, balances)
+	if err != nil {
+		return "", common.NewErrorf("equivocation_failed",
+			"can't get the miner %s: %v", ev.MinerID, err)
+	}
+
+	slashed, err := mn.slash(gn.EquivocationSlashRatio, balances)
+	if err != nil {
+		return "", common.NewErrorf("equivocation_failed",
+			"slashing the miner: %v", err)
+	}
+
+	reward, err := currency.MultFloat64(slashed, gn.EquivocationReporterRatio)
+	if err != nil {
+		return "", common.NewError("equivocation_failed", err.Error())
+	}
+	burn, err := currency.MinusCoin(slashed, reward)
+	if err != nil {
+		return "", common.NewError("equivocation_failed", err.Error())
+	}
+	if reward > 0 {
+		if err = balances.AddTransfer(state.NewTransfer(ADDRESS, t.ClientID, reward)); err != nil {
+			return "", common.NewErrorf("equivocation_failed",
+				"paying the reporter: %v", err)
+		}
+	}
+	if burn > 0 {
+		if err = balances.AddTransfer(state.NewTransfer(ADDRESS, gn.BurnAddress, burn)); err != nil {
+			return "", common.NewErrorf("equivocation_failed",
+				"burning slashed tokens: %v", err)
+		}
+	}
+
+	if err = jail(mn, balances.GetBlock().Round, balances); err != nil {
+		return "", common.NewErrorf("equivocation_failed",
+			"jailing the miner: %v", err)
+	}
+
+	eq = &equivocation{
+		MinerID:    ev.MinerID,
+		Round:      ev.First.Round,
+		Type:       ev.Type,
+		FirstHash:  ev.First.ComputeHash(),
+		SecondHash: ev.Second.ComputeHash(),
+		ReporterID: t.ClientID,
+		Slashed:    slashed,
+	}
+	if _, err = balances.InsertTrieNode(key, eq); err != nil {
+		return "", common.NewErrorf("equivocation_failed",
+			"saving equivocation: %v", err)
+	}
+
+	balances.EmitEvent(event.TypeStats, event.TagAddEquivocation, mn.ID, event.Equivocation{
+		MinerID:    eq.MinerID,
+		Round:      eq.Round,
+		Type:       eq.Type,
+		FirstHash:  eq.FirstHash,
+		SecondHash: eq.SecondHash,
+		ReporterID: eq.ReporterID,
+		Slashed:    eq.Slashed,
+	})
+
+	b, err := json.Marshal(eq)
+	if err != nil {
+		return "", common.NewError("equivocation_failed", err.Error())
+	}
+	return string(b), nil
+}
@@ -0,0 +1,117 @@
+package minersc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *equivocation) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 7
+	// string "MinerID"
+	o = append(o, 0x87, 0xa7, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.MinerID)
+	// string "Round"
+	o = append(o, 0xa5, 0x52, 0x6f, 0x75, 0x6e, 0x64)
+	o = msgp.AppendInt64(o, z.Round)
+	// string "Type"
+	o = append(o, 0xa4, 0x54, 0x79, 0x70, 0x65)
+	o = msgp.AppendString(o, z.Type)
+	// string "FirstHash"
+	o = append(o, 0xa9, 0x46, 0x69, 0x72, 0x73, 0x74, 0x48, 0x61, 0x73, 0x68)
+	o = msgp.AppendString(o, z.FirstHash)
+	// string "SecondHash"
+	o = append(o, 0xaa, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x48, 0x61, 0x73, 0x68)
+	o = msgp.AppendString(o, z.SecondHash)
+	// string "ReporterID"
+	o = append(o, 0xaa, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ReporterID)
+	// string "Slashed"
+	o = append(o, 0xa7, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64)
+	o, err = z.Slashed.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Slashed")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *equivocation) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "MinerID":
+			z.MinerID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MinerID")
+				return
+			}
+		case "Round":
+			z.Round, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Round")
+				return
+			}
+		case "Type":
+			z.Type, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Type")
+				return
+			}
+		case "FirstHash":
+			z.FirstHash, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "FirstHash")
+				return
+			}
+		case "SecondHash":
+			z.SecondHash, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SecondHash")
+				return
+			}
+		case "ReporterID":
+			z.ReporterID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReporterID")
+				return
+			}
+		case "Slashed":
+			bts, err = z.Slashed.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Slashed")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *equivocation) Msgsize() (s int) {
+	s = 1 + 8 + msgp.StringPrefixSize + len(z.MinerID) + 6 + msgp.Int64Size + 5 + msgp.StringPrefixSize + len(z.Type) + 10 + msgp.StringPrefixSize + len(z.FirstHash) + 11 + msgp.StringPrefixSize + len(z.SecondHash) + 11 + msgp.StringPrefixSize + len(z.ReporterID) + 8 + z.Slashed.Msgsize()
+	return
+}
@@ -0,0 +1,206 @@
+package minersc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/node"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+func newTestMagicBlock(t *testing.T, miners ...*Client) *block.MagicBlock {
+	var mb = block.NewMagicBlock()
+	mb.Miners = node.NewPool(node.NodeTypeMiner)
+	for _, mn := range miners {
+		var n = node.Provider()
+		require.NoError(t, n.SetID(mn.id))
+		n.PublicKey = mn.pk
+		n.Type = node.NodeTypeMiner
+		n.SetSignatureSchemeType(encryption.SignatureSchemeBls0chain)
+		mb.Miners.AddNode(n)
+	}
+	return mb
+}
+
+func (c *Client) signHeader(t *testing.T, h block.Header) SignedHeader {
+	sig, err := c.scheme.Sign(h.ComputeHash())
+	require.NoError(t, err)
+	return SignedHeader{Header: h, Signature: sig}
+}
+
+func TestMinerSmartContract_reportEquivocation(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		msc      = newTestMinerSC()
+		gn       = setConfig(t, balances)
+		miner    = newClient(0, balances)
+		other    = newClient(0, balances)
+		reporter = newClient(0, balances)
+	)
+	gn.EquivocationSlashRatio = 0.1
+	gn.EquivocationReporterRatio = 0.5
+	gn.BurnAddress = "burn"
+
+	balances.magicBlock = newTestMagicBlock(t, miner, other)
+	balances.block = &block.Block{}
+	balances.block.Round = 10
+	balances.balances[ADDRESS] = 100e10
+	balances.txn = newTransaction(reporter.id, ADDRESS, 0, 10)
+
+	var mn = NewMinerNode()
+	mn.ID, mn.PublicKey = miner.id, miner.pk
+	mn.TotalStaked = 80e10
+	mn.Pools["active"] = &stakepool.DelegatePool{Balance: 80e10, Status: spenum.Active}
+	mn.Pools["pending"] = &stakepool.DelegatePool{Balance: 20e10, Status: spenum.Pending}
+	require.NoError(t, mn.save(balances))
+
+	// the miner is in the DKG of the next view change
+	mustSave(t, PhaseKey, &PhaseNode{Phase: Contribute}, balances)
+	var dkg = NewDKGMinerNodes()
+	dkg.SimpleNodes[miner.id] = mn.SimpleNode
+	dkg.SimpleNodes[other.id] = &SimpleNode{ID: other.id}
+	require.NoError(t, updateDKGMinersList(balances, dkg))
+	var mpks = block.NewMpks()
+	mpks.Mpks[miner.id] = &block.MPK{ID: miner.id}
+	require.NoError(t, updateMinersMPKs(balances, mpks))
+
+	var (
+		first  = block.Header{MinerID: miner.id, Round: 5, RoundRandomSeed: 42, PrevHash: "p", MerkleRoot: "a"}
+		second = block.Header{MinerID: miner.id, Round: 5, RoundRandomSeed: 42, PrevHash: "p", MerkleRoot: "b"}
+		ev     = EquivocationEvidence{
+			Type:    EquivocationBlock,
+			MinerID: miner.id,
+			First:   miner.signHeader(t, first),
+			Second:  miner.signHeader(t, second),
+		}
+		report = func(ev EquivocationEvidence) (string, error) {
+			return msc.reportEquivocation(balances.txn, ev.Encode(), gn, balances)
+		}
+	)
+
+	for _, tt := range []struct {
+		name   string
+		modify func(ev *EquivocationEvidence)
+		err    string
+	}{
+		{"unknown type", func(ev *EquivocationEvidence) { ev.Type = "x" },
+			`unknown evidence type "x"`},
+		{"other generator", func(ev *EquivocationEvidence) { ev.MinerID = other.id },
+			"the blocks are not generated by the miner"},
+		{"different rounds", func(ev *EquivocationEvidence) { ev.Second.Round = 6 },
+			"the blocks are of different rounds"},
+		{"different seeds", func(ev *EquivocationEvidence) {
+			ev.Second = miner.signHeader(t, block.Header{MinerID: miner.id,
+				Round: 5, RoundRandomSeed: 43, PrevHash: "p", MerkleRoot: "b"})
+		}, "the blocks are of different round random seeds"},
+		{"different previous blocks", func(ev *EquivocationEvidence) {
+			ev.Second = miner.signHeader(t, block.Header{MinerID: miner.id,
+				Round: 5, RoundRandomSeed: 42, PrevHash: "q", MerkleRoot: "b"})
+		}, "the blocks are of different previous blocks"},
+		{"same blocks", func(ev *EquivocationEvidence) { ev.Second = ev.First },
+			"the blocks are the same"},
+		{"invalid signature", func(ev *EquivocationEvidence) {
+			ev.Second.Signature = ev.First.Signature
+		}, "invalid signature of block " + second.ComputeHash()},
+		{"not in magic block", func(ev *EquivocationEvidence) {
+			ev.MinerID, ev.First.MinerID, ev.Second.MinerID = reporter.id, reporter.id, reporter.id
+		}, "the miner is not in the magic block"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var bad = ev
+			tt.modify(&bad)
+			_, err := report(bad)
+			require.EqualError(t, err, "equivocation_failed: invalid evidence: "+tt.err)
+		})
+	}
+
+	resp, err := report(ev)
+	require.NoError(t, err)
+	var eq equivocation
+	require.NoError(t, json.Unmarshal([]byte(resp), &eq))
+	assert.Equal(t, currency.Coin(10e10), eq.Slashed)
+	assert.Equal(t, reporter.id, eq.ReporterID)
+
+	assert.Equal(t, currency.Coin(5e10), balances.balances[reporter.id])
+	assert.Equal(t, currency.Coin(5e10), balances.balances["burn"])
+	assert.Equal(t, currency.Coin(90e10), balances.balances[ADDRESS])
+
+	got, err := getMinerNode(miner.id, balances)
+	require.NoError(t, err)
+	assert.True(t, got.Jailed)
+	assert.EqualValues(t, 10, got.JailedRound)
+	assert.Equal(t, currency.Coin(72e10), got.Pools["active"].Balance)
+	assert.Equal(t, currency.Coin(18e10), got.Pools["pending"].Balance)
+	assert.Equal(t, currency.Coin(72e10), got.TotalStaked)
+
+	dkg, err = getDKGMinersList(balances)
+	require.NoError(t, err)
+	assert.NotContains(t, dkg.SimpleNodes, miner.id)
+	assert.Contains(t, dkg.SimpleNodes, other.id)
+	mpks, err = getMinersMPKs(balances)
+	require.NoError(t, err)
+	assert.NotContains(t, mpks.Mpks, miner.id)
+
+	_, err = report(ev)
+	require.EqualError(t, err, "equivocation_failed: the miner is already punished for round 5")
+
+	// the miner verified two blocks of other generator in a round
+	first = block.Header{MinerID: other.id, Round: 7, PrevHash: "p", MerkleRoot: "a"}
+	second = block.Header{MinerID: other.id, Round: 7, PrevHash: "p", MerkleRoot: "b"}
+	var tickets = EquivocationEvidence{
+		Type:    EquivocationTicket,
+		MinerID: miner.id,
+		First:   miner.signHeader(t, first),
+		Second:  miner.signHeader(t, second),
+	}
+	var otherRound = tickets
+	otherRound.Second = miner.signHeader(t, block.Header{MinerID: miner.id, Round: 7})
+	_, err = report(otherRound)
+	require.EqualError(t, err, "equivocation_failed: invalid evidence: "+
+		"the blocks are generated by different miners")
+
+	_, err = report(tickets)
+	require.NoError(t, err)
+	got, err = getMinerNode(miner.id, balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(648e9), got.Pools["active"].Balance)
+}
+
+func TestMinerSmartContract_createDKGMinersForContribute_jailed(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		msc      = newTestMinerSC()
+		gn       = setConfig(t, balances)
+		all      = new(MinerNodes)
+	)
+	balances.block = &block.Block{}
+
+	for i := 0; i < 4; i++ {
+		var mn = NewMinerNode()
+		mn.ID = newClient(0, balances).id
+		mn.Jailed = i == 0
+		require.NoError(t, mn.save(balances))
+		all.Nodes = append(all.Nodes, mn)
+	}
+	require.NoError(t, updateMinersList(balances, all))
+
+	require.NoError(t, msc.createDKGMinersForContribute(balances, gn))
+	dkg, err := getDKGMinersList(balances)
+	require.NoError(t, err)
+	assert.Len(t, dkg.SimpleNodes, 3)
+	assert.NotContains(t, dkg.SimpleNodes, all.Nodes[0].ID)
+
+	// too few miners not jailed, the jailed node is a copy of the listed one
+	mn, err := getMinerNode(all.Nodes[1].ID, balances)
+	require.NoError(t, err)
+	require.NoError(t, jail(mn, 1, balances))
+	require.EqualError(t, msc.createDKGMinersForContribute(balances, gn),
+		"failed to create dkg miners: too few miners for dkg, l_all_miners: 2, N: 3")
+}
@@ -0,0 +1,119 @@
+package minersc
+
+import (
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	"0chain.net/chaincore/block"
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/config"
+	"0chain.net/chaincore/state"
+)
+
+//go:generate msgp -io=false -tests=false -v
+
+var FeeMarketKey = globalKeyHash("fee_market")
+
+// FeeMarket keeps the base fee transactions of the next block pay. The base
+// fee is burned, only the tips of the transactions are paid to the block
+// generator and sharders. The base fee is adjusted in the end of every block
+// by the block fullness.
+type FeeMarket struct {
+	BaseFee currency.Coin `json:"base_fee"`
+}
+
+func getFeeMarket(balances cstate.CommonStateContextI) (*FeeMarket, error) {
+	fm := &FeeMarket{}
+	err := balances.GetTrieNode(FeeMarketKey, fm)
+	switch err {
+	case nil, util.ErrValueNotPresent:
+		return fm, nil
+	default:
+		return nil, err
+	}
+}
+
+// GetBaseFee returns the base fee transactions of the next block pay, it's
+// zero until the fee market is enabled
+func GetBaseFee(balances cstate.CommonStateContextI) (currency.Coin, error) {
+	fm, err := getFeeMarket(balances)
+	if err != nil {
+		return 0, err
+	}
+	return fm.BaseFee, nil
+}
+
+// update adjusts the base fee by the fullness of the block, the block is
+// full if it has the max block size of transactions paying a fee
+func (fm *FeeMarket) update(b *block.Block, conf config.ChainConfig,
+	balances cstate.StateContextI) error {
+
+	denominator := conf.BaseFeeChangeDenominator()
+	if denominator <= 0 {
+		return nil // fee market is disabled
+	}
+
+	var used int
+	for _, txn := range b.Txns {
+		if txn.Fee > 0 || txn.IsDynamicFee() {
+			used++
+		}
+	}
+
+	target := float64(conf.BlockSize()) * conf.BaseFeeTargetFullness()
+	baseFee := nextBaseFee(fm.BaseFee, used, target, denominator)
+	if baseFee == fm.BaseFee {
+		return nil
+	}
+	fm.BaseFee = baseFee
+	_, err := balances.InsertTrieNode(FeeMarketKey, fm)
+	return err
+}
+
+// nextBaseFee moves the base fee towards the target number of transactions
+// in a block, by 1/denominator of it at most. The base fee has no floor: the
+// min fee is checked when a transaction is accepted, and the part of it above
+// the base fee is the tip of the miners.
+func nextBaseFee(baseFee currency.Coin, used int, target float64,
+	denominator int) currency.Coin {
+
+	if target <= 0 {
+		return baseFee
+	}
+
+	change := float64(baseFee) * (float64(used) - target) / target /
+		float64(denominator)
+	switch {
+	case float64(used) > target:
+		if change < 1 {
+			change = 1
+		}
+		if fee, err := currency.AddCoin(baseFee, currency.Coin(change)); err == nil {
+			baseFee = fee
+		}
+	case float64(used) < target:
+		if dec := currency.Coin(-change); dec < baseFee {
+			baseFee -= dec
+		} else {
+			baseFee = 0
+		}
+	}
+	return baseFee
+}
+
+// burnBaseFee burns the base fee part of the fees the transactions of the
+// block paid to the miner SC
+func burnBaseFee(b *block.Block, baseFee currency.Coin, burnAddress string,
+	balances cstate.StateContextI) (err error) {
+
+	var burn currency.Coin
+	for _, txn := range b.Txns {
+		if burn, err = currency.AddCoin(burn, txn.BurnedFee(baseFee)); err != nil {
+			return err
+		}
+	}
+	if burn == 0 {
+		return nil
+	}
+	return balances.AddTransfer(state.NewTransfer(ADDRESS, burnAddress, burn))
+}
@@ -0,0 +1,63 @@
+package minersc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *FeeMarket) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "BaseFee"
+	o = append(o, 0x81, 0xa7, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65)
+	o, err = z.BaseFee.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "BaseFee")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *FeeMarket) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "BaseFee":
+			bts, err = z.BaseFee.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BaseFee")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *FeeMarket) Msgsize() (s int) {
+	s = 1 + 8 + z.BaseFee.Msgsize()
+	return
+}
@@ -0,0 +1,116 @@
+package minersc
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/chaincore/config/mocks"
+	"0chain.net/chaincore/transaction"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		baseFee currency.Coin
+		used    int
+		want    currency.Coin
+	}{
+		{"on target", 800, 5, 800},
+		{"full block", 800, 10, 900},
+		{"empty block", 800, 0, 700},
+		{"above target", 800, 6, 820},
+		{"at least one", 0, 6, 1},
+		{"no floor", 16, 0, 14},
+		{"empty at zero", 0, 0, 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, nextBaseFee(tt.baseFee, tt.used, 5, 8))
+		})
+	}
+	assert.EqualValues(t, 100, nextBaseFee(100, 10, 0, 8), "no target")
+}
+
+func newFeeTxn(fee, maxFee, priorityFee currency.Coin) *transaction.Transaction {
+	return &transaction.Transaction{
+		Fee:         fee,
+		MaxFee:      maxFee,
+		PriorityFee: priorityFee,
+	}
+}
+
+func TestFeeMarketUpdate(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		conf     = mocks.NewChainConfig(t)
+		b        = &block.Block{}
+	)
+	conf.On("BaseFeeChangeDenominator").Return(8)
+	conf.On("BaseFeeTargetFullness").Return(0.5)
+	conf.On("BlockSize").Return(int32(4))
+
+	// the built-in transactions paying no fee don't fill the block
+	b.Txns = []*transaction.Transaction{
+		newFeeTxn(100, 0, 0), newFeeTxn(0, 200, 10),
+		newFeeTxn(100, 0, 0), newFeeTxn(0, 0, 0),
+	}
+
+	fm, err := getFeeMarket(balances)
+	require.NoError(t, err)
+	require.NoError(t, fm.update(b, conf, balances))
+
+	baseFee, err := GetBaseFee(balances)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, baseFee, "at least one")
+
+	fm.BaseFee = 800
+	require.NoError(t, fm.update(b, conf, balances))
+	baseFee, err = GetBaseFee(balances)
+	require.NoError(t, err)
+	assert.EqualValues(t, 850, baseFee)
+}
+
+func TestSumFee(t *testing.T) {
+	var (
+		msc = newTestMinerSC()
+		b   = &block.Block{}
+	)
+	b.Txns = []*transaction.Transaction{
+		newFeeTxn(150, 0, 0),   // tip 50
+		newFeeTxn(50, 0, 0),    // below the base fee, no tip
+		newFeeTxn(0, 200, 30),  // tip 30
+		newFeeTxn(0, 120, 30),  // capped, tip 20
+		newFeeTxn(100, 0, 0),   // no tip
+		newFeeTxn(0, 0, 1000),  // no max fee, no fee
+		newFeeTxn(0, 1000, 0),  // no priority fee
+		newFeeTxn(0, 1000, 10), // tip 10
+	}
+	fees, err := msc.sumFee(b, 100, false)
+	require.NoError(t, err)
+	assert.EqualValues(t, 110, fees)
+}
+
+func TestBurnBaseFee(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		b        = &block.Block{}
+	)
+	balances.txn = newTransaction("generator", ADDRESS, 0, 1)
+	balances.balances[ADDRESS] = 1000
+	b.Txns = []*transaction.Transaction{
+		newFeeTxn(150, 0, 0),  // burns 100
+		newFeeTxn(50, 0, 0),   // below the base fee, burns 50
+		newFeeTxn(0, 200, 30), // burns 100
+		newFeeTxn(0, 0, 0),    // pays nothing
+	}
+	require.NoError(t, burnBaseFee(b, 100, "burn", balances))
+	assert.EqualValues(t, 250, balances.balances["burn"])
+	assert.EqualValues(t, 750, balances.balances[ADDRESS])
+
+	require.NoError(t, burnBaseFee(b, 0, "zero", balances))
+	_, ok := balances.balances["zero"]
+	assert.False(t, ok, "nothing to burn")
+}
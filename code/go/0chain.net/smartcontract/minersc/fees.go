@@ -7,6 +7,7 @@ import (
 	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/logging"
 
+	"0chain.net/smartcontract/stakepool"
 	"0chain.net/smartcontract/stakepool/spenum"
 
 	"0chain.net/chaincore/block"
@@ -41,9 +42,23 @@ func (msc *MinerSmartContract) activatePending(mn *MinerNode) error {
 // unlock all delegate pools of offline node
 func (msc *MinerSmartContract) unlockOffline(
 	mn *MinerNode,
+	providerType spenum.Provider,
 	balances cstate.StateContextI,
 ) error {
-	for _, pool := range mn.Pools {
+	for delegateID, pool := range mn.Pools {
+		if pool.VestingPoolID != "" {
+			// staked from a vesting pool, the vesting SC decides where the
+			// tokens go
+			receiver, err := stakepool.UnstakeVesting(pool.DelegateID, pool,
+				providerType, mn.ID, balances)
+			if err != nil {
+				return fmt.Errorf("pay_fees/unlock_offline: unstaking vesting pool: %v", err)
+			}
+			if err := mn.EmptyVestingPool(ADDRESS, delegateID, receiver, balances); err != nil {
+				return fmt.Errorf("pay_fees/unlock_offline: adding transfer: %v", err)
+			}
+			continue
+		}
 		transfer := state.NewTransfer(ADDRESS, pool.DelegateID, pool.Balance)
 		if err := balances.AddTransfer(transfer); err != nil {
 			return fmt.Errorf("pay_fees/unlock_offline: adding transfer: %v", err)
@@ -140,13 +155,13 @@ func (msc *MinerSmartContract) viewChangePoolsWork(
 
 	// unlockOffline
 	for _, mn := range minersOffline {
-		if err = msc.unlockOffline(mn, balances); err != nil {
+		if err = msc.unlockOffline(mn, spenum.Miner, balances); err != nil {
 			return err
 		}
 	}
 
 	for _, mn := range shardersOffline {
-		if err = msc.unlockOffline(mn, balances); err != nil {
+		if err = msc.unlockOffline(mn, spenum.Sharder, balances); err != nil {
 			return err
 		}
 	}
@@ -275,102 +290,144 @@ func (msc *MinerSmartContract) payFees(t *transaction.Transaction,
 		zap.Int64("round", b.Round),
 		zap.String("block", b.Hash))
 
-	fees, err := msc.sumFee(b, true)
+	fm, err := getFeeMarket(balances)
+	if err != nil {
+		return "", common.NewErrorf("pay_fees",
+			"can't get fee market: %v", err)
+	}
+	// the base fee of the transactions is burned, the tips are paid
+	fees, err := msc.sumFee(b, fm.BaseFee, true)
 	if err != nil {
 		return "", err
 	}
-	blockReward, err := currency.MultFloat64(gn.BlockReward, gn.RewardRate)
+	if err = burnBaseFee(b, fm.BaseFee, gn.BurnAddress, balances); err != nil {
+		return "", common.NewErrorf("pay_fees",
+			"burning base fee: %v", err)
+	}
+	sharders, err := payBlockRewards(b, mn, fees, gn, balances)
 	if err != nil {
 		return "", err
 	}
 
+	// save node first, for the VC pools work
+	if err = mn.save(balances); err != nil {
+		return "", common.NewErrorf("pay_fees",
+			"saving generator node: %v", err)
+	}
+
+	if err = updateLiveness(b, gn, balances); err != nil {
+		return "", common.NewErrorf("pay_fees",
+			"updating miners liveness: %v", err)
+	}
+
+	if gn.RewardRoundFrequency != 0 && b.Round%gn.RewardRoundFrequency == 0 {
+		var lfmb = balances.GetLastestFinalizedMagicBlock().MagicBlock
+		if lfmb != nil {
+			err = msc.viewChangePoolsWork(lfmb, b.Round, sharders, balances)
+			if err != nil {
+				return "", err
+			}
+		} else {
+			return "", common.NewError("pay fees", "cannot find latest magic bock")
+		}
+	}
+
+	if err = fm.update(b, configuration.ChainConfig, balances); err != nil {
+		return "", common.NewErrorf("pay_fees",
+			"updating base fee: %v", err)
+	}
+
+	gn.setLastRound(b.Round)
+	if err = gn.save(balances); err != nil {
+		return "", common.NewErrorf("pay_fees",
+			"saving global node: %v", err)
+	}
+
+	return resp, nil
+}
+
+// payBlockRewards pays the block reward and the fees of the block to the
+// generator and to random sharders of the magic block with their delegates
+func payBlockRewards(b *block.Block, mn *MinerNode, fees currency.Coin,
+	gn *GlobalNode, balances cstate.StateContextI) (sharders *MinerNodes, err error) {
+
+	blockReward, err := currency.MultFloat64(gn.BlockReward, gn.RewardRate)
+	if err != nil {
+		return nil, err
+	}
+
 	minerRewards, sharderRewards, err := gn.splitByShareRatio(blockReward)
 	if err != nil {
-		return "", fmt.Errorf("error splitting rewards by ratio: %v", err)
+		return nil, fmt.Errorf("error splitting rewards by ratio: %v", err)
 	}
 	minerFees, sharderFees, err := gn.splitByShareRatio(fees)
 	if err != nil {
-		return "", fmt.Errorf("error splitting fees by ratio: %v", err)
-	}
-
-	// pay random N miners
-	if err := mn.StakePool.DistributeRewardsRandN(
-		minerRewards,
-		mn.ID,
-		spenum.Miner,
-		b.GetRoundRandomSeed(),
-		gn.NumMinerDelegatesRewarded,
-		spenum.BlockRewardMiner,
-		balances,
-	); err != nil {
-		return "", err
-	}
+		return nil, fmt.Errorf("error splitting fees by ratio: %v", err)
+	}
+
+	// pay random N miners, a jailed miner gets no rewards
+	if !mn.Jailed {
+		if err := mn.StakePool.DistributeRewardsRandN(
+			minerRewards,
+			mn.ID,
+			spenum.Miner,
+			b.GetRoundRandomSeed(),
+			gn.NumMinerDelegatesRewarded,
+			spenum.BlockRewardMiner,
+			balances,
+		); err != nil {
+			return nil, err
+		}
 
-	if err := mn.StakePool.DistributeRewardsRandN(
-		minerFees,
-		mn.ID,
-		spenum.Miner,
-		b.GetRoundRandomSeed(),
-		gn.NumMinerDelegatesRewarded,
-		spenum.FeeRewardMiner,
-		balances,
-	); err != nil {
-		return "", err
+		if err := mn.StakePool.DistributeRewardsRandN(
+			minerFees,
+			mn.ID,
+			spenum.Miner,
+			b.GetRoundRandomSeed(),
+			gn.NumMinerDelegatesRewarded,
+			spenum.FeeRewardMiner,
+			balances,
+		); err != nil {
+			return nil, err
+		}
+	} else if minerFees > 0 {
+		// the fees are paid to the miner SC already, so the share of a
+		// jailed miner is burned
+		if err := balances.AddTransfer(
+			state.NewTransfer(ADDRESS, gn.BurnAddress, minerFees)); err != nil {
+			return nil, fmt.Errorf("burning fees of jailed miner: %v", err)
+		}
 	}
 
 	// pay and mint rest for block sharders
-	sharders, err := getAllShardersList(balances)
+	sharders, err = getAllShardersList(balances)
 	if err != nil {
 		if err != util.ErrValueNotPresent {
-			return "", err
+			return nil, err
 		}
 	}
 
 	if len(sharders.Nodes) > 0 {
 		mbSharders := getRegisterShardersInMagicBlock(balances, sharders)
-		if err := msc.payShardersAndDelegates(
+		if err := payShardersAndDelegates(
 			gn, mbSharders, sharderFees,
 			gn.NumShardersRewarded, b.GetRoundRandomSeed(),
 			spenum.FeeRewardSharder,
 			balances,
 		); err != nil {
-			return "", err
+			return nil, err
 		}
-		if err := msc.payShardersAndDelegates(
+		if err := payShardersAndDelegates(
 			gn, mbSharders, sharderRewards,
 			gn.NumShardersRewarded, b.GetRoundRandomSeed(),
 			spenum.BlockRewardSharder,
 			balances,
 		); err != nil {
-			return "", err
-		}
-	}
-
-	// save node first, for the VC pools work
-	if err = mn.save(balances); err != nil {
-		return "", common.NewErrorf("pay_fees",
-			"saving generator node: %v", err)
-	}
-
-	if gn.RewardRoundFrequency != 0 && b.Round%gn.RewardRoundFrequency == 0 {
-		var lfmb = balances.GetLastestFinalizedMagicBlock().MagicBlock
-		if lfmb != nil {
-			err = msc.viewChangePoolsWork(lfmb, b.Round, sharders, balances)
-			if err != nil {
-				return "", err
-			}
-		} else {
-			return "", common.NewError("pay fees", "cannot find latest magic bock")
+			return nil, err
 		}
 	}
 
-	gn.setLastRound(b.Round)
-	if err = gn.save(balances); err != nil {
-		return "", common.NewErrorf("pay_fees",
-			"saving global node: %v", err)
-	}
-
-	return resp, nil
+	return sharders, nil
 }
 
 func getRegisterShardersInMagicBlock(balances cstate.StateContextI, sharders *MinerNodes) []*MinerNode {
@@ -408,7 +465,8 @@ func getMagicBlockSharders(balances cstate.StateContextI) []string {
 	return sharderKeys
 }
 
-func (msc *MinerSmartContract) sumFee(b *block.Block,
+// sumFee sums the tips of the transactions of the block paying the base fee
+func (msc *MinerSmartContract) sumFee(b *block.Block, baseFee currency.Coin,
 	updateStats bool) (currency.Coin, error) {
 
 	var (
@@ -420,7 +478,7 @@ func (msc *MinerSmartContract) sumFee(b *block.Block,
 		feeStats = stat.(metrics.Counter)
 	}
 	for _, txn := range b.Txns {
-		totalMaxFee, err = currency.AddCoin(totalMaxFee, txn.Fee)
+		totalMaxFee, err = currency.AddCoin(totalMaxFee, txn.EffectiveTip(baseFee))
 		if err != nil {
 			return 0, err
 		}
@@ -437,7 +495,7 @@ func (msc *MinerSmartContract) sumFee(b *block.Block,
 }
 
 // pay fees and mint sharders
-func (msc *MinerSmartContract) payShardersAndDelegates(
+func payShardersAndDelegates(
 	gn *GlobalNode,
 	sharders []*MinerNode,
 	reward currency.Coin,
@@ -366,6 +366,7 @@ func testPayFees(t *testing.T, minerStakes []float64, sharderStakes [][]float64,
 
 	mockChainConfig := mocks.NewChainConfig(t)
 	mockChainConfig.On("IsViewChangeEnabled").Return(true)
+	mockChainConfig.On("BaseFeeChangeDenominator").Return(0).Maybe()
 	// Add information only relevant to view change rounds
 	config.Configuration().ChainConfig = mockChainConfig
 
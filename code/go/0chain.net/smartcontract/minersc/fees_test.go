@@ -9,6 +9,8 @@ import (
 	"0chain.net/chaincore/block"
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/chaincore/node"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,7 +135,7 @@ func computeMinerPayments(gn *GlobalNode, msc *MinerSmartContract, b *block.Bloc
 	if err != nil {
 		return 0, err
 	}
-	fees, err := msc.sumFee(b, false)
+	fees, err := msc.sumFee(b, 0, false)
 	if err != nil {
 		return 0, err
 	}
@@ -151,7 +153,7 @@ func computeShardersPayments(gn *GlobalNode, msc *MinerSmartContract, b *block.B
 	if err != nil {
 		return 0, err
 	}
-	fees, err := msc.sumFee(b, false)
+	fees, err := msc.sumFee(b, 0, false)
 	if err != nil {
 		return 0, err
 	}
@@ -571,3 +573,68 @@ func Test_payFees(t *testing.T) {
 	})
 
 }
+
+type testVestingStaker struct {
+	clientID, poolID, delegateID string
+	providerType                 spenum.Provider
+	providerID                   string
+	amount                       currency.Coin
+}
+
+func (tvs *testVestingStaker) Unstake(clientID, poolID, delegateID string,
+	providerType spenum.Provider, providerID string, amount currency.Coin,
+	_ cstate.StateContextI) (string, error) {
+
+	tvs.clientID, tvs.poolID, tvs.delegateID = clientID, poolID, delegateID
+	tvs.providerType, tvs.providerID = providerType, providerID
+	tvs.amount = amount
+	return "vesting_sc", nil
+}
+
+func TestMinerSmartContract_unlockOffline_vesting(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		msc      = newTestMinerSC()
+		mn       = NewMinerNode()
+		staker   = &testVestingStaker{}
+	)
+	stakepool.SetVestingStaker(staker)
+	t.Cleanup(func() { stakepool.SetVestingStaker(nil) })
+
+	mn.ID = newClient(0, balances).id
+	mn.Pools["own"] = &stakepool.DelegatePool{
+		Balance:    10,
+		DelegateID: "own",
+		Status:     spenum.Active,
+	}
+	mn.Pools["vested"] = &stakepool.DelegatePool{
+		Balance:       20,
+		DelegateID:    "vested",
+		Status:        spenum.Active,
+		VestingPoolID: "vp",
+	}
+	balances.txn = newTransaction(mn.ID, ADDRESS, 0, 1)
+	balances.balances[ADDRESS] = 30
+
+	require.NoError(t, msc.unlockOffline(mn, spenum.Miner, balances))
+
+	// the vesting SC gets back the tokens staked from the vesting pool
+	assert.Equal(t, &testVestingStaker{
+		clientID:     "vested",
+		poolID:       "vp",
+		delegateID:   "vested",
+		providerType: spenum.Miner,
+		providerID:   mn.ID,
+		amount:       20,
+	}, staker)
+	assert.Equal(t, currency.Coin(20), balances.balances["vesting_sc"])
+	assert.Zero(t, balances.balances["vested"])
+	assert.Equal(t, currency.Coin(10), balances.balances["own"])
+	assert.Zero(t, balances.balances[ADDRESS])
+
+	got, err := getMinerNode(mn.ID, balances)
+	require.NoError(t, err)
+	for id, dp := range got.Pools {
+		assert.Equal(t, spenum.Deleted, dp.Status, id)
+	}
+}
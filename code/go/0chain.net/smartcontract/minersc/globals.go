@@ -306,7 +306,7 @@ func (msc *MinerSmartContract) updateGlobals(
 	gn *GlobalNode,
 	balances cstate.StateContextI,
 ) (resp string, err error) {
-	if err := smartcontractinterface.AuthorizeWithOwner("update_globals", func() bool {
+	if err := smartcontractinterface.AuthorizeWithGovernance("update_globals", txn.ClientID, func() bool {
 		return gn.OwnerId == txn.ClientID
 	}); err != nil {
 		return "", err
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"0chain.net/chaincore/config"
 	"0chain.net/chaincore/smartcontract"
 	"0chain.net/core/datastore"
 	common2 "0chain.net/smartcontract/common"
@@ -17,6 +18,7 @@ import (
 	"0chain.net/core/common"
 	sc "0chain.net/smartcontract"
 	"0chain.net/smartcontract/dbs/event"
+	"github.com/0chain/common/core/currency"
 	"github.com/0chain/common/core/util"
 	"github.com/guregu/null"
 )
@@ -60,6 +62,8 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		rest.MakeEndpoint(miner+"/get_sharder_geolocations", common.UserRateLimit(mrh.getSharderGeolocations)),
 		rest.MakeEndpoint(miner+"/provider-rewards", common.UserRateLimit(mrh.getProviderRewards)),
 		rest.MakeEndpoint(miner+"/delegate-rewards", common.UserRateLimit(mrh.getDelegateRewards)),
+		rest.MakeEndpoint(miner+"/equivocations", common.UserRateLimit(mrh.getEquivocations)),
+		rest.MakeEndpoint(miner+"/simulate-rewards", common.UserRateLimit(mrh.getSimulateRewards)),
 
 		//test endpoints
 		rest.MakeEndpoint("/test/screst/nodeStat", common.UserRateLimit(mrh.testNodeStat)),
@@ -121,6 +125,157 @@ func (mrh *MinerRestHandler) getDelegateRewards(w http.ResponseWriter, r *http.R
 	common.Respond(w, r, rtv, nil)
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d9/equivocations equivocations
+// Gets list of punished equivocations of miners
+//
+// parameters:
+//
+//	+name: miner_id
+//	 description: miner id, all miners if empty
+//	 in: query
+//	 type: string
+//	+name: offset
+//	 description: offset
+//	 in: query
+//	 type: string
+//	+name: limit
+//	 description: limit
+//	 in: query
+//	 type: string
+//	+name: is_descending
+//	 description: is descending
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: []Equivocation
+//	400:
+//	500:
+func (mrh *MinerRestHandler) getEquivocations(w http.ResponseWriter, r *http.Request) {
+	minerID := r.URL.Query().Get("miner_id")
+	limit, err := common2.GetOffsetLimitOrderParam(r.URL.Query())
+	if err != nil {
+		common.Respond(w, r, nil, err)
+		return
+	}
+
+	edb := mrh.GetQueryStateContext().GetEventDB()
+	if edb == nil {
+		common.Respond(w, r, nil, common.NewErrInternal("no db connection"))
+		return
+	}
+	rtv, err := edb.GetEquivocations(minerID, limit)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal(err.Error()))
+		return
+	}
+	common.Respond(w, r, rtv, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d9/simulate-rewards simulate-rewards
+// Simulates block rewards of a miner or a sharder over the next rounds under the current global settings
+//
+// parameters:
+//
+//	+name: provider_id
+//	 description: miner or sharder id, a hypothetical miner if empty
+//	 in: query
+//	 type: string
+//	+name: provider_type
+//	 description: type of the provider, 1 for miners (default) or 2 for sharders
+//	 in: query
+//	 type: string
+//	+name: stake
+//	 description: stake of the hypothetical miner, in SAS
+//	 in: query
+//	 type: string
+//	+name: service_charge
+//	 description: service charge of the hypothetical miner
+//	 in: query
+//	 type: string
+//	+name: fees
+//	 description: fees of every simulated block, in SAS
+//	 in: query
+//	 type: string
+//	+name: rounds
+//	 description: number of rounds to simulate
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: seed
+//	 description: seed of the random generators of the simulated blocks
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: RewardsSimulation
+//	400:
+func (mrh *MinerRestHandler) getSimulateRewards(w http.ResponseWriter, r *http.Request) {
+	if !config.RewardsSimulation() {
+		common.Respond(w, r, nil, common.NewErrNoResource(
+			"rewards simulation is disabled on the node"))
+		return
+	}
+	var (
+		q   = r.URL.Query()
+		req = &SimulateRewardsRequest{
+			ProviderID:   q.Get("provider_id"),
+			ProviderType: spenum.Miner,
+		}
+		err error
+	)
+	for _, p := range []struct {
+		name  string
+		parse func(s string) error
+	}{
+		{"provider_type", func(s string) error {
+			pt, err := strconv.Atoi(s)
+			req.ProviderType = spenum.Provider(pt)
+			return err
+		}},
+		{"stake", func(s string) error { return parseCoin(s, &req.Stake) }},
+		{"fees", func(s string) error { return parseCoin(s, &req.Fees) }},
+		{"service_charge", func(s string) (err error) {
+			req.ServiceCharge, err = strconv.ParseFloat(s, 64)
+			return
+		}},
+		{"rounds", func(s string) (err error) {
+			req.Rounds, err = strconv.ParseInt(s, 10, 64)
+			return
+		}},
+		{"seed", func(s string) (err error) {
+			req.Seed, err = strconv.ParseInt(s, 10, 64)
+			return
+		}},
+	} {
+		if s := q.Get(p.name); s != "" {
+			if err = p.parse(s); err != nil {
+				common.Respond(w, r, nil, common.NewErrBadRequest(
+					fmt.Sprintf("invalid %s: %v", p.name, err)))
+				return
+			}
+		}
+	}
+
+	rs, err := SimulateRewards(mrh.GetQueryStateContext(), req)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrBadRequest(err.Error()))
+		return
+	}
+	common.Respond(w, r, rs, nil)
+}
+
+func parseCoin(s string, c *currency.Coin) error {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*c = currency.Coin(v)
+	return nil
+}
+
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/provider-rewards provider-rewards
 // Gets list of provider rewards satisfying filter
 //
@@ -26,12 +26,14 @@ func (msc *MinerSmartContract) initSC() {
 	PhaseRounds[Start] = scc.GetInt64(pfx + "start_rounds")
 	PhaseRounds[Contribute] = scc.GetInt64(pfx + "contribute_rounds")
 	PhaseRounds[Share] = scc.GetInt64(pfx + "share_rounds")
+	PhaseRounds[Complain] = scc.GetInt64(pfx + "complain_rounds")
 	PhaseRounds[Publish] = scc.GetInt64(pfx + "publish_rounds")
 	PhaseRounds[Wait] = scc.GetInt64(pfx + "wait_rounds")
 
 	moveFunctions[Start] = msc.moveToContribute
 	moveFunctions[Contribute] = msc.moveToShareOrPublish
 	moveFunctions[Share] = msc.moveToShareOrPublish
+	moveFunctions[Complain] = msc.moveToShareOrPublish
 	moveFunctions[Publish] = msc.moveToWait
 	moveFunctions[Wait] = msc.moveToStart
 
@@ -51,6 +53,9 @@ func (msc *MinerSmartContract) initSC() {
 	msc.smartContractFunctions["addToDelegatePool"] = msc.addToDelegatePool
 	msc.smartContractFunctions["deleteFromDelegatePool"] = msc.deleteFromDelegatePool
 	msc.smartContractFunctions["sharder_keep"] = msc.sharderKeep
+	msc.smartContractFunctions["equivocation"] = msc.reportEquivocation
+	msc.smartContractFunctions["unjail"] = msc.unjail
+	msc.smartContractFunctions["complainDKG"] = msc.complainDKG
 }
 
 func (msc *MinerSmartContract) AddMinerIntegrationTests(
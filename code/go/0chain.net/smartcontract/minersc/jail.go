@@ -0,0 +1,241 @@
+package minersc
+
+import (
+	"encoding/json"
+	"math/rand"
+
+	"github.com/0chain/common/core/util"
+
+	"0chain.net/chaincore/block"
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+var MinersLivenessKey = globalKeyHash("miners_liveness")
+
+// minersLiveness counts consecutive rounds the miners of the magic block
+// missed generating or verifying blocks
+type minersLiveness struct {
+	Missed map[string]int64 `json:"missed"`
+}
+
+func getMinersLiveness(balances cstate.CommonStateContextI) (*minersLiveness, error) {
+	lv := &minersLiveness{}
+	err := balances.GetTrieNode(MinersLivenessKey, lv)
+	switch err {
+	case nil:
+	case util.ErrValueNotPresent:
+	default:
+		return nil, err
+	}
+	if lv.Missed == nil {
+		lv.Missed = make(map[string]int64)
+	}
+	return lv, nil
+}
+
+func (lv *minersLiveness) save(balances cstate.StateContextI) error {
+	_, err := balances.InsertTrieNode(MinersLivenessKey, lv)
+	return err
+}
+
+// missedRound reports miners of the magic block missed the round of the
+// block: miners ranked higher than the generator of the block didn't
+// generate it, and miners without a ticket for the previous block didn't
+// verify it. The generator and verifiers are seen alive.
+func missedRound(b *block.Block, mb *block.MagicBlock) (missed, seen []string) {
+	var (
+		nodes    = mb.Miners.CopyNodes()
+		ranks    = rand.New(rand.NewSource(b.GetRoundRandomSeed())).Perm(len(nodes))
+		genRank  = len(nodes)
+		tickets  = b.GetPrevBlockVerificationTickets()
+		verified = make(map[string]struct{}, len(tickets))
+	)
+	for _, n := range nodes {
+		if n.ID == b.MinerID && n.SetIndex < len(ranks) {
+			genRank = ranks[n.SetIndex]
+		}
+	}
+	for _, tk := range tickets {
+		verified[tk.VerifierID] = struct{}{}
+	}
+
+	for _, n := range nodes {
+		if n.ID == b.MinerID {
+			seen = append(seen, n.ID)
+			continue
+		}
+		var (
+			_, ok       = verified[n.ID]
+			notVerified = len(tickets) > 0 && !ok
+			notGen      = n.SetIndex < len(ranks) && ranks[n.SetIndex] < genRank
+		)
+		switch {
+		case notVerified || notGen:
+			missed = append(missed, n.ID)
+		case ok:
+			seen = append(seen, n.ID)
+		}
+	}
+	return
+}
+
+// updateLiveness counts rounds the miners missed and jails the miners
+// missed gn.JailThreshold rounds in a row
+func updateLiveness(b *block.Block, gn *GlobalNode,
+	balances cstate.StateContextI) error {
+
+	if gn.JailThreshold <= 0 {
+		return nil
+	}
+	var mb = balances.GetMagicBlock(b.Round)
+	if mb == nil || mb.Miners == nil {
+		return nil
+	}
+
+	lv, err := getMinersLiveness(balances)
+	if err != nil {
+		return err
+	}
+
+	missed, seen := missedRound(b, mb)
+	for _, id := range seen {
+		delete(lv.Missed, id)
+	}
+	for _, id := range missed {
+		lv.Missed[id]++
+		if lv.Missed[id] < gn.JailThreshold {
+			continue
+		}
+		delete(lv.Missed, id)
+
+		mn, err := getMinerNode(id, balances)
+		switch err {
+		case nil:
+		case util.ErrValueNotPresent:
+			continue
+		default:
+			return err
+		}
+		if mn.Jailed {
+			continue
+		}
+		if err := jail(mn, b.Round, balances); err != nil {
+			return err
+		}
+	}
+
+	return lv.save(balances)
+}
+
+// jail excludes a miner from rewards and from the next view change
+func jail(mn *MinerNode, round int64, balances cstate.StateContextI) error {
+	mn.Jailed = true
+	mn.JailedRound = round
+	if err := mn.save(balances); err != nil {
+		return err
+	}
+	if err := excludeFromDKG(mn.ID, balances); err != nil {
+		return err
+	}
+	return emitUpdateMiner(mn, balances, false)
+}
+
+// excludeFromDKG removes a miner from the DKG of the next view change if
+// it's not too late
+func excludeFromDKG(id string, balances cstate.StateContextI) error {
+	pn, err := GetPhaseNode(balances)
+	if err != nil {
+		return err
+	}
+	if pn.Phase != Contribute {
+		// the DKG miners are not chosen yet, or the DKG is in progress
+		return nil
+	}
+
+	dkgMiners, err := getDKGMinersList(balances)
+	if err != nil {
+		return err
+	}
+	if _, ok := dkgMiners.SimpleNodes[id]; !ok {
+		return nil
+	}
+	delete(dkgMiners.SimpleNodes, id)
+	if err := updateDKGMinersList(balances, dkgMiners); err != nil {
+		return err
+	}
+
+	mpks, err := getMinersMPKs(balances)
+	switch err {
+	case nil:
+	case util.ErrValueNotPresent:
+		return nil
+	default:
+		return err
+	}
+	if _, ok := mpks.Mpks[id]; !ok {
+		return nil
+	}
+	delete(mpks.Mpks, id)
+	return updateMinersMPKs(balances, mpks)
+}
+
+// unjail returns a jailed miner to rewards and view changes after
+// gn.JailCooldown rounds, by the delegate wallet of the miner
+func (msc *MinerSmartContract) unjail(t *transaction.Transaction,
+	inputData []byte, gn *GlobalNode, balances cstate.StateContextI) (
+	resp string, err error) {
+
+	var req = NewMinerNode()
+	if err = req.Decode(inputData); err != nil {
+		return "", common.NewErrorf("unjail_failed",
+			"decoding request: %v", err)
+	}
+
+	mn, err := getMinerNode(req.ID, balances)
+	if err != nil {
+		return "", common.NewErrorf("unjail_failed",
+			"can't get the miner %s: %v", req.ID, err)
+	}
+
+	if mn.Settings.DelegateWallet != t.ClientID {
+		return "", common.NewError("unjail_failed", "access denied")
+	}
+	if !mn.Jailed {
+		return "", common.NewError("unjail_failed", "the miner is not jailed")
+	}
+	var round = balances.GetBlock().Round
+	if round < mn.JailedRound+gn.JailCooldown {
+		return "", common.NewErrorf("unjail_failed",
+			"the miner is jailed until round %d", mn.JailedRound+gn.JailCooldown)
+	}
+
+	mn.Jailed, mn.JailedRound = false, 0
+	if err = mn.save(balances); err != nil {
+		return "", common.NewError("unjail_failed", err.Error())
+	}
+
+	lv, err := getMinersLiveness(balances)
+	if err != nil {
+		return "", common.NewError("unjail_failed", err.Error())
+	}
+	if _, ok := lv.Missed[mn.ID]; ok {
+		delete(lv.Missed, mn.ID)
+		if err = lv.save(balances); err != nil {
+			return "", common.NewError("unjail_failed", err.Error())
+		}
+	}
+
+	if err = emitUpdateMiner(mn, balances, false); err != nil {
+		return "", common.NewError("unjail_failed", err.Error())
+	}
+
+	b, err := json.Marshal(mn.SimpleNode)
+	if err != nil {
+		return "", common.NewError("unjail_failed", err.Error())
+	}
+	return string(b), nil
+}
@@ -0,0 +1,99 @@
+package minersc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *minersLiveness) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "Missed"
+	o = append(o, 0x81, 0xa6, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Missed)))
+	keys_za0001 := make([]string, 0, len(z.Missed))
+	for k := range z.Missed {
+		keys_za0001 = append(keys_za0001, k)
+	}
+	msgp.Sort(keys_za0001)
+	for _, k := range keys_za0001 {
+		za0002 := z.Missed[k]
+		o = msgp.AppendString(o, k)
+		o = msgp.AppendInt64(o, za0002)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *minersLiveness) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Missed":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Missed")
+				return
+			}
+			if z.Missed == nil {
+				z.Missed = make(map[string]int64, zb0002)
+			} else if len(z.Missed) > 0 {
+				for key := range z.Missed {
+					delete(z.Missed, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 int64
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Missed")
+					return
+				}
+				za0002, bts, err = msgp.ReadInt64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Missed", za0001)
+					return
+				}
+				z.Missed[za0001] = za0002
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *minersLiveness) Msgsize() (s int) {
+	s = 1 + 7 + msgp.MapHeaderSize
+	if z.Missed != nil {
+		for za0001, za0002 := range z.Missed {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.Int64Size
+		}
+	}
+	return
+}
@@ -0,0 +1,184 @@
+package minersc
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+)
+
+// minersByRank returns IDs of the magic block miners ordered by their
+// generator rank for the round random seed
+func minersByRank(mb *block.MagicBlock, seed int64) []string {
+	var (
+		nodes = mb.Miners.CopyNodes()
+		ranks = rand.New(rand.NewSource(seed)).Perm(len(nodes))
+		ids   = make([]string, len(nodes))
+	)
+	for _, n := range nodes {
+		ids[ranks[n.SetIndex]] = n.ID
+	}
+	return ids
+}
+
+func newTestLivenessBlock(round, seed int64, generator string,
+	verifiers ...string) *block.Block {
+
+	var b = &block.Block{}
+	b.Round = round
+	b.MinerID = generator
+	b.RoundRandomSeed = seed
+	for _, id := range verifiers {
+		b.PrevBlockVerificationTickets = append(b.PrevBlockVerificationTickets,
+			&block.VerificationTicket{VerifierID: id})
+	}
+	return b
+}
+
+func TestMissedRound(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		mb       = newTestMagicBlock(t,
+			newClient(0, balances), newClient(0, balances), newClient(0, balances))
+		ids = minersByRank(mb, 7)
+	)
+
+	// the best ranked miner didn't generate the block
+	missed, seen := missedRound(newTestLivenessBlock(1, 7, ids[1]), mb)
+	assert.ElementsMatch(t, []string{ids[0]}, missed)
+	assert.ElementsMatch(t, []string{ids[1]}, seen)
+
+	// the worst ranked miner didn't verify the previous block
+	missed, seen = missedRound(newTestLivenessBlock(1, 7, ids[0], ids[0], ids[1]), mb)
+	assert.ElementsMatch(t, []string{ids[2]}, missed)
+	assert.ElementsMatch(t, []string{ids[0], ids[1]}, seen)
+
+	// a verifier ranked higher than the generator
+	missed, seen = missedRound(newTestLivenessBlock(1, 7, ids[2], ids[0], ids[1]), mb)
+	assert.ElementsMatch(t, []string{ids[0], ids[1]}, missed)
+	assert.ElementsMatch(t, []string{ids[2]}, seen)
+}
+
+func TestUpdateLiveness(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		gn       = setConfig(t, balances)
+	)
+	gn.JailThreshold = 2
+	balances.magicBlock = newTestMagicBlock(t,
+		newClient(0, balances), newClient(0, balances), newClient(0, balances))
+	var ids = minersByRank(balances.magicBlock, 7)
+	for _, id := range ids {
+		var mn = NewMinerNode()
+		mn.ID = id
+		require.NoError(t, mn.save(balances))
+	}
+
+	var round = func(r int64, generator string) {
+		balances.block = newTestLivenessBlock(r, 7, generator)
+		require.NoError(t, updateLiveness(balances.block, gn, balances))
+	}
+	var jailed = func(id string) bool {
+		mn, err := getMinerNode(id, balances)
+		require.NoError(t, err)
+		return mn.Jailed
+	}
+
+	round(1, ids[2])
+	lv, err := getMinersLiveness(balances)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{ids[0]: 1, ids[1]: 1}, lv.Missed)
+
+	// the second miner is back, the first one misses in a row
+	round(2, ids[1])
+	lv, err = getMinersLiveness(balances)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{}, lv.Missed)
+	assert.True(t, jailed(ids[0]))
+	assert.False(t, jailed(ids[1]))
+
+	mn, err := getMinerNode(ids[0], balances)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, mn.JailedRound)
+
+	// disabled
+	gn.JailThreshold = 0
+	round(3, ids[2])
+	round(4, ids[2])
+	assert.False(t, jailed(ids[1]))
+}
+
+func TestMinerSmartContract_unjail(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		msc      = newTestMinerSC()
+		gn       = setConfig(t, balances)
+		owner    = newClient(0, balances)
+		mn       = NewMinerNode()
+	)
+	gn.JailCooldown = 10
+	mn.ID = newClient(0, balances).id
+	mn.Settings.DelegateWallet = owner.id
+	require.NoError(t, mn.save(balances))
+
+	var unjail = func(clientID string, round int64) (string, error) {
+		balances.block = &block.Block{}
+		balances.block.Round = round
+		var req = &MinerNode{SimpleNode: &SimpleNode{ID: mn.ID}}
+		return msc.unjail(newTransaction(clientID, ADDRESS, 0, round),
+			req.Encode(), gn, balances)
+	}
+
+	_, err := unjail(owner.id, 1)
+	require.EqualError(t, err, "unjail_failed: the miner is not jailed")
+
+	require.NoError(t, jail(mn, 5, balances))
+	var lv = &minersLiveness{Missed: map[string]int64{mn.ID: 3}}
+	require.NoError(t, lv.save(balances))
+
+	_, err = unjail(newClient(0, balances).id, 15)
+	require.EqualError(t, err, "unjail_failed: access denied")
+	_, err = unjail(owner.id, 14)
+	require.EqualError(t, err, "unjail_failed: the miner is jailed until round 15")
+
+	resp, err := unjail(owner.id, 15)
+	require.NoError(t, err)
+	var sn SimpleNode
+	require.NoError(t, json.Unmarshal([]byte(resp), &sn))
+	assert.False(t, sn.Jailed)
+
+	got, err := getMinerNode(mn.ID, balances)
+	require.NoError(t, err)
+	assert.False(t, got.Jailed)
+	assert.Zero(t, got.JailedRound)
+	lv, err = getMinersLiveness(balances)
+	require.NoError(t, err)
+	assert.NotContains(t, lv.Missed, mn.ID)
+}
+
+func TestPayBlockRewards_jailed(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		gn       = setConfig(t, balances)
+		mn       = NewMinerNode()
+		b        = &block.Block{}
+	)
+	gn.BurnAddress = "0000000000000000000000000000000000000000000000000000000000000000"
+	mn.ID = newClient(0, balances).id
+	mn.Jailed = true
+	balances.txn = newTransaction(mn.ID, ADDRESS, 0, 1)
+	balances.balances[ADDRESS] = 1000
+
+	_, err := payBlockRewards(b, mn, 1000, gn, balances)
+	require.NoError(t, err)
+
+	minerFees, _, err := gn.splitByShareRatio(1000)
+	require.NoError(t, err)
+	require.NotZero(t, minerFees)
+	assert.Equal(t, minerFees, balances.balances[gn.BurnAddress])
+	assert.Equal(t, 1000-minerFees, balances.balances[ADDRESS])
+}
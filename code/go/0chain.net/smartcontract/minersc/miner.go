@@ -298,7 +298,10 @@ func (msc *MinerSmartContract) UpdateMinerSettings(t *transaction.Transaction,
 		return "", common.NewError("update_miner_settings", "access denied")
 	}
 
-	mn.Settings.ServiceChargeRatio = update.Settings.ServiceChargeRatio
+	if err = mn.UpdateServiceCharge(update.Settings.ServiceChargeRatio,
+		mn.ID, spenum.Miner, gn.commission(), balances); err != nil {
+		return "", common.NewError("update_miner_settings", err.Error())
+	}
 	mn.Settings.MaxNumDelegates = update.Settings.MaxNumDelegates
 	mn.Settings.MinStake = update.Settings.MinStake
 	mn.Settings.MaxStake = update.Settings.MaxStake
@@ -122,6 +122,7 @@ func minerNodeToMinerTable(mn *MinerNode) event.Miner {
 		Active:    mn.Status == node.NodeStatusActive,
 		Longitude: mn.Geolocation.Longitude,
 		Latitude:  mn.Geolocation.Latitude,
+		Jailed:    mn.Jailed,
 	}
 }
 
@@ -165,6 +166,7 @@ func emitUpdateMiner(mn *MinerNode, balances cstate.StateContextI, updateStatus
 			"last_health_check": mn.LastHealthCheck,
 			"longitude":         mn.SimpleNode.Geolocation.Longitude,
 			"latitude":          mn.SimpleNode.Geolocation.Latitude,
+			"jailed":            mn.Jailed,
 		},
 	}
 
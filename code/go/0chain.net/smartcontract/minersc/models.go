@@ -14,12 +14,14 @@ import (
 	"github.com/0chain/common/core/currency"
 
 	"0chain.net/smartcontract"
+	"0chain.net/smartcontract/stakepool"
 	"0chain.net/smartcontract/stakepool/spenum"
 
 	"0chain.net/chaincore/block"
 	cstate "0chain.net/chaincore/chain/state"
 	"0chain.net/chaincore/config"
 	"0chain.net/chaincore/node"
+	"0chain.net/chaincore/threshold/bls"
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
@@ -42,7 +44,8 @@ func init() {
 // Phase number.
 type Phase int
 
-// known phases
+// known phases, the phase is saved by its number, so new phases are
+// appended regardless of the order they run
 const (
 	Unknown Phase = iota - 1
 	Start
@@ -50,8 +53,23 @@ const (
 	Share
 	Publish
 	Wait
+	Complain
 )
 
+// next returns the phase running after the p, the complain phase runs
+// between the share and the publish ones
+func (p Phase) next() Phase {
+	switch p {
+	case Share:
+		return Complain
+	case Complain:
+		return Publish
+	case Wait:
+		return Start
+	}
+	return p + 1
+}
+
 func (p Phase) String() string {
 	switch p {
 	case Unknown:
@@ -62,6 +80,8 @@ func (p Phase) String() string {
 		return "contribute"
 	case Share:
 		return "share"
+	case Complain:
+		return "complain"
 	case Publish:
 		return "publish"
 	case Wait:
@@ -256,6 +276,30 @@ type GlobalNode struct {
 	OwnerId              string         `json:"owner_id"`
 	CooldownPeriod       int64          `json:"cooldown_period"`
 	Cost                 map[string]int `json:"cost"`
+	// CommissionNoticePeriod is number of rounds a service charge increase
+	// waits before it becomes effective.
+	CommissionNoticePeriod int64 `json:"commission_notice_period"`
+	// MaxCommissionIncrease is the max service charge increase per notice period.
+	MaxCommissionIncrease float64 `json:"max_commission_increase"`
+	// EquivocationSlashRatio is part of stake slashed from a miner proven
+	// to sign conflicting blocks or verification tickets in a round.
+	EquivocationSlashRatio float64 `json:"equivocation_slash_ratio"`
+	// EquivocationReporterRatio is part of the slashed tokens paid to the
+	// reporter of the equivocation, the rest is burned.
+	EquivocationReporterRatio float64 `json:"equivocation_reporter_ratio"`
+	// BurnAddress receives burned tokens.
+	BurnAddress string `json:"burn_address"`
+	// JailThreshold is number of consecutive rounds a miner misses
+	// generating or verifying blocks to be jailed; zero disables it.
+	JailThreshold int64 `json:"jail_threshold"`
+	// JailCooldown is number of rounds a jailed miner waits to unjail.
+	JailCooldown int64 `json:"jail_cooldown"`
+	// DKGScheme is the DKG scheme of the next view changes.
+	DKGScheme string `json:"dkg_scheme"`
+	// ReshareDKG makes the view changes reshare the group secret of the
+	// previous magic block with the new miners instead of generating a new
+	// one, keeping the group public key.
+	ReshareDKG bool `json:"reshare_dkg"`
 }
 
 func (gn *GlobalNode) readConfig() (err error) {
@@ -295,6 +339,15 @@ func (gn *GlobalNode) readConfig() (err error) {
 	}
 	gn.OwnerId = config.SmartContractConfig.GetString(pfx + SettingName[OwnerId])
 	gn.CooldownPeriod = config.SmartContractConfig.GetInt64(pfx + SettingName[CooldownPeriod])
+	gn.CommissionNoticePeriod = config.SmartContractConfig.GetInt64(pfx + SettingName[CommissionNoticePeriod])
+	gn.MaxCommissionIncrease = config.SmartContractConfig.GetFloat64(pfx + SettingName[MaxCommissionIncrease])
+	gn.EquivocationSlashRatio = config.SmartContractConfig.GetFloat64(pfx + SettingName[EquivocationSlashRatio])
+	gn.EquivocationReporterRatio = config.SmartContractConfig.GetFloat64(pfx + SettingName[EquivocationReporterRatio])
+	gn.BurnAddress = config.SmartContractConfig.GetString(pfx + SettingName[BurnAddress])
+	gn.JailThreshold = config.SmartContractConfig.GetInt64(pfx + SettingName[JailThreshold])
+	gn.JailCooldown = config.SmartContractConfig.GetInt64(pfx + SettingName[JailCooldown])
+	gn.DKGScheme = config.SmartContractConfig.GetString(pfx + SettingName[DKGScheme])
+	gn.ReshareDKG = config.SmartContractConfig.GetBool(pfx + SettingName[ReshareDKG])
 	gn.Cost = config.SmartContractConfig.GetStringMapInt(pfx + "cost")
 	return nil
 }
@@ -331,9 +384,41 @@ func (gn *GlobalNode) validate() error {
 		return fmt.Errorf("%s cannot be negative: %d",
 			NumShardersRewarded.String(), gn.NumShardersRewarded)
 	}
+	if gn.EquivocationSlashRatio < 0 || gn.EquivocationSlashRatio > 1 {
+		return fmt.Errorf("%s must be in [0; 1]: %v",
+			EquivocationSlashRatio.String(), gn.EquivocationSlashRatio)
+	}
+	if gn.EquivocationReporterRatio < 0 || gn.EquivocationReporterRatio > 1 {
+		return fmt.Errorf("%s must be in [0; 1]: %v",
+			EquivocationReporterRatio.String(), gn.EquivocationReporterRatio)
+	}
+	if gn.JailThreshold < 0 {
+		return fmt.Errorf("%s cannot be negative: %d",
+			JailThreshold.String(), gn.JailThreshold)
+	}
+	if gn.JailCooldown < 0 {
+		return fmt.Errorf("%s cannot be negative: %d",
+			JailCooldown.String(), gn.JailCooldown)
+	}
+	scheme, err := bls.GetScheme(gn.DKGScheme)
+	if err != nil {
+		return fmt.Errorf("%s: %v", DKGScheme.String(), err)
+	}
+	if _, ok := scheme.(bls.ResharingScheme); gn.ReshareDKG && !ok {
+		return fmt.Errorf("%s: DKG scheme %q can't reshare",
+			ReshareDKG.String(), gn.DKGScheme)
+	}
 	return nil
 }
 
+// commission limits of the miners and sharders stake pools
+func (gn *GlobalNode) commission() stakepool.CommissionConfig {
+	return stakepool.CommissionConfig{
+		NoticePeriod: gn.CommissionNoticePeriod,
+		MaxIncrease:  gn.MaxCommissionIncrease,
+	}
+}
+
 func (gn *GlobalNode) getConfigMap() (smartcontract.StringMap, error) {
 	var out smartcontract.StringMap
 	out.Fields = make(map[string]string)
@@ -411,6 +496,24 @@ func (gn *GlobalNode) Get(key Setting) (interface{}, error) {
 		return gn.OwnerId, nil
 	case CooldownPeriod:
 		return gn.CooldownPeriod, nil
+	case CommissionNoticePeriod:
+		return gn.CommissionNoticePeriod, nil
+	case MaxCommissionIncrease:
+		return gn.MaxCommissionIncrease, nil
+	case EquivocationSlashRatio:
+		return gn.EquivocationSlashRatio, nil
+	case EquivocationReporterRatio:
+		return gn.EquivocationReporterRatio, nil
+	case BurnAddress:
+		return gn.BurnAddress, nil
+	case JailThreshold:
+		return gn.JailThreshold, nil
+	case JailCooldown:
+		return gn.JailCooldown, nil
+	case DKGScheme:
+		return gn.DKGScheme, nil
+	case ReshareDKG:
+		return gn.ReshareDKG, nil
 	default:
 		return nil, errors.New("Setting not implemented")
 	}
@@ -690,6 +793,11 @@ type SimpleNode struct {
 
 	//LastSettingUpdateRound will be set to round number when settings were updated
 	LastSettingUpdateRound int64 `json:"last_setting_update_round"`
+
+	// Jailed node is excluded from the next view change, JailedRound is the
+	// round it was jailed at.
+	Jailed      bool  `json:"jailed,omitempty"`
+	JailedRound int64 `json:"jailed_round,omitempty"`
 }
 
 func (smn *SimpleNode) Encode() []byte {
@@ -798,6 +906,16 @@ type DKGMinerNodes struct {
 	XPercent       float64         `json:"x_percent"`
 	RevealedShares map[string]int  `json:"revealed_shares"`
 	Waited         map[string]bool `json:"waited"`
+	// Scheme is the DKG scheme of the view change.
+	Scheme string `json:"scheme"`
+	// Disqualified are dealers proven to deal invalid shares, mapped to
+	// the miners complained about them.
+	Disqualified map[string]string `json:"disqualified"`
+	// Reshare is set when the view change reshares the group secret of the
+	// previous magic block, only its miners deal then, and PrevT of them
+	// are enough.
+	Reshare bool `json:"reshare"`
+	PrevT   int  `json:"prev_t"`
 
 	// StartRound used to filter responses from old MB where sharders comes up.
 	StartRound int64 `json:"start_round"`
@@ -809,6 +927,7 @@ func (dkgmn *DKGMinerNodes) setConfigs(gn *GlobalNode) {
 	dkgmn.TPercent = gn.TPercent
 	dkgmn.KPercent = gn.KPercent
 	dkgmn.XPercent = gn.XPercent
+	dkgmn.Scheme = gn.DKGScheme
 }
 
 func min(a, b int) int {
@@ -880,6 +999,7 @@ func NewDKGMinerNodes() *DKGMinerNodes {
 		SimpleNodes:    NewSimpleNodes(),
 		RevealedShares: make(map[string]int),
 		Waited:         make(map[string]bool),
+		Disqualified:   make(map[string]string),
 	}
 }
 
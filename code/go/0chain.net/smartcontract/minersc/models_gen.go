@@ -10,9 +10,9 @@ import (
 // MarshalMsg implements msgp.Marshaler
 func (z *DKGMinerNodes) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 12
+	// map header, size 16
 	// string "MinN"
-	o = append(o, 0x8c, 0xa4, 0x4d, 0x69, 0x6e, 0x4e)
+	o = append(o, 0xde, 0x0, 0x10, 0xa4, 0x4d, 0x69, 0x6e, 0x4e)
 	o = msgp.AppendInt(o, z.MinN)
 	// string "MaxN"
 	o = append(o, 0xa4, 0x4d, 0x61, 0x78, 0x4e)
@@ -82,6 +82,28 @@ func (z *DKGMinerNodes) MarshalMsg(b []byte) (o []byte, err error) {
 		o = msgp.AppendString(o, k)
 		o = msgp.AppendBool(o, za0006)
 	}
+	// string "Scheme"
+	o = append(o, 0xa6, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Scheme)
+	// string "Disqualified"
+	o = append(o, 0xac, 0x44, 0x69, 0x73, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Disqualified)))
+	keys_za0007 := make([]string, 0, len(z.Disqualified))
+	for k := range z.Disqualified {
+		keys_za0007 = append(keys_za0007, k)
+	}
+	msgp.Sort(keys_za0007)
+	for _, k := range keys_za0007 {
+		za0008 := z.Disqualified[k]
+		o = msgp.AppendString(o, k)
+		o = msgp.AppendString(o, za0008)
+	}
+	// string "Reshare"
+	o = append(o, 0xa7, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65)
+	o = msgp.AppendBool(o, z.Reshare)
+	// string "PrevT"
+	o = append(o, 0xa5, 0x50, 0x72, 0x65, 0x76, 0x54)
+	o = msgp.AppendInt(o, z.PrevT)
 	// string "StartRound"
 	o = append(o, 0xaa, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64)
 	o = msgp.AppendInt64(o, z.StartRound)
@@ -255,6 +277,54 @@ func (z *DKGMinerNodes) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.Waited[za0005] = za0006
 			}
+		case "Scheme":
+			z.Scheme, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Scheme")
+				return
+			}
+		case "Disqualified":
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Disqualified")
+				return
+			}
+			if z.Disqualified == nil {
+				z.Disqualified = make(map[string]string, zb0005)
+			} else if len(z.Disqualified) > 0 {
+				for key := range z.Disqualified {
+					delete(z.Disqualified, key)
+				}
+			}
+			for zb0005 > 0 {
+				var za0007 string
+				var za0008 string
+				zb0005--
+				za0007, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Disqualified")
+					return
+				}
+				za0008, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Disqualified", za0007)
+					return
+				}
+				z.Disqualified[za0007] = za0008
+			}
+		case "Reshare":
+			z.Reshare, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Reshare")
+				return
+			}
+		case "PrevT":
+			z.PrevT, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "PrevT")
+				return
+			}
 		case "StartRound":
 			z.StartRound, bts, err = msgp.ReadInt64Bytes(bts)
 			if err != nil {
@@ -275,7 +345,7 @@ func (z *DKGMinerNodes) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DKGMinerNodes) Msgsize() (s int) {
-	s = 1 + 5 + msgp.IntSize + 5 + msgp.IntSize + 9 + msgp.Float64Size + 9 + msgp.Float64Size + 12 + msgp.MapHeaderSize
+	s = 3 + 5 + msgp.IntSize + 5 + msgp.IntSize + 9 + msgp.Float64Size + 9 + msgp.Float64Size + 12 + msgp.MapHeaderSize
 	if z.SimpleNodes != nil {
 		for za0001, za0002 := range z.SimpleNodes {
 			_ = za0002
@@ -301,16 +371,23 @@ func (z *DKGMinerNodes) Msgsize() (s int) {
 			s += msgp.StringPrefixSize + len(za0005) + msgp.BoolSize
 		}
 	}
-	s += 11 + msgp.Int64Size
+	s += 7 + msgp.StringPrefixSize + len(z.Scheme) + 13 + msgp.MapHeaderSize
+	if z.Disqualified != nil {
+		for za0007, za0008 := range z.Disqualified {
+			_ = za0008
+			s += msgp.StringPrefixSize + len(za0007) + msgp.StringPrefixSize + len(za0008)
+		}
+	}
+	s += 8 + msgp.BoolSize + 6 + msgp.IntSize + 11 + msgp.Int64Size
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *GlobalNode) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 28
+	// map header, size 37
 	// string "ViewChange"
-	o = append(o, 0xde, 0x0, 0x1c, 0xaa, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65)
+	o = append(o, 0xde, 0x0, 0x25, 0xaa, 0x56, 0x69, 0x65, 0x77, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65)
 	o = msgp.AppendInt64(o, z.ViewChange)
 	// string "MaxN"
 	o = append(o, 0xa4, 0x4d, 0x61, 0x78, 0x4e)
@@ -431,6 +508,33 @@ func (z *GlobalNode) MarshalMsg(b []byte) (o []byte, err error) {
 		o = msgp.AppendString(o, k)
 		o = msgp.AppendInt(o, za0002)
 	}
+	// string "CommissionNoticePeriod"
+	o = append(o, 0xb6, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64)
+	o = msgp.AppendInt64(o, z.CommissionNoticePeriod)
+	// string "MaxCommissionIncrease"
+	o = append(o, 0xb5, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65)
+	o = msgp.AppendFloat64(o, z.MaxCommissionIncrease)
+	// string "EquivocationSlashRatio"
+	o = append(o, 0xb6, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x61, 0x74, 0x69, 0x6f)
+	o = msgp.AppendFloat64(o, z.EquivocationSlashRatio)
+	// string "EquivocationReporterRatio"
+	o = append(o, 0xb9, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f)
+	o = msgp.AppendFloat64(o, z.EquivocationReporterRatio)
+	// string "BurnAddress"
+	o = append(o, 0xab, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	o = msgp.AppendString(o, z.BurnAddress)
+	// string "JailThreshold"
+	o = append(o, 0xad, 0x4a, 0x61, 0x69, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64)
+	o = msgp.AppendInt64(o, z.JailThreshold)
+	// string "JailCooldown"
+	o = append(o, 0xac, 0x4a, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e)
+	o = msgp.AppendInt64(o, z.JailCooldown)
+	// string "DKGScheme"
+	o = append(o, 0xa9, 0x44, 0x4b, 0x47, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.DKGScheme)
+	// string "ReshareDKG"
+	o = append(o, 0xaa, 0x52, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x44, 0x4b, 0x47)
+	o = msgp.AppendBool(o, z.ReshareDKG)
 	return
 }
 
@@ -655,6 +759,60 @@ func (z *GlobalNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 				z.Cost[za0001] = za0002
 			}
+		case "CommissionNoticePeriod":
+			z.CommissionNoticePeriod, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CommissionNoticePeriod")
+				return
+			}
+		case "MaxCommissionIncrease":
+			z.MaxCommissionIncrease, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxCommissionIncrease")
+				return
+			}
+		case "EquivocationSlashRatio":
+			z.EquivocationSlashRatio, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EquivocationSlashRatio")
+				return
+			}
+		case "EquivocationReporterRatio":
+			z.EquivocationReporterRatio, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "EquivocationReporterRatio")
+				return
+			}
+		case "BurnAddress":
+			z.BurnAddress, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "BurnAddress")
+				return
+			}
+		case "JailThreshold":
+			z.JailThreshold, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "JailThreshold")
+				return
+			}
+		case "JailCooldown":
+			z.JailCooldown, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "JailCooldown")
+				return
+			}
+		case "DKGScheme":
+			z.DKGScheme, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DKGScheme")
+				return
+			}
+		case "ReshareDKG":
+			z.ReshareDKG, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReshareDKG")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -682,6 +840,7 @@ func (z *GlobalNode) Msgsize() (s int) {
 			s += msgp.StringPrefixSize + len(za0001) + msgp.IntSize
 		}
 	}
+	s += 23 + msgp.Int64Size + 22 + msgp.Float64Size + 23 + msgp.Float64Size + 26 + msgp.Float64Size + 12 + msgp.StringPrefixSize + len(z.BurnAddress) + 14 + msgp.Int64Size + 13 + msgp.Int64Size + 10 + msgp.StringPrefixSize + len(z.DKGScheme) + 11 + msgp.BoolSize
 	return
 }
 
@@ -827,9 +986,9 @@ func (z *PhaseNode) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *SimpleNode) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 14
+	// map header, size 16
 	// string "ID"
-	o = append(o, 0x8e, 0xa2, 0x49, 0x44)
+	o = append(o, 0xde, 0x0, 0x10, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "N2NHost"
 	o = append(o, 0xa7, 0x4e, 0x32, 0x4e, 0x48, 0x6f, 0x73, 0x74)
@@ -884,6 +1043,12 @@ func (z *SimpleNode) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "LastSettingUpdateRound"
 	o = append(o, 0xb6, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64)
 	o = msgp.AppendInt64(o, z.LastSettingUpdateRound)
+	// string "Jailed"
+	o = append(o, 0xa6, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64)
+	o = msgp.AppendBool(o, z.Jailed)
+	// string "JailedRound"
+	o = append(o, 0xab, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64)
+	o = msgp.AppendInt64(o, z.JailedRound)
 	return
 }
 
@@ -1022,6 +1187,18 @@ func (z *SimpleNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "LastSettingUpdateRound")
 				return
 			}
+		case "Jailed":
+			z.Jailed, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Jailed")
+				return
+			}
+		case "JailedRound":
+			z.JailedRound, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "JailedRound")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -1036,7 +1213,7 @@ func (z *SimpleNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *SimpleNode) Msgsize() (s int) {
-	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 8 + msgp.StringPrefixSize + len(z.N2NHost) + 5 + msgp.StringPrefixSize + len(z.Host) + 5 + msgp.IntSize + 12 + 1 + 9 + msgp.Float64Size + 10 + msgp.Float64Size + 5 + msgp.StringPrefixSize + len(z.Path) + 10 + msgp.StringPrefixSize + len(z.PublicKey) + 10 + msgp.StringPrefixSize + len(z.ShortName) + 9 + msgp.StringPrefixSize + len(z.BuildTag) + 12 + z.TotalStaked.Msgsize() + 7 + msgp.BoolSize + 9 + msgp.IntSize + 16 + z.LastHealthCheck.Msgsize() + 23 + msgp.Int64Size
+	s = 3 + 3 + msgp.StringPrefixSize + len(z.ID) + 8 + msgp.StringPrefixSize + len(z.N2NHost) + 5 + msgp.StringPrefixSize + len(z.Host) + 5 + msgp.IntSize + 12 + 1 + 9 + msgp.Float64Size + 10 + msgp.Float64Size + 5 + msgp.StringPrefixSize + len(z.Path) + 10 + msgp.StringPrefixSize + len(z.PublicKey) + 10 + msgp.StringPrefixSize + len(z.ShortName) + 9 + msgp.StringPrefixSize + len(z.BuildTag) + 12 + z.TotalStaked.Msgsize() + 7 + msgp.BoolSize + 9 + msgp.IntSize + 16 + z.LastHealthCheck.Msgsize() + 23 + msgp.Int64Size + 7 + msgp.BoolSize + 12 + msgp.Int64Size
 	return
 }
 
@@ -45,6 +45,15 @@ const (
 	MaxMint
 	OwnerId
 	CooldownPeriod
+	CommissionNoticePeriod
+	MaxCommissionIncrease
+	EquivocationSlashRatio
+	EquivocationReporterRatio
+	BurnAddress
+	JailThreshold
+	JailCooldown
+	DKGScheme
+	ReshareDKG
 	CostAddMiner
 	CostAddSharder
 	CostDeleteMiner
@@ -63,6 +72,9 @@ const (
 	CostAddToDelegatePool
 	CostDeleteFromDelegatePool
 	CostSharderKeep
+	CostEquivocation
+	CostUnjail
+	CostComplainDKG
 	NumberOfSettings
 )
 
@@ -110,6 +122,15 @@ func initSettingName() {
 	SettingName[MaxMint] = "max_mint"
 	SettingName[OwnerId] = "owner_id"
 	SettingName[CooldownPeriod] = "cooldown_period"
+	SettingName[CommissionNoticePeriod] = "commission_notice_period"
+	SettingName[MaxCommissionIncrease] = "max_commission_increase"
+	SettingName[EquivocationSlashRatio] = "equivocation_slash_ratio"
+	SettingName[EquivocationReporterRatio] = "equivocation_reporter_ratio"
+	SettingName[BurnAddress] = "burn_address"
+	SettingName[JailThreshold] = "jail_threshold"
+	SettingName[JailCooldown] = "jail_cooldown"
+	SettingName[DKGScheme] = "dkg_scheme"
+	SettingName[ReshareDKG] = "reshare_dkg"
 	SettingName[CostAddMiner] = "cost.add_miner"
 	SettingName[CostAddSharder] = "cost.add_sharder"
 	SettingName[CostDeleteMiner] = "cost.delete_miner"
@@ -128,6 +149,9 @@ func initSettingName() {
 	SettingName[CostAddToDelegatePool] = "cost.addToDelegatePool"
 	SettingName[CostDeleteFromDelegatePool] = "cost.deleteFromDelegatePool"
 	SettingName[CostSharderKeep] = "cost.sharder_keep"
+	SettingName[CostEquivocation] = "cost.equivocation"
+	SettingName[CostUnjail] = "cost.unjail"
+	SettingName[CostComplainDKG] = "cost.complainDKG"
 }
 
 func initSettings() {
@@ -158,6 +182,15 @@ func initSettings() {
 		MaxMint.String():                     {MaxMint, smartcontract.CurrencyCoin},
 		OwnerId.String():                     {OwnerId, smartcontract.Key},
 		CooldownPeriod.String():              {CooldownPeriod, smartcontract.Int64},
+		CommissionNoticePeriod.String():      {CommissionNoticePeriod, smartcontract.Int64},
+		MaxCommissionIncrease.String():       {MaxCommissionIncrease, smartcontract.Float64},
+		EquivocationSlashRatio.String():      {EquivocationSlashRatio, smartcontract.Float64},
+		EquivocationReporterRatio.String():   {EquivocationReporterRatio, smartcontract.Float64},
+		BurnAddress.String():                 {BurnAddress, smartcontract.Key},
+		JailThreshold.String():               {JailThreshold, smartcontract.Int64},
+		JailCooldown.String():                {JailCooldown, smartcontract.Int64},
+		DKGScheme.String():                   {DKGScheme, smartcontract.String},
+		ReshareDKG.String():                  {ReshareDKG, smartcontract.Boolean},
 		CostAddMiner.String():                {CostAddMiner, smartcontract.Cost},
 		CostAddSharder.String():              {CostAddSharder, smartcontract.Cost},
 		CostDeleteMiner.String():             {CostDeleteMiner, smartcontract.Cost},
@@ -176,6 +209,9 @@ func initSettings() {
 		CostAddToDelegatePool.String():       {CostAddToDelegatePool, smartcontract.Cost},
 		CostDeleteFromDelegatePool.String():  {CostDeleteFromDelegatePool, smartcontract.Cost},
 		CostSharderKeep.String():             {CostSharderKeep, smartcontract.Cost},
+		CostEquivocation.String():            {CostEquivocation, smartcontract.Cost},
+		CostUnjail.String():                  {CostUnjail, smartcontract.Cost},
+		CostComplainDKG.String():             {CostComplainDKG, smartcontract.Cost},
 	}
 }
 
@@ -227,6 +263,12 @@ func (gn *GlobalNode) setInt64(key string, change int64) error {
 		gn.Epoch = change
 	case CooldownPeriod:
 		gn.CooldownPeriod = change
+	case CommissionNoticePeriod:
+		gn.CommissionNoticePeriod = change
+	case JailThreshold:
+		gn.JailThreshold = change
+	case JailCooldown:
+		gn.JailCooldown = change
 	default:
 		return fmt.Errorf("key: %v not implemented as int64", key)
 	}
@@ -249,6 +291,12 @@ func (gn *GlobalNode) setFloat64(key string, change float64) error {
 		gn.MaxCharge = change
 	case RewardDeclineRate:
 		gn.RewardDeclineRate = change
+	case MaxCommissionIncrease:
+		gn.MaxCommissionIncrease = change
+	case EquivocationSlashRatio:
+		gn.EquivocationSlashRatio = change
+	case EquivocationReporterRatio:
+		gn.EquivocationReporterRatio = change
 	default:
 		return fmt.Errorf("key: %v not implemented as float64", key)
 	}
@@ -259,11 +307,33 @@ func (gn *GlobalNode) setKey(key string, change string) {
 	switch Settings[key].Setting {
 	case OwnerId:
 		gn.OwnerId = change
+	case BurnAddress:
+		gn.BurnAddress = change
 	default:
 		panic("key: " + key + "not implemented as key")
 	}
 }
 
+func (gn *GlobalNode) setString(key string, change string) error {
+	switch Settings[key].Setting {
+	case DKGScheme:
+		gn.DKGScheme = change
+	default:
+		return fmt.Errorf("key: %v not implemented as string", key)
+	}
+	return nil
+}
+
+func (gn *GlobalNode) setBoolean(key string, change bool) error {
+	switch Settings[key].Setting {
+	case ReshareDKG:
+		gn.ReshareDKG = change
+	default:
+		return fmt.Errorf("key: %v not implemented as boolean", key)
+	}
+	return nil
+}
+
 const costPrefix = "cost."
 
 func (gn *GlobalNode) setCost(key string, change int) error {
@@ -346,6 +416,18 @@ func (gn *GlobalNode) set(key string, change string) error {
 			return fmt.Errorf("%s must be a hex string: %v", key, err)
 		}
 		gn.setKey(key, change)
+	case smartcontract.String:
+		if err := gn.setString(key, change); err != nil {
+			return err
+		}
+	case smartcontract.Boolean:
+		value, err := strconv.ParseBool(change)
+		if err != nil {
+			return fmt.Errorf("cannot convert key %s value %v to boolean: %v", key, change, err)
+		}
+		if err := gn.setBoolean(key, value); err != nil {
+			return err
+		}
 	case smartcontract.Cost:
 		value, err := strconv.Atoi(change)
 		if err != nil {
@@ -376,7 +458,7 @@ func (msc *MinerSmartContract) updateSettings(
 	gn *GlobalNode,
 	balances cstate.StateContextI,
 ) (resp string, err error) {
-	if err := smartcontractinterface.AuthorizeWithOwner("update_settings", func() bool {
+	if err := smartcontractinterface.AuthorizeWithGovernance("update_settings", t.ClientID, func() bool {
 		get, _ := gn.Get(OwnerId)
 		return get == t.ClientID
 	}); err != nil {
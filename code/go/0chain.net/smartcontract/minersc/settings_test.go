@@ -135,6 +135,14 @@ func TestUpdateSettings(t *testing.T) {
 								return false
 							}
 						}
+					case smartcontract.String:
+						{
+							actual, ok := setting.(string)
+							require.True(t, ok)
+							if value != actual {
+								return false
+							}
+						}
 					case smartcontract.Key:
 						{
 							_, err := hex.DecodeString(value)
@@ -196,6 +204,15 @@ func TestUpdateSettings(t *testing.T) {
 					"share_ratio":                  "50",
 					"block_reward":                 "021",
 					"max_charge":                   "0.5",
+					"commission_notice_period":     "1000",
+					"max_commission_increase":      "0.1",
+					"equivocation_slash_ratio":     "0.1",
+					"equivocation_reporter_ratio":  "0.1",
+					"burn_address":                 "0000000000000000000000000000000000000000000000000000000000000000",
+					"jail_threshold":               "1000",
+					"jail_cooldown":                "1000",
+					"dkg_scheme":                   "feldman_vss",
+					"reshare_dkg":                  "true",
 					"epoch":                        "6415000000",
 					"reward_decline_rate":          "0.1",
 					"max_mint":                     "1500000.0",
@@ -218,6 +235,9 @@ func TestUpdateSettings(t *testing.T) {
 					"cost.addToDelegatePool":       "111",
 					"cost.deleteFromDelegatePool":  "111",
 					"cost.sharder_keep":            "111",
+					"cost.equivocation":            "111",
+					"cost.unjail":                  "111",
+					"cost.complainDKG":             "111",
 				},
 			},
 		},
@@ -12,6 +12,7 @@ import (
 	"github.com/0chain/common/core/logging"
 	"go.uber.org/zap"
 	commonsc "0chain.net/smartcontract/common"
+	"0chain.net/smartcontract/stakepool/spenum"
 )
 
 func (msc *MinerSmartContract) UpdateSharderSettings(t *transaction.Transaction,
@@ -46,7 +47,10 @@ func (msc *MinerSmartContract) UpdateSharderSettings(t *transaction.Transaction,
 		return "", common.NewError("update_sharder_settings", "access denied")
 	}
 
-	sn.Settings.ServiceChargeRatio = update.Settings.ServiceChargeRatio
+	if err = sn.UpdateServiceCharge(update.Settings.ServiceChargeRatio,
+		sn.ID, spenum.Sharder, gn.commission(), balances); err != nil {
+		return "", common.NewError("update_sharder_settings", err.Error())
+	}
 	sn.Settings.MaxNumDelegates = update.Settings.MaxNumDelegates
 	sn.Settings.MinStake = update.Settings.MinStake
 	sn.Settings.MaxStake = update.Settings.MaxStake
@@ -0,0 +1,184 @@
+package minersc
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/0chain/common/core/currency"
+
+	"0chain.net/chaincore/block"
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+// MaxSimulatedRounds limits the rounds of a rewards simulation
+const MaxSimulatedRounds = 1000
+
+var (
+	// SimulatedMinerID is the ID of the hypothetical miner of a simulation
+	SimulatedMinerID = encryption.Hash("simulated_miner")
+	// SimulatedDelegateID is the delegate of the hypothetical miner
+	SimulatedDelegateID = encryption.Hash("simulated_delegate")
+)
+
+// SimulateRewardsRequest is a provider to simulate rewards of
+type SimulateRewardsRequest struct {
+	// ProviderID is a registered miner or sharder, or empty for a
+	// hypothetical miner with the stake and the service charge given
+	ProviderID    string          `json:"provider_id"`
+	ProviderType  spenum.Provider `json:"provider_type"`
+	Stake         currency.Coin   `json:"stake"`
+	ServiceCharge float64         `json:"service_charge"`
+	// Fees are the fees of every simulated block
+	Fees   currency.Coin `json:"fees"`
+	Rounds int64         `json:"rounds"`
+	// Seed of the simulated generators and round random seeds
+	Seed int64 `json:"seed"`
+}
+
+func (req *SimulateRewardsRequest) validate(gn *GlobalNode) error {
+	if req.Rounds <= 0 || req.Rounds > MaxSimulatedRounds {
+		return fmt.Errorf("rounds should be in [1, %d]", MaxSimulatedRounds)
+	}
+	switch req.ProviderType {
+	case spenum.Miner:
+	case spenum.Sharder:
+		if req.ProviderID == "" {
+			return errors.New("hypothetical sharders are not supported")
+		}
+	default:
+		return fmt.Errorf("unexpected provider type %s", req.ProviderType)
+	}
+	if req.ProviderID != "" {
+		return nil
+	}
+	if req.Stake == 0 {
+		return errors.New("no stake of the hypothetical miner")
+	}
+	if req.ServiceCharge < 0 || req.ServiceCharge > gn.MaxCharge {
+		return fmt.Errorf("service charge should be in [0, %v]", gn.MaxCharge)
+	}
+	return nil
+}
+
+func getProviderNode(providerType spenum.Provider, id string,
+	balances cstate.CommonStateContextI) (*MinerNode, error) {
+
+	if providerType == spenum.Sharder {
+		return getSharderNode(id, balances)
+	}
+	return getMinerNode(id, balances)
+}
+
+// SimulateRewards simulates the rewards of a miner or a sharder over the
+// rounds following the block of the state context, under the current global
+// settings. The block rewards and the fees of every round are paid by the
+// functions paying them on chain, against a fork of the state. Generators of
+// the blocks are chosen at random among the miners not jailed.
+func SimulateRewards(sctx cstate.CommonStateContextI, req *SimulateRewardsRequest) (
+	*stakepool.RewardsSimulation, error) {
+
+	fork, err := cstate.NewFork(sctx)
+	if err != nil {
+		return nil, err
+	}
+	return simulateRewards(fork.StateContext, sctx.GetBlock().Round, req)
+}
+
+func simulateRewards(
+	newContext func(*block.Block, *transaction.Transaction) cstate.StateContextI,
+	round int64,
+	req *SimulateRewardsRequest,
+) (*stakepool.RewardsSimulation, error) {
+
+	var (
+		rng = rand.New(rand.NewSource(req.Seed))
+		t   = &transaction.Transaction{ToClientID: ADDRESS}
+		b   = &block.Block{}
+	)
+	b.Round = round
+	var balances = newContext(b, t)
+
+	gn, err := getGlobalNode(balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting global node: %v", err)
+	}
+	if err = req.validate(gn); err != nil {
+		return nil, err
+	}
+
+	miners, err := getMinersList(balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting miners list: %v", err)
+	}
+	var generators []string
+	for _, mn := range miners.Nodes {
+		if !mn.Jailed {
+			generators = append(generators, mn.ID)
+		}
+	}
+
+	var providerID = req.ProviderID
+	if providerID == "" {
+		var mn = NewMinerNode()
+		mn.ID = SimulatedMinerID
+		mn.Settings.DelegateWallet = SimulatedDelegateID
+		mn.Settings.ServiceChargeRatio = req.ServiceCharge
+		mn.Settings.MaxNumDelegates = gn.MaxDelegates
+		mn.Pools[SimulatedDelegateID] = &stakepool.DelegatePool{
+			Balance:    req.Stake,
+			Status:     spenum.Active,
+			DelegateID: SimulatedDelegateID,
+		}
+		mn.TotalStaked = req.Stake
+		if err = mn.save(balances); err != nil {
+			return nil, fmt.Errorf("saving hypothetical miner: %v", err)
+		}
+		providerID = mn.ID
+		generators = append(generators, mn.ID)
+	}
+	if len(generators) == 0 {
+		return nil, errors.New("no miners to generate blocks")
+	}
+
+	before, err := getProviderNode(req.ProviderType, providerID, balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting provider %s: %v", providerID, err)
+	}
+
+	for r := round + 1; r <= round+req.Rounds; r++ {
+		b = &block.Block{}
+		b.Round = r
+		b.RoundRandomSeed = rng.Int63()
+		b.MinerID = generators[rng.Intn(len(generators))]
+		balances = newContext(b, t)
+
+		mn, err := getMinerNode(b.MinerID, balances)
+		if err != nil {
+			return nil, fmt.Errorf("getting generator %s: %v", b.MinerID, err)
+		}
+		if _, err = payBlockRewards(b, mn, req.Fees, gn, balances); err != nil {
+			return nil, fmt.Errorf("paying rewards of round %d: %v", r, err)
+		}
+		if err = mn.save(balances); err != nil {
+			return nil, fmt.Errorf("saving generator %s: %v", mn.ID, err)
+		}
+		gn.setLastRound(r)
+	}
+
+	after, err := getProviderNode(req.ProviderType, providerID, balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting provider %s: %v", providerID, err)
+	}
+	rs, err := stakepool.NewRewardsSimulation(providerID,
+		before.StakePool, after.StakePool)
+	if err != nil {
+		return nil, err
+	}
+	rs.FromRound, rs.ToRound = round+1, round+req.Rounds
+	return rs, nil
+}
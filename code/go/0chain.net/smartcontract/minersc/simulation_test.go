@@ -0,0 +1,104 @@
+package minersc
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+func TestSimulateRewards(t *testing.T) {
+	var (
+		balances = newTestBalances()
+		miners   = new(MinerNodes)
+	)
+	setConfig(t, balances)
+	for i, jailed := range []bool{false, true} {
+		var mn = NewMinerNode()
+		mn.ID = newClient(0, balances).id
+		mn.Settings.ServiceChargeRatio = 0.1
+		mn.Settings.DelegateWallet = mn.ID
+		mn.Jailed = jailed
+		mn.Pools["d0"] = &stakepool.DelegatePool{
+			Balance:    currency.Coin(10 * (i + 1)),
+			Status:     spenum.Active,
+			DelegateID: "d0",
+		}
+		require.NoError(t, mn.save(balances))
+		miners.Nodes = append(miners.Nodes, mn)
+	}
+	require.NoError(t, updateMinersList(balances, miners))
+
+	var newContext = func(b *block.Block, txn *transaction.Transaction) cstate.StateContextI {
+		balances.block, balances.txn = b, txn
+		return balances
+	}
+	var simulate = func(req *SimulateRewardsRequest) (*stakepool.RewardsSimulation, error) {
+		return simulateRewards(newContext, 100, req)
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := simulate(&SimulateRewardsRequest{ProviderType: spenum.Miner})
+		require.EqualError(t, err, "rounds should be in [1, 1000]")
+		_, err = simulate(&SimulateRewardsRequest{ProviderType: spenum.Blobber, Rounds: 10})
+		require.EqualError(t, err, "unexpected provider type blobber")
+		_, err = simulate(&SimulateRewardsRequest{ProviderType: spenum.Sharder, Rounds: 10})
+		require.EqualError(t, err, "hypothetical sharders are not supported")
+		_, err = simulate(&SimulateRewardsRequest{
+			ProviderType: spenum.Miner, Rounds: 10, Stake: 10, ServiceCharge: 0.6,
+		})
+		require.EqualError(t, err, "service charge should be in [0, 0.5]")
+	})
+
+	t.Run("registered miner", func(t *testing.T) {
+		// the jailed miner generates no blocks, the other one gets
+		// 0.7e10 * 0.1 of every block
+		rs, err := simulate(&SimulateRewardsRequest{
+			ProviderID:   miners.Nodes[0].ID,
+			ProviderType: spenum.Miner,
+			Rounds:       10,
+		})
+		require.NoError(t, err)
+		assert.EqualValues(t, 101, rs.FromRound)
+		assert.EqualValues(t, 110, rs.ToRound)
+		assert.Equal(t, currency.Coin(10), rs.Stake)
+		assert.Equal(t, currency.Coin(7e8), rs.ProviderReward)
+		assert.Equal(t, currency.Coin(7e9), rs.TotalReward)
+		assert.Equal(t, currency.Coin(6.3e9), rs.DelegateRewards["d0"])
+	})
+
+	t.Run("jailed miner", func(t *testing.T) {
+		rs, err := simulate(&SimulateRewardsRequest{
+			ProviderID:   miners.Nodes[1].ID,
+			ProviderType: spenum.Miner,
+			Rounds:       10,
+		})
+		require.NoError(t, err)
+		assert.Zero(t, rs.TotalReward)
+	})
+
+	t.Run("hypothetical miner", func(t *testing.T) {
+		rs, err := simulate(&SimulateRewardsRequest{
+			ProviderType:  spenum.Miner,
+			Stake:         100,
+			ServiceCharge: 0.2,
+			Rounds:        100,
+			Seed:          3,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, SimulatedMinerID, rs.ProviderID)
+		assert.Equal(t, currency.Coin(100), rs.Stake)
+		// both miners not jailed generate blocks
+		assert.NotZero(t, rs.TotalReward)
+		assert.Less(t, rs.TotalReward, currency.Coin(7e10))
+		assert.Equal(t, rs.TotalReward-rs.ProviderReward,
+			rs.DelegateRewards[SimulatedDelegateID])
+	})
+}
@@ -0,0 +1,518 @@
+package paychansc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/0chain/common/core/currency"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+)
+
+//msgp:ignore openRequest channelRequest updateRequest closeRequest
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+const (
+	StatusOpen    = "open"
+	StatusClosing = "closing"
+	StatusClosed  = "closed"
+)
+
+func channelKey(pscKey, channelID datastore.Key) datastore.Key {
+	return pscKey + ":channel:" + channelID
+}
+
+// NewBalanceUpdate returns a balance update of the channel to be signed by
+// its sender. The amount of an update is the total paid to the receiver, so
+// a newer update has a greater amount. The update is a transfer from the
+// channel, so it's not valid for other channels of the parties.
+func NewBalanceUpdate(channelID, receiverID string, amount currency.Coin) *state.SignedTransfer {
+	return &state.SignedTransfer{
+		Transfer: *state.NewTransfer(channelID, receiverID, amount),
+	}
+}
+
+func isPublicKeyOf(publicKey, clientID string) bool {
+	b, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return false
+	}
+	return encryption.Hash(b) == clientID
+}
+
+//
+// requests
+//
+
+type openRequest struct {
+	ReceiverID string `json:"receiver_id"`
+	// SignatureScheme and PublicKey of the sender used to verify the
+	// balance updates.
+	SignatureScheme string `json:"signature_scheme"`
+	PublicKey       string `json:"public_key"`
+}
+
+func (or *openRequest) decode(b []byte) error {
+	return json.Unmarshal(b, or)
+}
+
+func (or *openRequest) validate(t *transaction.Transaction, conf *config) error {
+	switch {
+	case or.ReceiverID == "":
+		return errors.New("missing receiver")
+	case or.ReceiverID == t.ClientID:
+		return errors.New("can't open channel to the sender")
+	case currency.Coin(t.Value) < conf.MinDeposit:
+		return fmt.Errorf("deposit is less than min deposit %v", conf.MinDeposit)
+	case !encryption.IsValidSignatureScheme(or.SignatureScheme):
+		return errors.New("invalid signature scheme")
+	case !isPublicKeyOf(or.PublicKey, t.ClientID):
+		return errors.New("public key doesn't match the sender")
+	}
+	var scheme = encryption.GetSignatureScheme(or.SignatureScheme)
+	if err := scheme.SetPublicKey(or.PublicKey); err != nil {
+		return errors.New("invalid public key")
+	}
+	return nil
+}
+
+type channelRequest struct {
+	ChannelID string `json:"channel_id"`
+}
+
+func (cr *channelRequest) decode(b []byte) error {
+	return json.Unmarshal(b, cr)
+}
+
+// updateRequest is a balance update signed by the sender of the channel,
+// no signature is needed for zero amount
+type updateRequest struct {
+	ChannelID string        `json:"channel_id"`
+	Amount    currency.Coin `json:"amount"`
+	Signature string        `json:"signature"`
+}
+
+func (ur *updateRequest) decode(b []byte) error {
+	return json.Unmarshal(b, ur)
+}
+
+func (ur *updateRequest) verify(ch *Channel) error {
+	if ur.Amount > ch.Deposit {
+		return errors.New("amount is greater than the deposit")
+	}
+	if ur.Amount == 0 && ur.Signature == "" {
+		return nil
+	}
+	var st = NewBalanceUpdate(ch.ID, ch.ReceiverID, ur.Amount)
+	st.SchemeName = ch.SignatureScheme
+	st.PublicKey = ch.SenderPublicKey
+	st.Sig = ur.Signature
+	if err := st.VerifySignature(false); err != nil {
+		return fmt.Errorf("invalid signature of the sender: %v", err)
+	}
+	return nil
+}
+
+// closeRequest is the last balance update signed by the sender and the
+// receiver of the channel
+type closeRequest struct {
+	updateRequest
+	ReceiverPublicKey string `json:"receiver_public_key"`
+	ReceiverSignature string `json:"receiver_signature"`
+}
+
+func (cr *closeRequest) decode(b []byte) error {
+	return json.Unmarshal(b, cr)
+}
+
+func (cr *closeRequest) verify(ch *Channel) error {
+	if cr.Signature == "" {
+		return errors.New("missing signature of the sender")
+	}
+	if err := cr.updateRequest.verify(ch); err != nil {
+		return err
+	}
+	if !isPublicKeyOf(cr.ReceiverPublicKey, ch.ReceiverID) {
+		return errors.New("public key doesn't match the receiver")
+	}
+	var st = NewBalanceUpdate(ch.ID, ch.ReceiverID, cr.Amount)
+	st.SchemeName = ch.SignatureScheme
+	st.PublicKey = cr.ReceiverPublicKey
+	st.Sig = cr.ReceiverSignature
+	if err := st.VerifySignature(false); err != nil {
+		return fmt.Errorf("invalid signature of the receiver: %v", err)
+	}
+	return nil
+}
+
+//
+// channel
+//
+
+// Channel is a deposit of a sender paying a receiver off chain
+type Channel struct {
+	ID              string        `json:"id"`
+	SenderID        string        `json:"sender_id"`
+	ReceiverID      string        `json:"receiver_id"`
+	SignatureScheme string        `json:"signature_scheme"`
+	SenderPublicKey string        `json:"sender_public_key"`
+	Deposit         currency.Coin `json:"deposit"`
+	Status          string        `json:"status"`
+	// Paid is the amount of the balance update the channel is closed with.
+	Paid currency.Coin `json:"paid"`
+	// Penalty is paid to the receiver from the rest of the deposit when the
+	// sender closes the channel with a stale balance update.
+	Penalty currency.Coin `json:"penalty,omitempty"`
+	// ClosedBy is the party started unilateral close, the channel can be
+	// settled from the SettleAt time.
+	ClosedBy string           `json:"closed_by,omitempty"`
+	SettleAt common.Timestamp `json:"settle_at,omitempty"`
+}
+
+func (ch *Channel) save(balances chainstate.StateContextI) (err error) {
+	_, err = balances.InsertTrieNode(channelKey(ADDRESS, ch.ID), ch)
+	return
+}
+
+func getChannel(channelID datastore.Key, balances chainstate.CommonStateContextI) (
+	ch *Channel, err error) {
+
+	ch = new(Channel)
+	if err = balances.GetTrieNode(channelKey(ADDRESS, channelID), ch); err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (ch *Channel) isParty(clientID string) bool {
+	return clientID == ch.SenderID || clientID == ch.ReceiverID
+}
+
+// settle pays the amount and the penalty to the receiver, the rest of the
+// deposit is refunded to the sender and the channel is removed
+func (ch *Channel) settle(paid, penalty currency.Coin,
+	balances chainstate.StateContextI) error {
+
+	ch.Paid, ch.Penalty = paid, penalty
+	toReceiver, err := currency.AddCoin(paid, penalty)
+	if err != nil {
+		return err
+	}
+	toSender, err := currency.MinusCoin(ch.Deposit, toReceiver)
+	if err != nil {
+		return err
+	}
+	if toReceiver > 0 {
+		err = balances.AddTransfer(state.NewTransfer(ADDRESS, ch.ReceiverID, toReceiver))
+		if err != nil {
+			return fmt.Errorf("paying receiver: %v", err)
+		}
+	}
+	if toSender > 0 {
+		err = balances.AddTransfer(state.NewTransfer(ADDRESS, ch.SenderID, toSender))
+		if err != nil {
+			return fmt.Errorf("refunding sender: %v", err)
+		}
+	}
+	if _, err = balances.DeleteTrieNode(channelKey(ADDRESS, ch.ID)); err != nil {
+		return fmt.Errorf("deleting channel: %v", err)
+	}
+	ch.Status = StatusClosed
+	return nil
+}
+
+func (ch *Channel) response() (string, error) {
+	b, err := json.Marshal(ch)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+//
+// smart contract functions
+//
+
+// open a channel to the receiver, the value of the transaction is the
+// deposit
+func (psc *PaymentChannelSmartContract) open(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	conf, err := getConfig(balances)
+	if err != nil {
+		return "", common.NewError("open_failed",
+			"can't get config: "+err.Error())
+	}
+
+	var or openRequest
+	if err = or.decode(input); err != nil {
+		return "", common.NewError("open_failed",
+			"malformed request: "+err.Error())
+	}
+	if err = or.validate(t, conf); err != nil {
+		return "", common.NewError("open_failed",
+			"invalid request: "+err.Error())
+	}
+
+	var ch = &Channel{
+		ID:              t.Hash,
+		SenderID:        t.ClientID,
+		ReceiverID:      or.ReceiverID,
+		SignatureScheme: or.SignatureScheme,
+		SenderPublicKey: or.PublicKey,
+		Deposit:         currency.Coin(t.Value),
+		Status:          StatusOpen,
+	}
+	if err = balances.AddTransfer(state.NewTransfer(t.ClientID, ADDRESS, ch.Deposit)); err != nil {
+		return "", common.NewError("open_failed",
+			"transferring deposit: "+err.Error())
+	}
+	if err = ch.save(balances); err != nil {
+		return "", common.NewError("open_failed",
+			"saving channel: "+err.Error())
+	}
+
+	resp, err := ch.response()
+	if err != nil {
+		return "", common.NewError("open_failed", err.Error())
+	}
+	return resp, nil
+}
+
+// deposit the value of the transaction to an open channel of the sender
+func (psc *PaymentChannelSmartContract) deposit(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	var cr channelRequest
+	if err := cr.decode(input); err != nil {
+		return "", common.NewError("deposit_failed",
+			"malformed request: "+err.Error())
+	}
+
+	ch, err := getChannel(cr.ChannelID, balances)
+	if err != nil {
+		return "", common.NewError("deposit_failed",
+			"can't get channel: "+err.Error())
+	}
+	switch {
+	case ch.SenderID != t.ClientID:
+		return "", common.NewError("deposit_failed",
+			"only sender of the channel can deposit")
+	case ch.Status != StatusOpen:
+		return "", common.NewError("deposit_failed", "channel is "+ch.Status)
+	case t.Value == 0:
+		return "", common.NewError("deposit_failed", "no tokens to deposit")
+	}
+
+	if err = balances.AddTransfer(state.NewTransfer(t.ClientID, ADDRESS, currency.Coin(t.Value))); err != nil {
+		return "", common.NewError("deposit_failed",
+			"transferring deposit: "+err.Error())
+	}
+	if ch.Deposit, err = currency.AddCoin(ch.Deposit, currency.Coin(t.Value)); err != nil {
+		return "", common.NewError("deposit_failed", err.Error())
+	}
+	if err = ch.save(balances); err != nil {
+		return "", common.NewError("deposit_failed",
+			"saving channel: "+err.Error())
+	}
+
+	resp, err := ch.response()
+	if err != nil {
+		return "", common.NewError("deposit_failed", err.Error())
+	}
+	return resp, nil
+}
+
+// close a channel cooperatively, it's settled at once
+func (psc *PaymentChannelSmartContract) close(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	var cr closeRequest
+	if err := cr.decode(input); err != nil {
+		return "", common.NewError("close_failed",
+			"malformed request: "+err.Error())
+	}
+
+	ch, err := getChannel(cr.ChannelID, balances)
+	if err != nil {
+		return "", common.NewError("close_failed",
+			"can't get channel: "+err.Error())
+	}
+	if !ch.isParty(t.ClientID) {
+		return "", common.NewError("close_failed",
+			"only parties of the channel can close it")
+	}
+	if err = cr.verify(ch); err != nil {
+		return "", common.NewError("close_failed",
+			"invalid request: "+err.Error())
+	}
+
+	if err = ch.settle(cr.Amount, 0, balances); err != nil {
+		return "", common.NewError("close_failed", err.Error())
+	}
+
+	resp, err := ch.response()
+	if err != nil {
+		return "", common.NewError("close_failed", err.Error())
+	}
+	return resp, nil
+}
+
+// startClose starts unilateral close of a channel with the last balance
+// update the party has, the counterparty can challenge it during the
+// challenge period
+func (psc *PaymentChannelSmartContract) startClose(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	conf, err := getConfig(balances)
+	if err != nil {
+		return "", common.NewError("start_close_failed",
+			"can't get config: "+err.Error())
+	}
+
+	var ur updateRequest
+	if err = ur.decode(input); err != nil {
+		return "", common.NewError("start_close_failed",
+			"malformed request: "+err.Error())
+	}
+
+	ch, err := getChannel(ur.ChannelID, balances)
+	if err != nil {
+		return "", common.NewError("start_close_failed",
+			"can't get channel: "+err.Error())
+	}
+	switch {
+	case !ch.isParty(t.ClientID):
+		return "", common.NewError("start_close_failed",
+			"only parties of the channel can close it")
+	case ch.Status != StatusOpen:
+		return "", common.NewError("start_close_failed", "channel is "+ch.Status)
+	}
+	if err = ur.verify(ch); err != nil {
+		return "", common.NewError("start_close_failed",
+			"invalid request: "+err.Error())
+	}
+
+	ch.Status = StatusClosing
+	ch.Paid = ur.Amount
+	ch.ClosedBy = t.ClientID
+	ch.SettleAt = balances.GetBlock().CreationDate + toSeconds(conf.ChallengePeriod)
+	if err = ch.save(balances); err != nil {
+		return "", common.NewError("start_close_failed",
+			"saving channel: "+err.Error())
+	}
+
+	resp, err := ch.response()
+	if err != nil {
+		return "", common.NewError("start_close_failed", err.Error())
+	}
+	return resp, nil
+}
+
+// challenge unilateral close with a newer balance update, the channel is
+// settled at once with the update; if the sender closed the channel with
+// the stale update, the penalty is paid to the receiver
+func (psc *PaymentChannelSmartContract) challenge(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	conf, err := getConfig(balances)
+	if err != nil {
+		return "", common.NewError("challenge_failed",
+			"can't get config: "+err.Error())
+	}
+
+	var ur updateRequest
+	if err = ur.decode(input); err != nil {
+		return "", common.NewError("challenge_failed",
+			"malformed request: "+err.Error())
+	}
+
+	ch, err := getChannel(ur.ChannelID, balances)
+	if err != nil {
+		return "", common.NewError("challenge_failed",
+			"can't get channel: "+err.Error())
+	}
+	switch {
+	case !ch.isParty(t.ClientID) || ch.ClosedBy == t.ClientID:
+		return "", common.NewError("challenge_failed",
+			"only counterparty of the close can challenge it")
+	case ch.Status != StatusClosing:
+		return "", common.NewError("challenge_failed", "channel is "+ch.Status)
+	case balances.GetBlock().CreationDate >= ch.SettleAt:
+		return "", common.NewError("challenge_failed",
+			"challenge period is over")
+	case ur.Amount <= ch.Paid:
+		return "", common.NewError("challenge_failed",
+			"balance update is not newer than the closing one")
+	}
+	if err = ur.verify(ch); err != nil {
+		return "", common.NewError("challenge_failed",
+			"invalid request: "+err.Error())
+	}
+
+	var penalty currency.Coin
+	if ch.ClosedBy == ch.SenderID {
+		rest, err := currency.MinusCoin(ch.Deposit, ur.Amount)
+		if err != nil {
+			return "", common.NewError("challenge_failed", err.Error())
+		}
+		penalty, err = currency.MultFloat64(rest, conf.Penalty)
+		if err != nil {
+			return "", common.NewError("challenge_failed", err.Error())
+		}
+	}
+
+	if err = ch.settle(ur.Amount, penalty, balances); err != nil {
+		return "", common.NewError("challenge_failed", err.Error())
+	}
+
+	resp, err := ch.response()
+	if err != nil {
+		return "", common.NewError("challenge_failed", err.Error())
+	}
+	return resp, nil
+}
+
+// settle a channel closed unilaterally after its challenge period
+func (psc *PaymentChannelSmartContract) settle(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	var cr channelRequest
+	if err := cr.decode(input); err != nil {
+		return "", common.NewError("settle_failed",
+			"malformed request: "+err.Error())
+	}
+
+	ch, err := getChannel(cr.ChannelID, balances)
+	if err != nil {
+		return "", common.NewError("settle_failed",
+			"can't get channel: "+err.Error())
+	}
+	switch {
+	case !ch.isParty(t.ClientID):
+		return "", common.NewError("settle_failed",
+			"only parties of the channel can settle it")
+	case ch.Status != StatusClosing:
+		return "", common.NewError("settle_failed", "channel is "+ch.Status)
+	case balances.GetBlock().CreationDate < ch.SettleAt:
+		return "", common.NewError("settle_failed",
+			"challenge period is not over")
+	}
+
+	if err = ch.settle(ch.Paid, 0, balances); err != nil {
+		return "", common.NewError("settle_failed", err.Error())
+	}
+
+	resp, err := ch.response()
+	if err != nil {
+		return "", common.NewError("settle_failed", err.Error())
+	}
+	return resp, nil
+}
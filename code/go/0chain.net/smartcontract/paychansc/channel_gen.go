@@ -0,0 +1,165 @@
+package paychansc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Channel) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 11
+	// string "ID"
+	o = append(o, 0x8b, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "SenderID"
+	o = append(o, 0xa8, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.SenderID)
+	// string "ReceiverID"
+	o = append(o, 0xaa, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ReceiverID)
+	// string "SignatureScheme"
+	o = append(o, 0xaf, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.SignatureScheme)
+	// string "SenderPublicKey"
+	o = append(o, 0xaf, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79)
+	o = msgp.AppendString(o, z.SenderPublicKey)
+	// string "Deposit"
+	o = append(o, 0xa7, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74)
+	o, err = z.Deposit.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Deposit")
+		return
+	}
+	// string "Status"
+	o = append(o, 0xa6, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73)
+	o = msgp.AppendString(o, z.Status)
+	// string "Paid"
+	o = append(o, 0xa4, 0x50, 0x61, 0x69, 0x64)
+	o, err = z.Paid.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Paid")
+		return
+	}
+	// string "Penalty"
+	o = append(o, 0xa7, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79)
+	o, err = z.Penalty.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Penalty")
+		return
+	}
+	// string "ClosedBy"
+	o = append(o, 0xa8, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x79)
+	o = msgp.AppendString(o, z.ClosedBy)
+	// string "SettleAt"
+	o = append(o, 0xa8, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x41, 0x74)
+	o, err = z.SettleAt.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "SettleAt")
+		return
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Channel) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "SenderID":
+			z.SenderID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SenderID")
+				return
+			}
+		case "ReceiverID":
+			z.ReceiverID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ReceiverID")
+				return
+			}
+		case "SignatureScheme":
+			z.SignatureScheme, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SignatureScheme")
+				return
+			}
+		case "SenderPublicKey":
+			z.SenderPublicKey, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SenderPublicKey")
+				return
+			}
+		case "Deposit":
+			bts, err = z.Deposit.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Deposit")
+				return
+			}
+		case "Status":
+			z.Status, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Status")
+				return
+			}
+		case "Paid":
+			bts, err = z.Paid.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Paid")
+				return
+			}
+		case "Penalty":
+			bts, err = z.Penalty.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Penalty")
+				return
+			}
+		case "ClosedBy":
+			z.ClosedBy, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ClosedBy")
+				return
+			}
+		case "SettleAt":
+			bts, err = z.SettleAt.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SettleAt")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Channel) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 9 + msgp.StringPrefixSize + len(z.SenderID) + 11 + msgp.StringPrefixSize + len(z.ReceiverID) + 16 + msgp.StringPrefixSize + len(z.SignatureScheme) + 16 + msgp.StringPrefixSize + len(z.SenderPublicKey) + 8 + z.Deposit.Msgsize() + 7 + msgp.StringPrefixSize + len(z.Status) + 5 + z.Paid.Msgsize() + 8 + z.Penalty.Msgsize() + 9 + msgp.StringPrefixSize + len(z.ClosedBy) + 9 + z.SettleAt.Msgsize()
+	return
+}
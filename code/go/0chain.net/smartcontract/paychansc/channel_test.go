@@ -0,0 +1,238 @@
+package paychansc
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/chain/state/statetest"
+	"0chain.net/chaincore/state"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+)
+
+const testDeposit = currency.Coin(10e10)
+
+// testChannel is a channel open on a test chain
+type testChannel struct {
+	*statetest.Chain
+	sender, receiver *testClient
+	id               string
+}
+
+func newTestChannel(t *testing.T) *testChannel {
+	var tc = &testChannel{
+		Chain:    newTestChain(t),
+		sender:   newTestClient(t),
+		receiver: newTestClient(t),
+	}
+	var (
+		psc      = &PaymentChannelSmartContract{}
+		tx       = statetest.NewTransaction(tc.sender.id, ADDRESS, testDeposit, 100)
+		balances = tc.ContextAt(100, tx)
+	)
+	_, err := psc.open(tx, statetest.MustEncode(t, &openRequest{
+		ReceiverID:      tc.receiver.id,
+		SignatureScheme: encryption.SignatureSchemeEd25519,
+		PublicKey:       tc.sender.scheme.GetPublicKey(),
+	}), balances)
+	require.NoError(t, err)
+	require.Equal(t, []*state.Transfer{
+		state.NewTransfer(tc.sender.id, ADDRESS, testDeposit),
+	}, balances.GetTransfers())
+	tc.id = tx.Hash
+	return tc
+}
+
+func (tc *testChannel) update(amount currency.Coin) *updateRequest {
+	return &updateRequest{
+		ChannelID: tc.id,
+		Amount:    amount,
+		Signature: tc.sender.sign(tc.T, tc.id, tc.receiver.id, amount),
+	}
+}
+
+func (tc *testChannel) call(now common.Timestamp, from *testClient,
+	f string, input interface{}) ([]*state.Transfer, error) {
+
+	var (
+		psc      = &PaymentChannelSmartContract{}
+		tx       = statetest.NewTransaction(from.id, ADDRESS, 0, now)
+		balances = tc.ContextAt(now, tx)
+	)
+	_, err := psc.Execute(tx, f, statetest.MustEncode(tc.T, input), balances)
+	return balances.GetTransfers(), err
+}
+
+func (tc *testChannel) channel() *Channel {
+	ch, err := getChannel(tc.id, tc.ContextAt(0, nil))
+	require.NoError(tc.T, err)
+	return ch
+}
+
+func TestOpen(t *testing.T) {
+	var (
+		tc       = newTestChain(t)
+		sender   = newTestClient(t)
+		receiver = newTestClient(t)
+		psc      = &PaymentChannelSmartContract{}
+	)
+
+	var request = func() *openRequest {
+		return &openRequest{
+			ReceiverID:      receiver.id,
+			SignatureScheme: encryption.SignatureSchemeEd25519,
+			PublicKey:       sender.scheme.GetPublicKey(),
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(or *openRequest)
+		value  currency.Coin
+		err    string
+	}{
+		{"no receiver", func(or *openRequest) { or.ReceiverID = "" }, testDeposit,
+			"missing receiver"},
+		{"to sender", func(or *openRequest) { or.ReceiverID = sender.id }, testDeposit,
+			"can't open channel to the sender"},
+		{"low deposit", func(or *openRequest) {}, 1,
+			"deposit is less than min deposit 10000000000"},
+		{"invalid scheme", func(or *openRequest) { or.SignatureScheme = "none" }, testDeposit,
+			"invalid signature scheme"},
+		{"other key", func(or *openRequest) { or.PublicKey = receiver.scheme.GetPublicKey() }, testDeposit,
+			"public key doesn't match the sender"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var or = request()
+			tt.modify(or)
+			var tx = statetest.NewTransaction(sender.id, ADDRESS, tt.value, 100)
+			_, err := psc.open(tx, statetest.MustEncode(t, or), tc.ContextAt(100, tx))
+			statetest.RequireErrMsg(t, err, "open_failed: invalid request: "+tt.err)
+		})
+	}
+}
+
+func TestDeposit(t *testing.T) {
+	var tc = newTestChannel(t)
+	var psc = &PaymentChannelSmartContract{}
+
+	var tx = statetest.NewTransaction(tc.receiver.id, ADDRESS, testDeposit, 110)
+	_, err := psc.deposit(tx, statetest.MustEncode(t, &channelRequest{ChannelID: tc.id}),
+		tc.ContextAt(110, tx))
+	statetest.RequireErrMsg(t, err, "deposit_failed: only sender of the channel can deposit")
+
+	tx = statetest.NewTransaction(tc.sender.id, ADDRESS, testDeposit, 110)
+	var balances = tc.ContextAt(110, tx)
+	_, err = psc.deposit(tx, statetest.MustEncode(t, &channelRequest{ChannelID: tc.id}), balances)
+	require.NoError(t, err)
+	assert.Equal(t, []*state.Transfer{
+		state.NewTransfer(tc.sender.id, ADDRESS, testDeposit),
+	}, balances.GetTransfers())
+	assert.Equal(t, 2*testDeposit, tc.channel().Deposit)
+}
+
+func TestClose(t *testing.T) {
+	var tc = newTestChannel(t)
+
+	var request = func() *closeRequest {
+		return &closeRequest{
+			updateRequest:     *tc.update(3e10),
+			ReceiverPublicKey: tc.receiver.scheme.GetPublicKey(),
+			ReceiverSignature: tc.receiver.sign(t, tc.id, tc.receiver.id, 3e10),
+		}
+	}
+
+	var cr = request()
+	cr.ReceiverSignature = tc.receiver.sign(t, tc.id, tc.receiver.id, 4e10)
+	_, err := tc.call(110, tc.sender, "close", cr)
+	statetest.RequireErrMsg(t, err, "close_failed: invalid request: invalid signature of "+
+		"the receiver: invalid_transfer_signature: Invalid signature on transfer")
+
+	cr = request()
+	cr.ReceiverPublicKey = tc.sender.scheme.GetPublicKey()
+	_, err = tc.call(110, tc.sender, "close", cr)
+	statetest.RequireErrMsg(t, err, "close_failed: invalid request: public key doesn't match the receiver")
+
+	// an update of another channel is not valid
+	cr = request()
+	cr.Signature = tc.sender.sign(t, "other", tc.receiver.id, 3e10)
+	_, err = tc.call(110, tc.receiver, "close", cr)
+	statetest.RequireErrMsg(t, err, "close_failed: invalid request: invalid signature of "+
+		"the sender: invalid_transfer_signature: Invalid signature on transfer")
+
+	transfers, err := tc.call(110, tc.receiver, "close", request())
+	require.NoError(t, err)
+	assert.Equal(t, []*state.Transfer{
+		state.NewTransfer(ADDRESS, tc.receiver.id, 3e10),
+		state.NewTransfer(ADDRESS, tc.sender.id, 7e10),
+	}, transfers)
+
+	_, err = tc.call(110, tc.receiver, "close", request())
+	statetest.RequireErrMsg(t, err, "close_failed: can't get channel: value not present")
+}
+
+func TestUnilateralClose(t *testing.T) {
+	var tc = newTestChannel(t)
+
+	_, err := tc.call(110, tc.sender, "start-close", tc.update(11e10))
+	statetest.RequireErrMsg(t, err, "start_close_failed: invalid request: amount is greater than the deposit")
+
+	// the sender closes the channel without balance updates
+	_, err = tc.call(110, tc.sender, "start-close", &updateRequest{ChannelID: tc.id})
+	require.NoError(t, err)
+	var ch = tc.channel()
+	assert.Equal(t, StatusClosing, ch.Status)
+	assert.EqualValues(t, 170, ch.SettleAt)
+
+	_, err = tc.call(120, tc.receiver, "start-close", tc.update(2e10))
+	statetest.RequireErrMsg(t, err, "start_close_failed: channel is closing")
+	_, err = tc.call(120, tc.sender, "challenge", tc.update(2e10))
+	statetest.RequireErrMsg(t, err, "challenge_failed: only counterparty of the close can challenge it")
+	_, err = tc.call(169, tc.sender, "settle", &channelRequest{ChannelID: tc.id})
+	statetest.RequireErrMsg(t, err, "settle_failed: challenge period is not over")
+	_, err = tc.call(170, tc.receiver, "challenge", tc.update(2e10))
+	statetest.RequireErrMsg(t, err, "challenge_failed: challenge period is over")
+
+	transfers, err := tc.call(170, tc.sender, "settle", &channelRequest{ChannelID: tc.id})
+	require.NoError(t, err)
+	assert.Equal(t, []*state.Transfer{
+		state.NewTransfer(ADDRESS, tc.sender.id, testDeposit),
+	}, transfers)
+}
+
+func TestChallenge(t *testing.T) {
+	t.Run("stale update of sender", func(t *testing.T) {
+		var tc = newTestChannel(t)
+
+		_, err := tc.call(110, tc.sender, "start-close", tc.update(2e10))
+		require.NoError(t, err)
+
+		_, err = tc.call(120, tc.receiver, "challenge", tc.update(2e10))
+		statetest.RequireErrMsg(t, err, "challenge_failed: balance update is not newer than the closing one")
+
+		// half of the rest of the deposit is the penalty
+		transfers, err := tc.call(120, tc.receiver, "challenge", tc.update(4e10))
+		require.NoError(t, err)
+		assert.Equal(t, []*state.Transfer{
+			state.NewTransfer(ADDRESS, tc.receiver.id, 7e10),
+			state.NewTransfer(ADDRESS, tc.sender.id, 3e10),
+		}, transfers)
+	})
+
+	t.Run("stale update of receiver", func(t *testing.T) {
+		var tc = newTestChannel(t)
+
+		_, err := tc.call(110, tc.receiver, "start-close", tc.update(2e10))
+		require.NoError(t, err)
+
+		transfers, err := tc.call(120, tc.sender, "challenge", tc.update(4e10))
+		require.NoError(t, err)
+		assert.Equal(t, []*state.Transfer{
+			state.NewTransfer(ADDRESS, tc.receiver.id, 4e10),
+			state.NewTransfer(ADDRESS, tc.sender.id, 6e10),
+		}, transfers)
+	})
+}
@@ -0,0 +1,70 @@
+package paychansc
+
+import (
+	"net/http"
+
+	"0chain.net/core/common"
+	"0chain.net/smartcontract"
+	"0chain.net/smartcontract/rest"
+)
+
+type PaymentChannelRestHandler struct {
+	rest.RestHandlerI
+}
+
+func NewPaymentChannelRestHandler(rh rest.RestHandlerI) *PaymentChannelRestHandler {
+	return &PaymentChannelRestHandler{rh}
+}
+
+func SetupRestHandler(rh rest.RestHandlerI) {
+	rh.Register(GetEndpoints(rh))
+}
+
+func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
+	prh := NewPaymentChannelRestHandler(rh)
+	paychan := "/v1/screst/" + ADDRESS
+	return []rest.Endpoint{
+		rest.MakeEndpoint(paychan+"/getChannel", common.UserRateLimit(prh.getChannel)),
+		rest.MakeEndpoint(paychan+"/paychan-config", common.UserRateLimit(prh.getConfig)),
+	}
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e4/getChannel getChannel
+// get payment channel
+//
+// parameters:
+//    + name: channel_id
+//      description: channel id, the hash of the open transaction
+//      required: true
+//      in: query
+//      type: string
+//
+// responses:
+//  200: Channel
+//  400:
+//  500:
+func (prh *PaymentChannelRestHandler) getChannel(w http.ResponseWriter, r *http.Request) {
+	var channelID = r.URL.Query().Get("channel_id")
+
+	ch, err := getChannel(channelID, prh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, smartcontract.NewErrNoResourceOrErrInternal(err, true, "can't get channel"))
+		return
+	}
+	common.Respond(w, r, ch, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e4/paychan-config paychan-config
+// get payment channel configuration settings
+//
+// responses:
+//  200: StringMap
+//  500:
+func (prh *PaymentChannelRestHandler) getConfig(w http.ResponseWriter, r *http.Request) {
+	conf, err := getConfig(prh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get config", err.Error()))
+		return
+	}
+	common.Respond(w, r, conf.getConfigMap(), nil)
+}
@@ -0,0 +1,64 @@
+package paychansc
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/logging"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"0chain.net/chaincore/chain/state/statetest"
+	configpkg "0chain.net/chaincore/config"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/encryption"
+	"0chain.net/core/viper"
+)
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+	logging.Logger = zap.NewNop()
+	configpkg.SmartContractConfig = viper.New()
+}
+
+func configureConfig() {
+	const pfx = "smart_contracts.paychansc."
+
+	configpkg.SmartContractConfig.Set(pfx+"min_deposit", 1)
+	configpkg.SmartContractConfig.Set(pfx+"challenge_period", time.Minute)
+	configpkg.SmartContractConfig.Set(pfx+"penalty", 0.5)
+	configpkg.SmartContractConfig.Set(pfx+"owner_id", statetest.Owner)
+	configpkg.SmartContractConfig.Set(pfx+"cost", "{\"1\":1, \"2\":2, \"3\":3}")
+}
+
+func newTestChain(t *testing.T) *statetest.Chain {
+	configureConfig()
+	var tc = statetest.NewChain(t)
+	require.NoError(t, InitConfig(tc.ContextAt(0, &transaction.Transaction{})))
+	return tc
+}
+
+// testClient is a client signing balance updates
+type testClient struct {
+	id     string
+	scheme encryption.SignatureScheme
+}
+
+func newTestClient(t *testing.T) *testClient {
+	var scheme = encryption.NewED25519Scheme()
+	require.NoError(t, scheme.GenerateKeys())
+	pk, err := hex.DecodeString(scheme.GetPublicKey())
+	require.NoError(t, err)
+	return &testClient{id: encryption.Hash(pk), scheme: scheme}
+}
+
+func (tc *testClient) sign(t *testing.T, channelID, receiverID string,
+	amount currency.Coin) string {
+
+	var st = NewBalanceUpdate(channelID, receiverID, amount)
+	require.NoError(t, st.Sign(tc.scheme))
+	return st.Sig
+}
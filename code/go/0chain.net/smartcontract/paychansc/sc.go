@@ -0,0 +1,132 @@
+package paychansc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+)
+
+const (
+	ADDRESS = "6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e4"
+)
+
+// PaymentChannelSmartContract keeps deposits of payment channels, a sender
+// pays a receiver off chain signing balance updates of a channel, the last
+// update is settled on chain when the channel is closed
+type PaymentChannelSmartContract struct {
+	*smartcontractinterface.SmartContract
+}
+
+func NewPaymentChannelSmartContract() smartcontractinterface.SmartContractInterface {
+	var pscCopy = &PaymentChannelSmartContract{
+		smartcontractinterface.NewSC(ADDRESS),
+	}
+	pscCopy.setSC(pscCopy.SmartContract, &smartcontract.BCContext{})
+	return pscCopy
+}
+
+func (psc *PaymentChannelSmartContract) GetHandlerStats(ctx context.Context, params url.Values) (interface{}, error) {
+	return psc.SmartContract.HandlerStats(ctx, params)
+}
+
+func (psc *PaymentChannelSmartContract) GetExecutionStats() map[string]interface{} {
+	return psc.SmartContractExecutionStats
+}
+
+func (psc *PaymentChannelSmartContract) GetName() string {
+	return "paychan"
+}
+
+func (psc *PaymentChannelSmartContract) GetAddress() string {
+	return ADDRESS
+}
+
+func (psc *PaymentChannelSmartContract) GetCost(t *transaction.Transaction, funcName string, balances chainstate.StateContextI) (int, error) {
+	conf, err := getConfig(balances)
+	if err != nil {
+		return math.MaxInt32, err
+	}
+	if conf.Cost == nil {
+		return math.MaxInt32, errors.New("can't get cost")
+	}
+	cost, ok := conf.Cost[funcName]
+	if !ok {
+		return math.MaxInt32, errors.New("no cost given for " + funcName)
+	}
+	return cost, nil
+}
+
+func (psc *PaymentChannelSmartContract) setSC(sc *smartcontractinterface.SmartContract,
+	bcContext smartcontractinterface.BCContextI) {
+
+	psc.SmartContract = sc
+
+	// open a channel depositing tokens
+	psc.SmartContractExecutionStats["open"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "open"), nil)
+
+	// add tokens to deposit of an open channel
+	psc.SmartContractExecutionStats["deposit"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "deposit"), nil)
+
+	// close a channel with the last balance update signed by both parties
+	psc.SmartContractExecutionStats["close"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "close"), nil)
+
+	// start unilateral close of a channel
+	psc.SmartContractExecutionStats["start-close"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "start-close"), nil)
+
+	// challenge unilateral close with a newer balance update
+	psc.SmartContractExecutionStats["challenge"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "challenge"), nil)
+
+	// settle a channel after the challenge period
+	psc.SmartContractExecutionStats["settle"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "settle"), nil)
+
+	psc.SmartContractExecutionStats["paychansc-update-settings"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", psc.ID, "paychansc-update-settings"), nil)
+}
+
+func (psc *PaymentChannelSmartContract) Execute(t *transaction.Transaction,
+	function string, input []byte, balances chainstate.StateContextI) (
+	resp string, err error) {
+
+	switch function {
+
+	case "open":
+		resp, err = psc.open(t, input, balances)
+	case "deposit":
+		resp, err = psc.deposit(t, input, balances)
+	case "close":
+		resp, err = psc.close(t, input, balances)
+	case "start-close":
+		resp, err = psc.startClose(t, input, balances)
+	case "challenge":
+		resp, err = psc.challenge(t, input, balances)
+	case "settle":
+		resp, err = psc.settle(t, input, balances)
+	case "paychansc-update-settings":
+		resp, err = psc.updateConfig(t, input, balances)
+	default:
+		err = common.NewError("paychan_sc_failed",
+			fmt.Sprintf("no function with %q name", function))
+	}
+	return
+}
+
+func toSeconds(dur time.Duration) common.Timestamp {
+	return common.Timestamp(dur / time.Second)
+}
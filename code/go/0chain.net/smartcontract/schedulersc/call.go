@@ -0,0 +1,540 @@
+package schedulersc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+)
+
+//msgp:ignore scheduleRequest callRequest
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+var queueKey = ADDRESS + encryption.Hash("schedulersc_queue")
+
+func callKey(sscKey, callID datastore.Key) datastore.Key {
+	return sscKey + ":call:" + callID
+}
+
+//
+// requests
+//
+
+type scheduleRequest struct {
+	// ToClientID is address of the smart contract to call.
+	ToClientID string          `json:"to_client_id"`
+	Function   string          `json:"function"`
+	Input      json.RawMessage `json:"input"`
+	// Round is the round of the first execution of a call scheduled by
+	// round, Interval is its repeat interval in rounds.
+	Round    int64 `json:"round,omitempty"`
+	Interval int64 `json:"interval,omitempty"`
+	// Timestamp is the time of the first execution of a call scheduled by
+	// time, Period is its repeat period in seconds.
+	Timestamp common.Timestamp `json:"timestamp,omitempty"`
+	Period    common.Timestamp `json:"period,omitempty"`
+	// Runs is number of executions of a repeated call, zero to repeat it
+	// while its balance covers the fee.
+	Runs int64 `json:"runs,omitempty"`
+	// Fee is paid to the miner of the block executing the call.
+	Fee currency.Coin `json:"fee"`
+}
+
+func (sr *scheduleRequest) decode(b []byte) error {
+	return json.Unmarshal(b, sr)
+}
+
+func (sr *scheduleRequest) validate(t *transaction.Transaction, conf *config,
+	balances chainstate.StateContextI) error {
+
+	var round = balances.GetBlock().Round
+	switch {
+	case (sr.Round > 0) == (sr.Timestamp > 0):
+		return errors.New("either round or timestamp must be given")
+	case sr.Round > 0 && sr.Round <= round:
+		return fmt.Errorf("round %d is not in the future", sr.Round)
+	case sr.Timestamp > 0 && sr.Timestamp <= t.CreationDate:
+		return fmt.Errorf("timestamp %d is not in the future", sr.Timestamp)
+	case sr.Round > 0 && sr.Period != 0:
+		return errors.New("period of a call scheduled by round")
+	case sr.Timestamp > 0 && sr.Interval != 0:
+		return errors.New("interval of a call scheduled by time")
+	case sr.Interval < 0 || sr.Interval > 0 && sr.Interval < conf.MinInterval:
+		return fmt.Errorf("interval is less than min interval %d", conf.MinInterval)
+	case sr.Period < 0 || sr.Period > 0 && sr.Period < toSeconds(conf.MinPeriod):
+		return fmt.Errorf("period is less than min period %v", conf.MinPeriod)
+	case sr.Runs < 0:
+		return errors.New("negative runs")
+	case sr.Runs > 1 && sr.Interval == 0 && sr.Period == 0:
+		return errors.New("runs of a call not repeated")
+	case sr.Fee < conf.MinFee:
+		return fmt.Errorf("fee is less than min fee %v", conf.MinFee)
+	case len(sr.Input) > conf.MaxInputLength:
+		return errors.New("input is too long")
+	case sr.ToClientID == ADDRESS:
+		return errors.New("can't schedule calls to the scheduler")
+	}
+
+	var target = smartcontract.GetSmartContract(sr.ToClientID)
+	if target == nil {
+		return fmt.Errorf("smart contract %s is not enabled", sr.ToClientID)
+	}
+	if _, ok := target.GetExecutionStats()[sr.Function]; !ok {
+		return fmt.Errorf("smart contract %s has no %q function",
+			sr.ToClientID, sr.Function)
+	}
+
+	var runs = sr.Runs
+	if runs == 0 {
+		runs = 1
+	}
+	prepaid, err := currency.MultCoin(sr.Fee, currency.Coin(runs))
+	if err != nil {
+		return err
+	}
+	if currency.Coin(t.Value) < prepaid {
+		return fmt.Errorf("value %v doesn't cover fees of the runs %v",
+			t.Value, prepaid)
+	}
+	return nil
+}
+
+type callRequest struct {
+	CallID string `json:"call_id"`
+}
+
+func (cr *callRequest) decode(b []byte) error {
+	return json.Unmarshal(b, cr)
+}
+
+//
+// scheduled call
+//
+
+// ScheduledCall is a prepaid call of a client to a smart contract, it's
+// executed on behalf of the client.
+type ScheduledCall struct {
+	ID         string `json:"id"`
+	ClientID   string `json:"client_id"`
+	ToClientID string `json:"to_client_id"`
+	Function   string `json:"function"`
+	Input      string `json:"input"`
+	// Round is the round of the next execution of a call scheduled by
+	// round.
+	Round    int64 `json:"round,omitempty"`
+	Interval int64 `json:"interval,omitempty"`
+	// Timestamp is the time of the next execution of a call scheduled by
+	// time.
+	Timestamp common.Timestamp `json:"timestamp,omitempty"`
+	Period    common.Timestamp `json:"period,omitempty"`
+	Runs      int64            `json:"runs"`
+	Fee       currency.Coin    `json:"fee"`
+	// Balance is the prepaid fees left.
+	Balance  currency.Coin `json:"balance"`
+	Executed int64         `json:"executed"`
+	// LastRound is the round of the last execution, LastError is its error.
+	LastRound int64  `json:"last_round,omitempty"`
+	LastError string `json:"last_error,omitempty"`
+}
+
+func (sc *ScheduledCall) save(balances chainstate.StateContextI) (err error) {
+	_, err = balances.InsertTrieNode(callKey(ADDRESS, sc.ID), sc)
+	return
+}
+
+func getCall(callID datastore.Key, balances chainstate.CommonStateContextI) (
+	sc *ScheduledCall, err error) {
+
+	sc = new(ScheduledCall)
+	if err = balances.GetTrieNode(callKey(ADDRESS, callID), sc); err != nil {
+		return nil, err
+	}
+	return
+}
+
+func (sc *ScheduledCall) isDue(round int64, now common.Timestamp) bool {
+	if sc.Round > 0 {
+		return sc.Round <= round
+	}
+	return sc.Timestamp <= now
+}
+
+// next moves the call to its next execution after the round and the time
+// given, it returns false if the call is not executed anymore
+func (sc *ScheduledCall) next(round int64, now common.Timestamp) bool {
+	switch {
+	case sc.Runs > 0 && sc.Executed >= sc.Runs:
+		return false
+	case sc.Balance < sc.Fee:
+		return false
+	case sc.Interval > 0:
+		sc.Round += ((round-sc.Round)/sc.Interval + 1) * sc.Interval
+		return true
+	case sc.Period > 0:
+		sc.Timestamp += ((now-sc.Timestamp)/sc.Period + 1) * sc.Period
+		return true
+	}
+	return false
+}
+
+// run executes the call on behalf of its client. The call is executed on a
+// fork of the state first, so a failed call doesn't fail the execute
+// transaction and doesn't change the state.
+func (sc *ScheduledCall) run(t *transaction.Transaction,
+	balances chainstate.StateContextI) (callErr, err error) {
+
+	var target = smartcontract.GetSmartContract(sc.ToClientID)
+	if target == nil {
+		return fmt.Errorf("smart contract %s is not enabled", sc.ToClientID), nil
+	}
+	caller, ok := balances.(chainstate.Caller)
+	if !ok {
+		return nil, errors.New("the state context can't execute calls")
+	}
+
+	var tx = t.Clone()
+	tx.ClientID = sc.ClientID
+	tx.ToClientID = sc.ToClientID
+	tx.Value = 0
+	tx.Fee = 0
+
+	fork, err := chainstate.NewFork(balances)
+	if err != nil {
+		return nil, err
+	}
+	_, callErr = target.Execute(tx, sc.Function, []byte(sc.Input),
+		fork.StateContext(balances.GetBlock(), tx))
+	if callErr != nil {
+		return callErr, nil
+	}
+
+	err = caller.Call(tx, func() error {
+		_, err := smartcontract.ExecuteWithStats(target, tx, sc.Function,
+			[]byte(sc.Input), balances)
+		return err
+	})
+	return nil, err
+}
+
+//
+// queue
+//
+
+type dueCall struct {
+	CallID string `json:"call_id"`
+	Due    int64  `json:"due"`
+}
+
+// queue of the scheduled calls ordered by the round or the time of the next
+// execution
+type queue struct {
+	Rounds []dueCall `json:"rounds"`
+	Times  []dueCall `json:"times"`
+}
+
+func (q *queue) size() int {
+	return len(q.Rounds) + len(q.Times)
+}
+
+func insertDue(dcs []dueCall, dc dueCall) []dueCall {
+	var i = sort.Search(len(dcs), func(i int) bool {
+		return dcs[i].Due > dc.Due
+	})
+	dcs = append(dcs, dueCall{})
+	copy(dcs[i+1:], dcs[i:])
+	dcs[i] = dc
+	return dcs
+}
+
+func removeDue(dcs []dueCall, callID string) []dueCall {
+	for i, dc := range dcs {
+		if dc.CallID == callID {
+			return append(dcs[:i], dcs[i+1:]...)
+		}
+	}
+	return dcs
+}
+
+func (q *queue) add(sc *ScheduledCall) {
+	if sc.Round > 0 {
+		q.Rounds = insertDue(q.Rounds, dueCall{CallID: sc.ID, Due: sc.Round})
+		return
+	}
+	q.Times = insertDue(q.Times, dueCall{CallID: sc.ID, Due: int64(sc.Timestamp)})
+}
+
+func (q *queue) remove(sc *ScheduledCall) {
+	if sc.Round > 0 {
+		q.Rounds = removeDue(q.Rounds, sc.ID)
+		return
+	}
+	q.Times = removeDue(q.Times, sc.ID)
+}
+
+// due returns at most max IDs of the calls due in the round and at the time
+func (q *queue) due(round int64, now common.Timestamp, max int) (ids []string) {
+	for _, dc := range q.Rounds {
+		if dc.Due > round || len(ids) >= max {
+			break
+		}
+		ids = append(ids, dc.CallID)
+	}
+	for _, dc := range q.Times {
+		if dc.Due > int64(now) || len(ids) >= max {
+			break
+		}
+		ids = append(ids, dc.CallID)
+	}
+	return
+}
+
+func (q *queue) save(balances chainstate.StateContextI) (err error) {
+	_, err = balances.InsertTrieNode(queueKey, q)
+	return
+}
+
+func getQueue(balances chainstate.CommonStateContextI) (q *queue, err error) {
+	q = new(queue)
+	err = balances.GetTrieNode(queueKey, q)
+	if err != nil && err != util.ErrValueNotPresent {
+		return nil, err
+	}
+	return q, nil
+}
+
+// DueCalls returns IDs of the calls due in the block of the round and the
+// time given, block generation adds execute transactions of the calls.
+func DueCalls(mpt util.MerklePatriciaTrieI, round int64, now common.Timestamp) (
+	[]string, error) {
+
+	var conf = new(config)
+	err := mpt.GetNodeValue(util.Path(encryption.Hash(scConfigKey(ADDRESS))), conf)
+	if err == util.ErrValueNotPresent {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var q = new(queue)
+	err = mpt.GetNodeValue(util.Path(encryption.Hash(queueKey)), q)
+	if err == util.ErrValueNotPresent {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return q.due(round, now, conf.MaxExecutions), nil
+}
+
+//
+// smart contract functions
+//
+
+// schedule a prepaid call, the value of the transaction is the prepaid fees
+func (ssc *SchedulerSmartContract) schedule(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	conf, err := getConfig(balances)
+	if err != nil {
+		return "", common.NewError("schedule_failed",
+			"can't get config: "+err.Error())
+	}
+
+	var sr scheduleRequest
+	if err = sr.decode(input); err != nil {
+		return "", common.NewError("schedule_failed",
+			"malformed request: "+err.Error())
+	}
+	if err = sr.validate(t, conf, balances); err != nil {
+		return "", common.NewError("schedule_failed",
+			"invalid request: "+err.Error())
+	}
+
+	q, err := getQueue(balances)
+	if err != nil {
+		return "", common.NewError("schedule_failed",
+			"can't get queue: "+err.Error())
+	}
+	if q.size() >= conf.MaxCalls {
+		return "", common.NewError("schedule_failed",
+			"max scheduled calls reached")
+	}
+
+	var sc = &ScheduledCall{
+		ID:         t.Hash,
+		ClientID:   t.ClientID,
+		ToClientID: sr.ToClientID,
+		Function:   sr.Function,
+		Input:      string(sr.Input),
+		Round:      sr.Round,
+		Interval:   sr.Interval,
+		Timestamp:  sr.Timestamp,
+		Period:     sr.Period,
+		Runs:       sr.Runs,
+		Fee:        sr.Fee,
+		Balance:    currency.Coin(t.Value),
+	}
+	if sc.Interval == 0 && sc.Period == 0 {
+		sc.Runs = 1
+	}
+
+	if err = balances.AddTransfer(state.NewTransfer(t.ClientID, ADDRESS, sc.Balance)); err != nil {
+		return "", common.NewError("schedule_failed",
+			"transferring prepaid fees: "+err.Error())
+	}
+
+	q.add(sc)
+	if err = q.save(balances); err != nil {
+		return "", common.NewError("schedule_failed",
+			"saving queue: "+err.Error())
+	}
+	if err = sc.save(balances); err != nil {
+		return "", common.NewError("schedule_failed",
+			"saving call: "+err.Error())
+	}
+
+	var b []byte
+	if b, err = json.Marshal(sc); err != nil {
+		return "", common.NewError("schedule_failed", err.Error())
+	}
+	return string(b), nil
+}
+
+// finish removes the call and refunds its balance to its client
+func (sc *ScheduledCall) finish(balances chainstate.StateContextI) error {
+	if sc.Balance > 0 {
+		err := balances.AddTransfer(state.NewTransfer(ADDRESS, sc.ClientID, sc.Balance))
+		if err != nil {
+			return fmt.Errorf("refunding balance: %v", err)
+		}
+		sc.Balance = 0
+	}
+	if _, err := balances.DeleteTrieNode(callKey(ADDRESS, sc.ID)); err != nil {
+		return fmt.Errorf("deleting call: %v", err)
+	}
+	return nil
+}
+
+// cancel a call, its balance is refunded
+func (ssc *SchedulerSmartContract) cancel(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	var cr callRequest
+	if err := cr.decode(input); err != nil {
+		return "", common.NewError("cancel_failed",
+			"malformed request: "+err.Error())
+	}
+
+	sc, err := getCall(cr.CallID, balances)
+	if err != nil {
+		return "", common.NewError("cancel_failed",
+			"can't get call: "+err.Error())
+	}
+	if sc.ClientID != t.ClientID {
+		return "", common.NewError("cancel_failed",
+			"only owner of the call can cancel it")
+	}
+
+	q, err := getQueue(balances)
+	if err != nil {
+		return "", common.NewError("cancel_failed",
+			"can't get queue: "+err.Error())
+	}
+	q.remove(sc)
+	if err = q.save(balances); err != nil {
+		return "", common.NewError("cancel_failed",
+			"saving queue: "+err.Error())
+	}
+
+	if err = sc.finish(balances); err != nil {
+		return "", common.NewError("cancel_failed", err.Error())
+	}
+	return "canceled", nil
+}
+
+// execute a due call, the fee of the call is paid to the client of the
+// transaction, it's the miner of the block for the execute transactions
+// added by block generation
+func (ssc *SchedulerSmartContract) execute(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	var cr callRequest
+	if err := cr.decode(input); err != nil {
+		return "", common.NewError("execute_failed",
+			"malformed request: "+err.Error())
+	}
+
+	sc, err := getCall(cr.CallID, balances)
+	if err != nil {
+		return "", common.NewError("execute_failed",
+			"can't get call: "+err.Error())
+	}
+
+	var (
+		b     = balances.GetBlock()
+		round = b.Round
+		now   = b.CreationDate
+	)
+	if !sc.isDue(round, now) {
+		return "", common.NewError("execute_failed", "call is not due")
+	}
+
+	callErr, err := sc.run(t, balances)
+	if err != nil {
+		return "", common.NewError("execute_failed",
+			"executing call: "+err.Error())
+	}
+
+	if err = balances.AddTransfer(state.NewTransfer(ADDRESS, t.ClientID, sc.Fee)); err != nil {
+		return "", common.NewError("execute_failed",
+			"paying fee: "+err.Error())
+	}
+	if sc.Balance, err = currency.MinusCoin(sc.Balance, sc.Fee); err != nil {
+		return "", common.NewError("execute_failed",
+			"paying fee: "+err.Error())
+	}
+	sc.Executed++
+	sc.LastRound = round
+	sc.LastError = ""
+	if callErr != nil {
+		sc.LastError = callErr.Error()
+	}
+
+	q, err := getQueue(balances)
+	if err != nil {
+		return "", common.NewError("execute_failed",
+			"can't get queue: "+err.Error())
+	}
+	q.remove(sc)
+	if sc.next(round, now) {
+		q.add(sc)
+		err = sc.save(balances)
+	} else {
+		err = sc.finish(balances)
+	}
+	if err != nil {
+		return "", common.NewError("execute_failed", err.Error())
+	}
+	if err = q.save(balances); err != nil {
+		return "", common.NewError("execute_failed",
+			"saving queue: "+err.Error())
+	}
+
+	var resp []byte
+	if resp, err = json.Marshal(sc); err != nil {
+		return "", common.NewError("execute_failed", err.Error())
+	}
+	return string(resp), nil
+}
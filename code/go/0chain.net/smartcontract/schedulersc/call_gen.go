@@ -0,0 +1,432 @@
+package schedulersc
+
+// Code generated by github.com/tinylib/msgp DO NOT EDIT.
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ScheduledCall) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 15
+	// string "ID"
+	o = append(o, 0x8f, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "ClientID"
+	o = append(o, 0xa8, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ClientID)
+	// string "ToClientID"
+	o = append(o, 0xaa, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ToClientID)
+	// string "Function"
+	o = append(o, 0xa8, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.Function)
+	// string "Input"
+	o = append(o, 0xa5, 0x49, 0x6e, 0x70, 0x75, 0x74)
+	o = msgp.AppendString(o, z.Input)
+	// string "Round"
+	o = append(o, 0xa5, 0x52, 0x6f, 0x75, 0x6e, 0x64)
+	o = msgp.AppendInt64(o, z.Round)
+	// string "Interval"
+	o = append(o, 0xa8, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c)
+	o = msgp.AppendInt64(o, z.Interval)
+	// string "Timestamp"
+	o = append(o, 0xa9, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
+	o, err = z.Timestamp.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Timestamp")
+		return
+	}
+	// string "Period"
+	o = append(o, 0xa6, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64)
+	o, err = z.Period.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Period")
+		return
+	}
+	// string "Runs"
+	o = append(o, 0xa4, 0x52, 0x75, 0x6e, 0x73)
+	o = msgp.AppendInt64(o, z.Runs)
+	// string "Fee"
+	o = append(o, 0xa3, 0x46, 0x65, 0x65)
+	o, err = z.Fee.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Fee")
+		return
+	}
+	// string "Balance"
+	o = append(o, 0xa7, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65)
+	o, err = z.Balance.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Balance")
+		return
+	}
+	// string "Executed"
+	o = append(o, 0xa8, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64)
+	o = msgp.AppendInt64(o, z.Executed)
+	// string "LastRound"
+	o = append(o, 0xa9, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64)
+	o = msgp.AppendInt64(o, z.LastRound)
+	// string "LastError"
+	o = append(o, 0xa9, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72)
+	o = msgp.AppendString(o, z.LastError)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ScheduledCall) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ID")
+				return
+			}
+		case "ClientID":
+			z.ClientID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ClientID")
+				return
+			}
+		case "ToClientID":
+			z.ToClientID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "ToClientID")
+				return
+			}
+		case "Function":
+			z.Function, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Function")
+				return
+			}
+		case "Input":
+			z.Input, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Input")
+				return
+			}
+		case "Round":
+			z.Round, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Round")
+				return
+			}
+		case "Interval":
+			z.Interval, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Interval")
+				return
+			}
+		case "Timestamp":
+			bts, err = z.Timestamp.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Timestamp")
+				return
+			}
+		case "Period":
+			bts, err = z.Period.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Period")
+				return
+			}
+		case "Runs":
+			z.Runs, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Runs")
+				return
+			}
+		case "Fee":
+			bts, err = z.Fee.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Fee")
+				return
+			}
+		case "Balance":
+			bts, err = z.Balance.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Balance")
+				return
+			}
+		case "Executed":
+			z.Executed, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Executed")
+				return
+			}
+		case "LastRound":
+			z.LastRound, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LastRound")
+				return
+			}
+		case "LastError":
+			z.LastError, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "LastError")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ScheduledCall) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 9 + msgp.StringPrefixSize + len(z.ClientID) + 11 + msgp.StringPrefixSize + len(z.ToClientID) + 9 + msgp.StringPrefixSize + len(z.Function) + 6 + msgp.StringPrefixSize + len(z.Input) + 6 + msgp.Int64Size + 9 + msgp.Int64Size + 10 + z.Timestamp.Msgsize() + 7 + z.Period.Msgsize() + 5 + msgp.Int64Size + 4 + z.Fee.Msgsize() + 8 + z.Balance.Msgsize() + 9 + msgp.Int64Size + 10 + msgp.Int64Size + 10 + msgp.StringPrefixSize + len(z.LastError)
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z dueCall) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "CallID"
+	o = append(o, 0x82, 0xa6, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x44)
+	o = msgp.AppendString(o, z.CallID)
+	// string "Due"
+	o = append(o, 0xa3, 0x44, 0x75, 0x65)
+	o = msgp.AppendInt64(o, z.Due)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *dueCall) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "CallID":
+			z.CallID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CallID")
+				return
+			}
+		case "Due":
+			z.Due, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Due")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z dueCall) Msgsize() (s int) {
+	s = 1 + 7 + msgp.StringPrefixSize + len(z.CallID) + 4 + msgp.Int64Size
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *queue) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Rounds"
+	o = append(o, 0x82, 0xa6, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Rounds)))
+	for za0001 := range z.Rounds {
+		// map header, size 2
+		// string "CallID"
+		o = append(o, 0x82, 0xa6, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x44)
+		o = msgp.AppendString(o, z.Rounds[za0001].CallID)
+		// string "Due"
+		o = append(o, 0xa3, 0x44, 0x75, 0x65)
+		o = msgp.AppendInt64(o, z.Rounds[za0001].Due)
+	}
+	// string "Times"
+	o = append(o, 0xa5, 0x54, 0x69, 0x6d, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Times)))
+	for za0002 := range z.Times {
+		// map header, size 2
+		// string "CallID"
+		o = append(o, 0x82, 0xa6, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x44)
+		o = msgp.AppendString(o, z.Times[za0002].CallID)
+		// string "Due"
+		o = append(o, 0xa3, 0x44, 0x75, 0x65)
+		o = msgp.AppendInt64(o, z.Times[za0002].Due)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *queue) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Rounds":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Rounds")
+				return
+			}
+			if cap(z.Rounds) >= int(zb0002) {
+				z.Rounds = (z.Rounds)[:zb0002]
+			} else {
+				z.Rounds = make([]dueCall, zb0002)
+			}
+			for za0001 := range z.Rounds {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Rounds", za0001)
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Rounds", za0001)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "CallID":
+						z.Rounds[za0001].CallID, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Rounds", za0001, "CallID")
+							return
+						}
+					case "Due":
+						z.Rounds[za0001].Due, bts, err = msgp.ReadInt64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Rounds", za0001, "Due")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Rounds", za0001)
+							return
+						}
+					}
+				}
+			}
+		case "Times":
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Times")
+				return
+			}
+			if cap(z.Times) >= int(zb0004) {
+				z.Times = (z.Times)[:zb0004]
+			} else {
+				z.Times = make([]dueCall, zb0004)
+			}
+			for za0002 := range z.Times {
+				var zb0005 uint32
+				zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Times", za0002)
+					return
+				}
+				for zb0005 > 0 {
+					zb0005--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Times", za0002)
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "CallID":
+						z.Times[za0002].CallID, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Times", za0002, "CallID")
+							return
+						}
+					case "Due":
+						z.Times[za0002].Due, bts, err = msgp.ReadInt64Bytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Times", za0002, "Due")
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Times", za0002)
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *queue) Msgsize() (s int) {
+	s = 1 + 7 + msgp.ArrayHeaderSize
+	for za0001 := range z.Rounds {
+		s += 1 + 7 + msgp.StringPrefixSize + len(z.Rounds[za0001].CallID) + 4 + msgp.Int64Size
+	}
+	s += 6 + msgp.ArrayHeaderSize
+	for za0002 := range z.Times {
+		s += 1 + 7 + msgp.StringPrefixSize + len(z.Times[za0002].CallID) + 4 + msgp.Int64Size
+	}
+	return
+}
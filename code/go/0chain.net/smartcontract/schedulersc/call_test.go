@@ -0,0 +1,232 @@
+package schedulersc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/chain/state/statetest"
+	"0chain.net/chaincore/state"
+	"0chain.net/core/common"
+)
+
+const (
+	testClient = "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745803"
+	testMiner  = "1746b06bb09f55ee01b33b5e2e055d6cc7a900cb57c0a3a5eaabb8a0e7745804"
+	testFee    = currency.Coin(1e10)
+)
+
+func (tc *testChain) schedule(round int64, value currency.Coin,
+	sr *scheduleRequest) (*ScheduledCall, error) {
+
+	var (
+		ssc      = &SchedulerSmartContract{}
+		tx       = statetest.NewTransaction(testClient, ADDRESS, value, 100)
+		balances = tc.Context(round, 100, tx)
+	)
+	resp, err := ssc.schedule(tx, statetest.MustEncode(tc.T, sr), balances)
+	if err != nil {
+		return nil, err
+	}
+	var sc ScheduledCall
+	require.NoError(tc.T, json.Unmarshal([]byte(resp), &sc))
+	require.Equal(tc.T, []*state.Transfer{
+		state.NewTransfer(testClient, ADDRESS, value),
+	}, balances.GetTransfers())
+	return &sc, nil
+}
+
+func (tc *testChain) execute(round int64, now common.Timestamp,
+	callID string) (*ScheduledCall, []*state.Transfer, error) {
+
+	var (
+		ssc      = &SchedulerSmartContract{}
+		tx       = statetest.NewTransaction(testMiner, ADDRESS, 0, now)
+		balances = tc.Context(round, now, tx)
+	)
+	resp, err := ssc.execute(tx, statetest.MustEncode(tc.T, &callRequest{CallID: callID}), balances)
+	if err != nil {
+		return nil, nil, err
+	}
+	var sc ScheduledCall
+	require.NoError(tc.T, json.Unmarshal([]byte(resp), &sc))
+	return &sc, balances.GetTransfers(), nil
+}
+
+func (tc *testChain) dueCalls(round int64, now common.Timestamp) []string {
+	ids, err := DueCalls(tc.State(), round, now)
+	require.NoError(tc.T, err)
+	return ids
+}
+
+func TestSchedule(t *testing.T) {
+	var tc = newTestChain(t)
+	withTestTarget(t)
+
+	var request = func() *scheduleRequest {
+		return &scheduleRequest{
+			ToClientID: testTargetAddress,
+			Function:   "add",
+			Input:      json.RawMessage(`{}`),
+			Round:      10,
+			Fee:        testFee,
+		}
+	}
+
+	for _, tt := range []struct {
+		name   string
+		modify func(sr *scheduleRequest)
+		value  currency.Coin
+		err    string
+	}{
+		{"no round", func(sr *scheduleRequest) { sr.Round = 0 }, testFee,
+			"either round or timestamp must be given"},
+		{"round and timestamp", func(sr *scheduleRequest) { sr.Timestamp = 200 }, testFee,
+			"either round or timestamp must be given"},
+		{"past round", func(sr *scheduleRequest) { sr.Round = 5 }, testFee,
+			"round 5 is not in the future"},
+		{"past time", func(sr *scheduleRequest) { sr.Round, sr.Timestamp = 0, 50 }, testFee,
+			"timestamp 50 is not in the future"},
+		{"short interval", func(sr *scheduleRequest) { sr.Interval = 5 }, testFee,
+			"interval is less than min interval 10"},
+		{"short period", func(sr *scheduleRequest) { sr.Round, sr.Timestamp, sr.Period = 0, 200, 30 }, testFee,
+			"period is less than min period 1m0s"},
+		{"runs not repeated", func(sr *scheduleRequest) { sr.Runs = 2 }, testFee,
+			"runs of a call not repeated"},
+		{"low fee", func(sr *scheduleRequest) { sr.Fee = 1 }, testFee,
+			"fee is less than min fee 10000000000"},
+		{"scheduler", func(sr *scheduleRequest) { sr.ToClientID = ADDRESS }, testFee,
+			"can't schedule calls to the scheduler"},
+		{"no function", func(sr *scheduleRequest) { sr.Function = "none" }, testFee,
+			`smart contract ` + testTargetAddress + ` has no "none" function`},
+		{"not prepaid", func(sr *scheduleRequest) { sr.Interval, sr.Runs = 10, 2 }, testFee,
+			"value 10000000000 doesn't cover fees of the runs 20000000000"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var sr = request()
+			tt.modify(sr)
+			_, err := tc.schedule(5, tt.value, sr)
+			statetest.RequireErrMsg(t, err, "schedule_failed: invalid request: "+tt.err)
+		})
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := tc.schedule(5, testFee, request())
+		require.NoError(t, err)
+	}
+	_, err := tc.schedule(5, testFee, request())
+	statetest.RequireErrMsg(t, err, "schedule_failed: max scheduled calls reached")
+
+	// max_executions calls are executed in a block
+	assert.Empty(t, tc.dueCalls(9, 1000))
+	assert.Len(t, tc.dueCalls(10, 1000), 2)
+}
+
+func TestExecute(t *testing.T) {
+	var tc = newTestChain(t)
+	withTestTarget(t)
+
+	sc, err := tc.schedule(5, 3*testFee, &scheduleRequest{
+		ToClientID: testTargetAddress,
+		Function:   "pay",
+		Input:      json.RawMessage(`{}`),
+		Round:      10,
+		Interval:   10,
+		Runs:       3,
+		Fee:        testFee,
+	})
+	require.NoError(t, err)
+
+	_, _, err = tc.execute(9, 100, sc.ID)
+	statetest.RequireErrMsg(t, err, "execute_failed: call is not due")
+
+	require.Equal(t, []string{sc.ID}, tc.dueCalls(10, 100))
+	executed, transfers, err := tc.execute(10, 100, sc.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, getCounter(t, tc.Context(10, 100, nil)))
+	assert.Equal(t, []*state.Transfer{
+		state.NewTransfer(testTargetAddress, testClient, 1),
+		state.NewTransfer(ADDRESS, testMiner, testFee),
+	}, transfers)
+	assert.EqualValues(t, 20, executed.Round)
+	assert.EqualValues(t, 1, executed.Executed)
+	assert.Equal(t, 2*testFee, executed.Balance)
+
+	// the missed rounds are skipped
+	assert.Empty(t, tc.dueCalls(19, 100))
+	executed, _, err = tc.execute(35, 100, sc.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 40, executed.Round)
+
+	// the last run removes the call
+	_, _, err = tc.execute(40, 100, sc.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, getCounter(t, tc.Context(40, 100, nil)))
+	assert.Empty(t, tc.dueCalls(100, 100))
+	_, err = getCall(sc.ID, tc.Context(40, 100, nil))
+	require.Equal(t, util.ErrValueNotPresent, err)
+}
+
+func TestExecuteFailedCall(t *testing.T) {
+	var tc = newTestChain(t)
+	withTestTarget(t)
+
+	sc, err := tc.schedule(5, 3*testFee, &scheduleRequest{
+		ToClientID: testTargetAddress,
+		Function:   "fail",
+		Input:      json.RawMessage(`{}`),
+		Timestamp:  200,
+		Fee:        testFee,
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, tc.dueCalls(100, 199))
+	require.Equal(t, []string{sc.ID}, tc.dueCalls(100, 200))
+
+	// the fee is paid and the rest is refunded, the changes of the call
+	// are not saved
+	executed, transfers, err := tc.execute(100, 200, sc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "failed", executed.LastError)
+	assert.Equal(t, []*state.Transfer{
+		state.NewTransfer(ADDRESS, testMiner, testFee),
+		state.NewTransfer(ADDRESS, testClient, 2*testFee),
+	}, transfers)
+	assert.EqualValues(t, 0, getCounter(t, tc.Context(100, 200, nil)))
+}
+
+func TestCancel(t *testing.T) {
+	var tc = newTestChain(t)
+	withTestTarget(t)
+
+	sc, err := tc.schedule(5, 2*testFee, &scheduleRequest{
+		ToClientID: testTargetAddress,
+		Function:   "add",
+		Input:      json.RawMessage(`{}`),
+		Round:      10,
+		Interval:   10,
+		Fee:        testFee,
+	})
+	require.NoError(t, err)
+
+	var (
+		ssc   = &SchedulerSmartContract{}
+		input = statetest.MustEncode(t, &callRequest{CallID: sc.ID})
+		tx    = statetest.NewTransaction(testMiner, ADDRESS, 0, 100)
+	)
+	_, err = ssc.cancel(tx, input, tc.Context(6, 100, tx))
+	statetest.RequireErrMsg(t, err, "cancel_failed: only owner of the call can cancel it")
+
+	tx = statetest.NewTransaction(testClient, ADDRESS, 0, 100)
+	var balances = tc.Context(6, 100, tx)
+	_, err = ssc.cancel(tx, input, balances)
+	require.NoError(t, err)
+	assert.Equal(t, []*state.Transfer{
+		state.NewTransfer(ADDRESS, testClient, 2*testFee),
+	}, balances.GetTransfers())
+	assert.Empty(t, tc.dueCalls(10, 100))
+}
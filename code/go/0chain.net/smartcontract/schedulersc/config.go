@@ -0,0 +1,320 @@
+package schedulersc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/util"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	configpkg "0chain.net/chaincore/config"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+type Setting int
+
+const (
+	MaxCalls Setting = iota
+	MaxExecutions
+	MinFee
+	MaxInputLength
+	MinInterval
+	MinPeriod
+	OwnerId
+	Cost
+)
+
+var (
+	Settings = []string{
+		"max_calls",
+		"max_executions",
+		"min_fee",
+		"max_input_length",
+		"min_interval",
+		"min_period",
+		"owner_id",
+		"cost",
+	}
+
+	costFunctions = []string{
+		"schedule",
+		"cancel",
+		"execute",
+		"schedulersc-update-settings",
+	}
+)
+
+func scConfigKey(scKey string) datastore.Key {
+	return scKey + encryption.Hash("schedulersc_config")
+}
+
+// config represents SC configurations ('schedulersc:' from sc.yaml)
+type config struct {
+	// MaxCalls is maximal number of scheduled calls.
+	MaxCalls int `json:"max_calls"`
+	// MaxExecutions is maximal number of calls executed in a block.
+	MaxExecutions int `json:"max_executions"`
+	// MinFee is minimal fee of an execution of a call.
+	MinFee         currency.Coin `json:"min_fee"`
+	MaxInputLength int           `json:"max_input_length"`
+	// MinInterval is minimal repeat interval of the calls scheduled by
+	// round, in rounds.
+	MinInterval int64 `json:"min_interval"`
+	// MinPeriod is minimal repeat period of the calls scheduled by time.
+	MinPeriod time.Duration  `json:"min_period"`
+	OwnerId   string         `json:"owner_id"`
+	Cost      map[string]int `json:"cost"`
+}
+
+func (c *config) validate() (err error) {
+	switch {
+	case c.MaxCalls < 1:
+		return errors.New("invalid max_calls (< 1)")
+	case c.MaxExecutions < 1:
+		return errors.New("invalid max_executions (< 1)")
+	case c.MinFee == 0:
+		return errors.New("invalid min_fee (0)")
+	case c.MaxInputLength < 1:
+		return errors.New("invalid max_input_length (< 1)")
+	case c.MinInterval < 1:
+		return errors.New("invalid min_interval (< 1)")
+	case toSeconds(c.MinPeriod) < 1:
+		return errors.New("invalid min_period (< 1s)")
+	case c.OwnerId == "":
+		return errors.New("owner_id is not set or empty")
+	}
+	return
+}
+
+func (c *config) Encode() (b []byte) {
+	var err error
+	if b, err = json.Marshal(c); err != nil {
+		panic(err) // must not happens
+	}
+	return
+}
+
+func (c *config) Decode(b []byte) error {
+	return json.Unmarshal(b, c)
+}
+
+func (c *config) update(changes *smartcontract.StringMap) error {
+	for key, value := range changes.Fields {
+		switch key {
+		case Settings[MaxCalls]:
+			if iValue, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxCalls = iValue
+			}
+		case Settings[MaxExecutions]:
+			if iValue, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxExecutions = iValue
+			}
+		case Settings[MinFee]:
+			if fValue, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("value %v cannot be converted to currency.Coin, "+
+					"failing to set config key %s", value, key)
+			} else {
+				fee, err := currency.ParseZCN(fValue)
+				if err != nil {
+					return err
+				}
+				c.MinFee = fee
+			}
+		case Settings[MaxInputLength]:
+			if iValue, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxInputLength = iValue
+			}
+		case Settings[MinInterval]:
+			if iValue, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int64, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MinInterval = iValue
+			}
+		case Settings[MinPeriod]:
+			if dValue, err := time.ParseDuration(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to time.Duration, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MinPeriod = dValue
+			}
+		case Settings[OwnerId]:
+			if _, err := hex.DecodeString(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int with 16 base, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.OwnerId = value
+			}
+
+		default:
+			return c.setCostValue(key, value)
+		}
+	}
+	return nil
+}
+
+func (c *config) setCostValue(key, value string) error {
+	if !strings.HasPrefix(key, Settings[Cost]) {
+		return fmt.Errorf("config setting %s not found", key)
+	}
+
+	costKey := strings.ToLower(strings.TrimPrefix(key, Settings[Cost]+"."))
+	for _, costFunction := range costFunctions {
+		if costKey != strings.ToLower(costFunction) {
+			continue
+		}
+		costValue, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("key %s, unable to convert %v to integer", key, value)
+		}
+
+		if costValue < 0 {
+			return fmt.Errorf("cost.%s contains invalid value %s", key, value)
+		}
+
+		c.Cost[costKey] = costValue
+
+		return nil
+	}
+
+	return fmt.Errorf("cost config setting %s not found", costKey)
+}
+
+func (c *config) getConfigMap() smartcontract.StringMap {
+	fields := map[string]string{
+		Settings[MaxCalls]:       fmt.Sprintf("%v", c.MaxCalls),
+		Settings[MaxExecutions]:  fmt.Sprintf("%v", c.MaxExecutions),
+		Settings[MinFee]:         fmt.Sprintf("%v", float64(c.MinFee)/1e10),
+		Settings[MaxInputLength]: fmt.Sprintf("%v", c.MaxInputLength),
+		Settings[MinInterval]:    fmt.Sprintf("%v", c.MinInterval),
+		Settings[MinPeriod]:      fmt.Sprintf("%v", c.MinPeriod),
+		Settings[OwnerId]:        fmt.Sprintf("%v", c.OwnerId),
+	}
+
+	for _, key := range costFunctions {
+		fields[fmt.Sprintf("cost.%s", key)] = fmt.Sprintf("%0v", c.Cost[strings.ToLower(key)])
+	}
+
+	return smartcontract.StringMap{
+		Fields: fields,
+	}
+}
+
+func (ssc *SchedulerSmartContract) updateConfig(
+	txn *transaction.Transaction,
+	input []byte,
+	balances chainstate.StateContextI,
+) (resp string, err error) {
+	var conf *config
+	if conf, err = getConfig(balances); err != nil {
+		return "", common.NewError("update_config",
+			"can't get config: "+err.Error())
+	}
+
+	if err := smartcontractinterface.AuthorizeWithGovernance("update_config", txn.ClientID, func() bool {
+		return conf.OwnerId == txn.ClientID
+	}); err != nil {
+		return "", err
+	}
+
+	update := &smartcontract.StringMap{}
+	if err = update.Decode(input); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := conf.update(update); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	if err := conf.validate(); err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+	if err != nil {
+		return "", common.NewError("update_config", err.Error())
+	}
+
+	return "", nil
+}
+
+//
+// helpers
+//
+
+// configurations from sc.yaml
+func getConfiguredConfig() (conf *config, err error) {
+	const prefix = "smart_contracts.schedulersc."
+
+	conf = new(config)
+
+	// short hand
+	var scconf = configpkg.SmartContractConfig
+	conf.MaxCalls = scconf.GetInt(prefix + "max_calls")
+	conf.MaxExecutions = scconf.GetInt(prefix + "max_executions")
+	conf.MinFee, err = currency.ParseZCN(scconf.GetFloat64(prefix + "min_fee"))
+	if err != nil {
+		return nil, err
+	}
+	conf.MaxInputLength = scconf.GetInt(prefix + "max_input_length")
+	conf.MinInterval = scconf.GetInt64(prefix + "min_interval")
+	conf.MinPeriod = scconf.GetDuration(prefix + "min_period")
+	conf.OwnerId = scconf.GetString(prefix + "owner_id")
+	conf.Cost = scconf.GetStringMapInt(prefix + "cost")
+
+	err = conf.validate()
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+func getConfig(
+	balances chainstate.CommonStateContextI,
+) (conf *config, err error) {
+	conf = new(config)
+	err = balances.GetTrieNode(scConfigKey(ADDRESS), conf)
+	switch err {
+	case nil:
+		return conf, nil
+	case util.ErrValueNotPresent:
+		return getConfiguredConfig()
+	default:
+		return nil, err
+	}
+}
+
+func InitConfig(balances chainstate.StateContextI) error {
+	err := balances.GetTrieNode(scConfigKey(ADDRESS), &config{})
+	if err == util.ErrValueNotPresent {
+		conf, err := getConfiguredConfig()
+		if err != nil {
+			return err
+		}
+		_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), conf)
+		return err
+	}
+	return err
+}
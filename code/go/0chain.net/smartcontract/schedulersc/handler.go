@@ -0,0 +1,70 @@
+package schedulersc
+
+import (
+	"net/http"
+
+	"0chain.net/core/common"
+	"0chain.net/smartcontract"
+	"0chain.net/smartcontract/rest"
+)
+
+type SchedulerRestHandler struct {
+	rest.RestHandlerI
+}
+
+func NewSchedulerRestHandler(rh rest.RestHandlerI) *SchedulerRestHandler {
+	return &SchedulerRestHandler{rh}
+}
+
+func SetupRestHandler(rh rest.RestHandlerI) {
+	rh.Register(GetEndpoints(rh))
+}
+
+func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
+	srh := NewSchedulerRestHandler(rh)
+	scheduler := "/v1/screst/" + ADDRESS
+	return []rest.Endpoint{
+		rest.MakeEndpoint(scheduler+"/getScheduledCall", common.UserRateLimit(srh.getScheduledCall)),
+		rest.MakeEndpoint(scheduler+"/scheduler-config", common.UserRateLimit(srh.getConfig)),
+	}
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e2/getScheduledCall getScheduledCall
+// get scheduled call
+//
+// parameters:
+//    + name: call_id
+//      description: call id, the hash of the schedule transaction
+//      required: true
+//      in: query
+//      type: string
+//
+// responses:
+//  200: ScheduledCall
+//  400:
+//  500:
+func (srh *SchedulerRestHandler) getScheduledCall(w http.ResponseWriter, r *http.Request) {
+	var callID = r.URL.Query().Get("call_id")
+
+	sc, err := getCall(callID, srh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, smartcontract.NewErrNoResourceOrErrInternal(err, true, "can't get scheduled call"))
+		return
+	}
+	common.Respond(w, r, sc, nil)
+}
+
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e2/scheduler-config scheduler-config
+// get scheduler configuration settings
+//
+// responses:
+//  200: StringMap
+//  500:
+func (srh *SchedulerRestHandler) getConfig(w http.ResponseWriter, r *http.Request) {
+	conf, err := getConfig(srh.GetQueryStateContext())
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrInternal("can't get config", err.Error()))
+		return
+	}
+	common.Respond(w, r, conf.getConfigMap(), nil)
+}
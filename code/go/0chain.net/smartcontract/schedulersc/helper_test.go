@@ -0,0 +1,118 @@
+package schedulersc
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/0chain/common/core/logging"
+	"github.com/0chain/common/core/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/chain/state/statetest"
+	configpkg "0chain.net/chaincore/config"
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/viper"
+)
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+	logging.Logger = zap.NewNop()
+	configpkg.SmartContractConfig = viper.New()
+}
+
+func configureConfig() {
+	const pfx = "smart_contracts.schedulersc."
+
+	configpkg.SmartContractConfig.Set(pfx+"max_calls", 3)
+	configpkg.SmartContractConfig.Set(pfx+"max_executions", 2)
+	configpkg.SmartContractConfig.Set(pfx+"min_fee", 1)
+	configpkg.SmartContractConfig.Set(pfx+"max_input_length", 64)
+	configpkg.SmartContractConfig.Set(pfx+"min_interval", 10)
+	configpkg.SmartContractConfig.Set(pfx+"min_period", time.Minute)
+	configpkg.SmartContractConfig.Set(pfx+"owner_id", statetest.Owner)
+	configpkg.SmartContractConfig.Set(pfx+"cost", "{\"1\":1, \"2\":2, \"3\":3}")
+}
+
+// testChain runs the transactions of a test on an in-memory state
+type testChain struct {
+	*statetest.Chain
+}
+
+func newTestChain(t *testing.T) *testChain {
+	configureConfig()
+	var tc = &testChain{Chain: statetest.NewChain(t)}
+	require.NoError(t, InitConfig(tc.ContextAt(0, &transaction.Transaction{})))
+	return tc
+}
+
+const testTargetAddress = "6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712ff"
+
+var counterKey = testTargetAddress + ":counter"
+
+// testTarget is a smart contract scheduled calls are made to
+type testTarget struct{}
+
+func withTestTarget(t *testing.T) {
+	smartcontract.ContractMap[testTargetAddress] = testTarget{}
+	t.Cleanup(func() { delete(smartcontract.ContractMap, testTargetAddress) })
+}
+
+func getCounter(t *testing.T, balances chainstate.CommonStateContextI) int64 {
+	var counter = new(dueCall)
+	err := balances.GetTrieNode(counterKey, counter)
+	if err == util.ErrValueNotPresent {
+		return 0
+	}
+	require.NoError(t, err)
+	return counter.Due
+}
+
+func (testTarget) Execute(t *transaction.Transaction, funcName string,
+	input []byte, balances chainstate.StateContextI) (string, error) {
+
+	var counter = new(dueCall)
+	err := balances.GetTrieNode(counterKey, counter)
+	if err != nil && err != util.ErrValueNotPresent {
+		return "", err
+	}
+	counter.CallID = t.ClientID
+	counter.Due++
+	if _, err = balances.InsertTrieNode(counterKey, counter); err != nil {
+		return "", err
+	}
+
+	switch funcName {
+	case "add":
+		return "added", nil
+	case "pay":
+		// pays the caller, the transfer is valid for the caller's call only
+		return "paid", balances.AddTransfer(
+			state.NewTransfer(testTargetAddress, t.ClientID, 1))
+	default:
+		return "", errors.New("failed")
+	}
+}
+
+func (testTarget) GetHandlerStats(context.Context, url.Values) (interface{}, error) {
+	return nil, nil
+}
+
+func (testTarget) GetExecutionStats() map[string]interface{} {
+	return map[string]interface{}{"add": nil, "pay": nil, "fail": nil}
+}
+
+func (testTarget) GetName() string    { return "target" }
+func (testTarget) GetAddress() string { return testTargetAddress }
+
+func (testTarget) GetCost(*transaction.Transaction, string,
+	chainstate.StateContextI) (int, error) {
+	return 0, nil
+}
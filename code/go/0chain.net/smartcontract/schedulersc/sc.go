@@ -0,0 +1,113 @@
+package schedulersc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/smartcontract"
+	"0chain.net/chaincore/smartcontractinterface"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+)
+
+const (
+	ADDRESS = "6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712e2"
+)
+
+// SchedulerSmartContract executes prepaid calls of clients to smart contracts
+// at given rounds or times, the calls are executed by block generation
+type SchedulerSmartContract struct {
+	*smartcontractinterface.SmartContract
+}
+
+func NewSchedulerSmartContract() smartcontractinterface.SmartContractInterface {
+	var sscCopy = &SchedulerSmartContract{
+		smartcontractinterface.NewSC(ADDRESS),
+	}
+	sscCopy.setSC(sscCopy.SmartContract, &smartcontract.BCContext{})
+	return sscCopy
+}
+
+func (ssc *SchedulerSmartContract) GetHandlerStats(ctx context.Context, params url.Values) (interface{}, error) {
+	return ssc.SmartContract.HandlerStats(ctx, params)
+}
+
+func (ssc *SchedulerSmartContract) GetExecutionStats() map[string]interface{} {
+	return ssc.SmartContractExecutionStats
+}
+
+func (ssc *SchedulerSmartContract) GetName() string {
+	return "scheduler"
+}
+
+func (ssc *SchedulerSmartContract) GetAddress() string {
+	return ADDRESS
+}
+
+func (ssc *SchedulerSmartContract) GetCost(t *transaction.Transaction, funcName string, balances chainstate.StateContextI) (int, error) {
+	conf, err := getConfig(balances)
+	if err != nil {
+		return math.MaxInt32, err
+	}
+	if conf.Cost == nil {
+		return math.MaxInt32, errors.New("can't get cost")
+	}
+	cost, ok := conf.Cost[funcName]
+	if !ok {
+		return math.MaxInt32, errors.New("no cost given for " + funcName)
+	}
+	return cost, nil
+}
+
+func (ssc *SchedulerSmartContract) setSC(sc *smartcontractinterface.SmartContract,
+	bcContext smartcontractinterface.BCContextI) {
+
+	ssc.SmartContract = sc
+
+	// schedule a prepaid call
+	ssc.SmartContractExecutionStats["schedule"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", ssc.ID, "schedule"), nil)
+
+	// cancel a call and refund its balance
+	ssc.SmartContractExecutionStats["cancel"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", ssc.ID, "cancel"), nil)
+
+	// execute a due call, added by block generation
+	ssc.SmartContractExecutionStats["execute"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", ssc.ID, "execute"), nil)
+
+	ssc.SmartContractExecutionStats["schedulersc-update-settings"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", ssc.ID, "schedulersc-update-settings"), nil)
+}
+
+func (ssc *SchedulerSmartContract) Execute(t *transaction.Transaction,
+	function string, input []byte, balances chainstate.StateContextI) (
+	resp string, err error) {
+
+	switch function {
+
+	case "schedule":
+		resp, err = ssc.schedule(t, input, balances)
+	case "cancel":
+		resp, err = ssc.cancel(t, input, balances)
+	case "execute":
+		resp, err = ssc.execute(t, input, balances)
+	case "schedulersc-update-settings":
+		resp, err = ssc.updateConfig(t, input, balances)
+	default:
+		err = common.NewError("scheduler_sc_failed",
+			fmt.Sprintf("no function with %q name", function))
+	}
+	return
+}
+
+func toSeconds(dur time.Duration) common.Timestamp {
+	return common.Timestamp(dur / time.Second)
+}
@@ -7,8 +7,12 @@ import (
 	sci "0chain.net/chaincore/smartcontractinterface"
 	"0chain.net/core/viper"
 	"0chain.net/smartcontract/faucetsc"
+	"0chain.net/smartcontract/governancesc"
+	"0chain.net/smartcontract/htlcsc"
 	"0chain.net/smartcontract/minersc"
 	"0chain.net/smartcontract/multisigsc"
+	"0chain.net/smartcontract/paychansc"
+	"0chain.net/smartcontract/schedulersc"
 	"0chain.net/smartcontract/storagesc"
 	"0chain.net/smartcontract/vestingsc"
 	"0chain.net/smartcontract/zcnsc"
@@ -23,6 +27,10 @@ const (
 	Miner
 	Vesting
 	Zcn
+	Governance
+	Scheduler
+	HTLC
+	PaymentChannel
 )
 
 var (
@@ -33,19 +41,27 @@ var (
 		"miner",
 		"vesting",
 		"zcn",
+		"governance",
+		"scheduler",
+		"htlc",
+		"paychan",
 	}
 
 	SCCode = map[string]SCName{
-		"faucet":   Faucet,
-		"storage":  Storage,
-		"multisig": Multisig,
-		"miner":    Miner,
-		"vesting":  Vesting,
-		"zcn":      Zcn,
+		"faucet":     Faucet,
+		"storage":    Storage,
+		"multisig":   Multisig,
+		"miner":      Miner,
+		"vesting":    Vesting,
+		"zcn":        Zcn,
+		"governance": Governance,
+		"scheduler":  Scheduler,
+		"htlc":       HTLC,
+		"paychan":    PaymentChannel,
 	}
 )
 
-//SetupSmartContracts initializes smart contract addresses
+// SetupSmartContracts initializes smart contract addresses
 func SetupSmartContracts() {
 	for _, name := range SCNames {
 		if viper.GetBool(fmt.Sprintf("server_chain.smart_contract.%v", name)) {
@@ -73,6 +89,14 @@ func newSmartContract(name string) sci.SmartContractInterface {
 		return vestingsc.NewVestingSmartContract()
 	case Zcn:
 		return zcnsc.NewZCNSmartContract()
+	case Governance:
+		return governancesc.NewGovernanceSmartContract()
+	case Scheduler:
+		return schedulersc.NewSchedulerSmartContract()
+	case HTLC:
+		return htlcsc.NewHTLCSmartContract()
+	case PaymentChannel:
+		return paychansc.NewPaymentChannelSmartContract()
 	default:
 		return nil
 	}
@@ -0,0 +1,98 @@
+package stakepool
+
+import (
+	"errors"
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"github.com/0chain/common/core/currency"
+)
+
+// SetAutoCompound switches rewards compounding of the delegate pool owned by
+// the given client.
+func (sp *StakePool) SetAutoCompound(
+	clientID string,
+	providerType spenum.Provider,
+	providerId datastore.Key,
+	autoCompound bool,
+	balances cstate.StateContextI,
+) error {
+	dp, ok := sp.Pools[clientID]
+	if !ok {
+		return fmt.Errorf("no such delegate pool: %v", clientID)
+	}
+	if dp.DelegateID != clientID {
+		return errors.New("only the delegate pool owner can change auto compound")
+	}
+	if dp.VestingPoolID != "" && autoCompound {
+		return errors.New("could not auto compound pool staked from a vesting pool")
+	}
+	if dp.Status != spenum.Active && dp.Status != spenum.Pending {
+		return fmt.Errorf("could not change auto compound of pool in %s status", dp.Status)
+	}
+	if dp.AutoCompound == autoCompound {
+		return nil
+	}
+
+	dp.AutoCompound = autoCompound
+	update := newDelegatePoolUpdate(clientID, providerId, providerType)
+	update.Updates["auto_compound"] = autoCompound
+	update.emitUpdate(balances)
+	return nil
+}
+
+// compoundRewards moves the rewards just given to auto compounding delegate
+// pools from their claimable reward to their stake. The stake of a pool can't
+// grow above the max stake of the provider, what doesn't fit stays claimable.
+// The compounded tokens are minted to the minter that holds the stakes.
+func (sp *StakePool) compoundRewards(
+	providerId string,
+	providerType spenum.Provider,
+	spUpdate *StakePoolReward,
+	balances cstate.StateContextI,
+) error {
+	var total currency.Coin
+	for _, id := range sp.OrderedPoolIds() {
+		dp := sp.Pools[id]
+		reward := spUpdate.DelegateRewards[dp.DelegateID]
+		if !dp.AutoCompound || reward == 0 || dp.Balance >= sp.Settings.MaxStake {
+			continue
+		}
+
+		compounded := reward
+		if room := sp.Settings.MaxStake - dp.Balance; compounded > room {
+			compounded = room
+		}
+
+		var err error
+		if dp.Balance, err = currency.AddCoin(dp.Balance, compounded); err != nil {
+			return err
+		}
+		dp.Reward -= compounded
+		if total, err = currency.AddCoin(total, compounded); err != nil {
+			return err
+		}
+		spUpdate.DelegateCompounded[dp.DelegateID] = compounded
+	}
+
+	if total == 0 {
+		return nil
+	}
+
+	minter, err := cstate.GetMinter(sp.Minter)
+	if err != nil {
+		return err
+	}
+	if err := balances.AddMint(&state.Mint{
+		Minter:     minter,
+		ToClientID: minter,
+		Amount:     total,
+	}); err != nil {
+		return fmt.Errorf("minting compounded rewards: %v", err)
+	}
+
+	return sp.EmitStakeEvent(providerType, providerId, balances)
+}
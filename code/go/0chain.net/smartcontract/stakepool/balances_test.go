@@ -69,7 +69,7 @@ func (tb *testBalances) GetTransaction() *transaction.Transaction {
 }
 
 // stubs
-func (tb *testBalances) GetBlock() *block.Block                      { return &block.Block{} }
+func (tb *testBalances) GetBlock() *block.Block                      { return tb.block }
 func (tb *testBalances) GetState() util.MerklePatriciaTrieI          { return nil }
 func (tb *testBalances) Validate() error                             { return nil }
 func (tb *testBalances) GetMints() []*state.Mint                     { return nil }
@@ -0,0 +1,130 @@
+package stakepool
+
+import (
+	"errors"
+	"fmt"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/smartcontract/dbs"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+//go:generate msgp -v -io=false -tests=false
+
+// CommissionConfig bounds the service charge changes of providers
+type CommissionConfig struct {
+	// NoticePeriod is number of rounds an increase of the service charge
+	// waits before it becomes effective, zero applies it instantly
+	NoticePeriod int64
+	// MaxIncrease is the max service charge increase per notice period,
+	// zero means no limit
+	MaxIncrease float64
+}
+
+// CommissionChange is a service charge increase waiting for its notice
+// period to end
+type CommissionChange struct {
+	ServiceChargeRatio float64 `json:"service_charge"`
+	ScheduledRound     int64   `json:"scheduled_round"`
+	EffectiveRound     int64   `json:"effective_round"`
+}
+
+// UpdateServiceCharge changes the service charge of the stake pool. Decreases
+// apply instantly and cancel a pending increase, increases are queued and
+// become effective after the notice period of the config.
+func (sp *StakePool) UpdateServiceCharge(
+	ratio float64,
+	providerId string,
+	providerType spenum.Provider,
+	conf CommissionConfig,
+	balances cstate.StateContextI,
+) error {
+	if ratio < 0 {
+		return errors.New("negative service charge")
+	}
+
+	round := balances.GetBlock().Round
+	sp.applyCommissionChange(round)
+
+	switch {
+	case sp.PendingCommission != nil && ratio == sp.PendingCommission.ServiceChargeRatio:
+		// already scheduled, keep the notice period running
+	case ratio <= sp.Settings.ServiceChargeRatio:
+		sp.Settings.ServiceChargeRatio = ratio
+		sp.PendingCommission = nil
+	case conf.MaxIncrease > 0 && ratio-sp.Settings.ServiceChargeRatio > conf.MaxIncrease:
+		return fmt.Errorf("service charge increase %v exceeds max increase %v",
+			ratio-sp.Settings.ServiceChargeRatio, conf.MaxIncrease)
+	case conf.NoticePeriod <= 0:
+		sp.Settings.ServiceChargeRatio = ratio
+		sp.PendingCommission = nil
+	default:
+		sp.PendingCommission = &CommissionChange{
+			ServiceChargeRatio: ratio,
+			ScheduledRound:     round,
+			EffectiveRound:     round + conf.NoticePeriod,
+		}
+	}
+
+	// the provider events overwrite the commission, so emit it on each update
+	sp.emitCommission(providerId, providerType, balances)
+	return nil
+}
+
+// InCommissionNotice reports whether a service charge increase is waiting
+// for its notice period to end. Delegates can leave the pool without the
+// min lock period penalty in the meantime.
+func (sp *StakePool) InCommissionNotice(round int64) bool {
+	return sp.PendingCommission != nil && round < sp.PendingCommission.EffectiveRound
+}
+
+// applyCommissionChange makes the pending service charge effective when its
+// notice period has passed
+func (sp *StakePool) applyCommissionChange(round int64) bool {
+	if sp.PendingCommission == nil || round < sp.PendingCommission.EffectiveRound {
+		return false
+	}
+	sp.Settings.ServiceChargeRatio = sp.PendingCommission.ServiceChargeRatio
+	sp.PendingCommission = nil
+	return true
+}
+
+// settleCommission applies a due service charge change before the rewards
+// of the round are distributed, it's a no-op without a block to take the
+// round from
+func (sp *StakePool) settleCommission(
+	providerId string,
+	providerType spenum.Provider,
+	balances cstate.StateContextI,
+) {
+	if sp.PendingCommission == nil {
+		return
+	}
+	b := balances.GetBlock()
+	if b == nil {
+		return
+	}
+	if sp.applyCommissionChange(b.Round) {
+		sp.emitCommission(providerId, providerType, balances)
+	}
+}
+
+func (sp *StakePool) emitCommission(
+	providerId string,
+	providerType spenum.Provider,
+	balances cstate.StateContextI,
+) {
+	data := dbs.ProviderCommission{
+		StakePoolId: dbs.StakePoolId{
+			ProviderId:   providerId,
+			ProviderType: providerType,
+		},
+		ServiceCharge: sp.Settings.ServiceChargeRatio,
+	}
+	if sp.PendingCommission != nil {
+		data.PendingServiceCharge = sp.PendingCommission.ServiceChargeRatio
+		data.EffectiveRound = sp.PendingCommission.EffectiveRound
+	}
+	balances.EmitEvent(event.TypeStats, event.TagUpdateProviderCommission, providerId, data)
+}
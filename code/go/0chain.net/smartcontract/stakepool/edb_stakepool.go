@@ -18,6 +18,7 @@ func NewStakePoolReward(pId string, pType spenum.Provider, rewardType spenum.Rew
 	spu.ProviderType = pType
 	spu.DelegateRewards = make(map[string]currency.Coin)
 	spu.DelegatePenalties = make(map[string]currency.Coin)
+	spu.DelegateCompounded = make(map[string]currency.Coin)
 	spu.RewardType = rewardType
 	return &spu
 }
@@ -38,9 +39,10 @@ func (spu StakePoolReward) Emit(
 
 func stakePoolRewardToStakePoolRewardEvent(spu StakePoolReward) *dbs.StakePoolReward {
 	return &dbs.StakePoolReward{
-		StakePoolId:     spu.StakePoolId,
-		Reward:          spu.Reward,
-		DelegateRewards: spu.DelegateRewards,
-		RewardType:      spu.RewardType,
+		StakePoolId:        spu.StakePoolId,
+		Reward:             spu.Reward,
+		DelegateRewards:    spu.DelegateRewards,
+		DelegateCompounded: spu.DelegateCompounded,
+		RewardType:         spu.RewardType,
 	}
 }
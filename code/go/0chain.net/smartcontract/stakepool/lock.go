@@ -80,6 +80,9 @@ func (sp *StakePool) LockPool(
 		dp.emitNew(newPoolId, providerId, providerType, balances)
 	} else {
 		// stake from the same clients
+		if dp.VestingPoolID != "" {
+			return "", fmt.Errorf("could not stake to pool staked from vesting pool %s", dp.VestingPoolID)
+		}
 		if dp.DelegateID != txn.ClientID {
 			return "", fmt.Errorf("could not stake for different delegate id: %s, txn client id: %s", dp.DelegateID, txn.ClientID)
 		}
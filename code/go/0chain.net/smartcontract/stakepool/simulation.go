@@ -0,0 +1,59 @@
+package stakepool
+
+import (
+	"github.com/0chain/common/core/currency"
+)
+
+// RewardsSimulation is the rewards a provider and its delegates would get in
+// the simulated rounds
+// swagger:model RewardsSimulation
+type RewardsSimulation struct {
+	ProviderID string `json:"provider_id"`
+	FromRound  int64  `json:"from_round"`
+	ToRound    int64  `json:"to_round"`
+	// Stake is the stake of the provider at the start of the simulation
+	Stake currency.Coin `json:"stake"`
+	// ProviderReward is the service charge of the provider
+	ProviderReward currency.Coin `json:"provider_reward"`
+	// DelegateRewards are the rewards of the delegates, including the
+	// rewards compounded to their stakes
+	DelegateRewards map[string]currency.Coin `json:"delegate_rewards"`
+	TotalReward     currency.Coin            `json:"total_reward"`
+}
+
+// NewRewardsSimulation returns the rewards the provider and the delegates got
+// between two states of the stake pool of the provider.
+func NewRewardsSimulation(providerID string, before, after *StakePool) (
+	*RewardsSimulation, error) {
+
+	var (
+		rs = &RewardsSimulation{
+			ProviderID:      providerID,
+			DelegateRewards: make(map[string]currency.Coin),
+		}
+		err error
+	)
+	if rs.Stake, err = before.stake(); err != nil {
+		return nil, err
+	}
+	if after.Reward > before.Reward {
+		rs.ProviderReward = after.Reward - before.Reward
+	}
+	rs.TotalReward = rs.ProviderReward
+
+	for id, dp := range after.Pools {
+		var now, prev = dp.Reward + dp.Balance, currency.Coin(0)
+		if p, ok := before.Pools[id]; ok {
+			prev = p.Reward + p.Balance
+		}
+		if now <= prev {
+			continue
+		}
+		var got = now - prev
+		rs.DelegateRewards[dp.DelegateID] = got
+		if rs.TotalReward, err = currency.AddCoin(rs.TotalReward, got); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
@@ -0,0 +1,28 @@
+package stakepool
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRewardsSimulation(t *testing.T) {
+	var before, after = NewStakePool(), NewStakePool()
+	before.Reward = 5
+	before.Pools["d0"] = &DelegatePool{DelegateID: "d0", Balance: 10, Reward: 1}
+	before.Pools["d1"] = &DelegatePool{DelegateID: "d1", Balance: 20}
+
+	after.Reward = 8
+	after.Pools["d0"] = &DelegatePool{DelegateID: "d0", Balance: 10, Reward: 4}
+	after.Pools["d1"] = &DelegatePool{DelegateID: "d1", Balance: 20}
+	after.Pools["d2"] = &DelegatePool{DelegateID: "d2", Balance: 7}
+
+	rs, err := NewRewardsSimulation("p0", before, after)
+	require.NoError(t, err)
+	require.Equal(t, "p0", rs.ProviderID)
+	require.Equal(t, currency.Coin(30), rs.Stake)
+	require.Equal(t, currency.Coin(3), rs.ProviderReward)
+	require.Equal(t, map[string]currency.Coin{"d0": 3, "d2": 7}, rs.DelegateRewards)
+	require.Equal(t, currency.Coin(13), rs.TotalReward)
+}
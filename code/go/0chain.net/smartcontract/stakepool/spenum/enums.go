@@ -73,6 +73,7 @@ const (
 	ChallengePassReward
 	ChallengeSlashPenalty
 	CancellationChargeReward
+	EquivocationSlashPenalty
 	NumOfRewards
 )
 
@@ -88,6 +89,7 @@ func initRewardString() {
 	rewardString[ChallengePassReward] = "challenge_pass_reward"
 	rewardString[ChallengeSlashPenalty] = "challenge_slash"
 	rewardString[CancellationChargeReward] = "cancellation_charge"
+	rewardString[EquivocationSlashPenalty] = "equivocation_slash"
 	rewardString[NumOfRewards] = "invalid"
 }
 
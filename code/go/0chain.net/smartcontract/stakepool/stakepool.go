@@ -42,7 +42,11 @@ type AbstractStakePool interface {
 		balances cstate.StateContextI) error
 	GetSettings() Settings
 	Empty(sscID, poolID, clientID string, balances cstate.StateContextI) error
+	LockVestingPool(txn *transaction.Transaction, vestingPoolID, delegateID string, value currency.Coin, providerType spenum.Provider, providerId datastore.Key, balances cstate.StateContextI) (string, error)
+	EmptyVestingPool(sscID, poolID, receiver string, balances cstate.StateContextI) error
 	UnlockPool(clientID string, providerType spenum.Provider, providerId datastore.Key, balances cstate.StateContextI) (string, error)
+	SetAutoCompound(clientID string, providerType spenum.Provider, providerId datastore.Key, autoCompound bool, balances cstate.StateContextI) error
+	InCommissionNotice(round int64) bool
 }
 
 // StakePool holds delegate information for an 0chain providers
@@ -51,6 +55,8 @@ type StakePool struct {
 	Reward   currency.Coin            `json:"rewards"`
 	Settings Settings                 `json:"settings"`
 	Minter   cstate.ApprovedMinter    `json:"minter"`
+	// PendingCommission is a service charge increase in its notice period
+	PendingCommission *CommissionChange `json:"pending_commission,omitempty"`
 }
 
 type Settings struct {
@@ -68,6 +74,10 @@ type DelegatePool struct {
 	RoundCreated int64             `json:"round_created"` // used for cool down
 	DelegateID   string            `json:"delegate_id"`
 	StakedAt     common.Timestamp  `json:"staked_at"`
+	AutoCompound bool              `json:"auto_compound"` // credit rewards to stake
+	// VestingPoolID is the vesting pool the unvested tokens of the delegate
+	// pool staked from, the tokens return to it on unlock
+	VestingPoolID string `json:"vesting_pool_id,omitempty"`
 }
 
 // swagger:model stakePoolStat
@@ -80,6 +90,8 @@ type StakePoolStat struct {
 	Penalty      currency.Coin      `json:"penalty"`  // total for all
 	Rewards      currency.Coin      `json:"rewards"`  // rewards
 	Settings     Settings           `json:"settings"` // Settings of the stake pool
+	// PendingCommission is a service charge increase in its notice period
+	PendingCommission *CommissionChange `json:"pending_commission,omitempty"`
 }
 
 type DelegatePoolStat struct {
@@ -95,6 +107,7 @@ type DelegatePoolStat struct {
 	TotalPenalty currency.Coin `json:"total_penalty"`
 	Status       string        `json:"status"`
 	RoundCreated int64         `json:"round_created"`
+	AutoCompound bool          `json:"auto_compound"`
 }
 
 // swagger:model userPoolStat
@@ -115,6 +128,12 @@ func ToProviderStakePoolStats(provider *event.Provider, delegatePools []event.De
 		MaxNumDelegates:    provider.NumDelegates,
 		ServiceChargeRatio: provider.ServiceCharge,
 	}
+	if provider.ServiceChargeEffectiveRound > 0 {
+		spStat.PendingCommission = &CommissionChange{
+			ServiceChargeRatio: provider.PendingServiceCharge,
+			EffectiveRound:     provider.ServiceChargeEffectiveRound,
+		}
+	}
 	spStat.Rewards = provider.Rewards.TotalRewards
 	for _, dp := range delegatePools {
 		if spenum.PoolStatus(dp.Status) == spenum.Deleted {
@@ -125,6 +144,7 @@ func ToProviderStakePoolStats(provider *event.Provider, delegatePools []event.De
 			DelegateID:   dp.DelegateID,
 			Status:       spenum.PoolStatus(dp.Status).String(),
 			RoundCreated: dp.RoundCreated,
+			AutoCompound: dp.AutoCompound,
 		}
 		dpStats.Balance = dp.Balance
 
@@ -307,6 +327,7 @@ func (sp *StakePool) DistributeRewardsRandN(
 	if value == 0 {
 		return nil // nothing to move
 	}
+	sp.settleCommission(providerId, providerType, balances)
 	var spUpdate = NewStakePoolReward(providerId, providerType, rewardType)
 
 	// if no stake pools pay all rewards to the provider
@@ -387,6 +408,9 @@ func (sp *StakePool) DistributeRewardsRandN(
 			return err
 		}
 	}
+	if err := sp.compoundRewards(providerId, providerType, spUpdate, balances); err != nil {
+		return err
+	}
 	if err := spUpdate.Emit(event.TagStakePoolReward, balances); err != nil {
 		return err
 	}
@@ -451,6 +475,7 @@ func (sp *StakePool) DistributeRewards(
 	if value == 0 {
 		return nil // nothing to move
 	}
+	sp.settleCommission(providerId, providerType, balances)
 	var spUpdate = NewStakePoolReward(providerId, providerType, rewardType)
 
 	// if no stake pools pay all rewards to the provider
@@ -530,6 +555,9 @@ func (sp *StakePool) DistributeRewards(
 			return err
 		}
 	}
+	if err := sp.compoundRewards(providerId, providerType, spUpdate, balances); err != nil {
+		return err
+	}
 	if err := spUpdate.Emit(event.TagStakePoolReward, balances); err != nil {
 		return err
 	}
@@ -608,6 +636,13 @@ func equallyDistributeRewards(coins currency.Coin, pools []*DelegatePool, spUpda
 type StakePoolRequest struct {
 	ProviderType spenum.Provider `json:"provider_type,omitempty"`
 	ProviderID   string          `json:"provider_id,omitempty"`
+	// AutoCompound switches rewards compounding of the delegate pool when set,
+	// a lock request without tokens only updates the flag of an existing pool
+	AutoCompound *bool `json:"auto_compound,omitempty"`
+	// DelegateID of the delegate pool to unlock, the vesting pool owner can
+	// unlock delegate pools staked from the vesting pool, the client of the
+	// transaction by default
+	DelegateID string `json:"delegate_id,omitempty"`
 }
 
 func (spr *StakePoolRequest) Encode() []byte {
@@ -634,6 +669,18 @@ func StakePoolLock(t *transaction.Transaction, input []byte, balances cstate.Sta
 			"can't get stake pool: %v", err)
 	}
 
+	if t.Value == 0 && spr.AutoCompound != nil {
+		if err := sp.SetAutoCompound(t.ClientID, spr.ProviderType, spr.ProviderID, *spr.AutoCompound, balances); err != nil {
+			return "", common.NewErrorf("stake_pool_lock_failed",
+				"setting auto compound: %v", err)
+		}
+		if err = sp.Save(spr.ProviderType, spr.ProviderID, balances); err != nil {
+			return "", common.NewErrorf("stake_pool_lock_failed",
+				"saving stake pool: %v", err)
+		}
+		return toJson(sp.GetPools()[t.ClientID]), nil
+	}
+
 	if t.Value < sp.GetSettings().MinStake {
 		return "", common.NewError("stake_pool_lock_failed",
 			fmt.Sprintf("too small stake to lock: %v < %v", t.Value, sp.GetSettings().MinStake))
@@ -656,6 +703,13 @@ func StakePoolLock(t *transaction.Transaction, input []byte, balances cstate.Sta
 			"stake pool digging error: %v", err)
 	}
 
+	if spr.AutoCompound != nil {
+		if err := sp.SetAutoCompound(t.ClientID, spr.ProviderType, spr.ProviderID, *spr.AutoCompound, balances); err != nil {
+			return "", common.NewErrorf("stake_pool_lock_failed",
+				"setting auto compound: %v", err)
+		}
+	}
+
 	if err = sp.Save(spr.ProviderType, spr.ProviderID, balances); err != nil {
 		return "", common.NewErrorf("stake_pool_lock_failed",
 			"saving stake pool: %v", err)
@@ -688,13 +742,22 @@ func StakePoolUnlock(t *transaction.Transaction, input []byte, balances cstate.S
 	if err != nil {
 		return "", err
 	}
-	dp, ok := sp.GetPools()[t.ClientID]
+	var delegateID = t.ClientID
+	if spr.DelegateID != "" {
+		delegateID = spr.DelegateID
+	}
+	dp, ok := sp.GetPools()[delegateID]
 	if !ok {
-		return "", common.NewErrorf("stake_pool_unlock_failed", "no such delegate pool: %v ", t.ClientID)
+		return "", common.NewErrorf("stake_pool_unlock_failed", "no such delegate pool: %v ", delegateID)
+	}
+	if dp.VestingPoolID == "" && delegateID != t.ClientID {
+		return "", common.NewError("stake_pool_unlock_failed",
+			"only the delegate can unlock the delegate pool")
 	}
 
-	// if StakeAt has valid value and lock period is less than MinLockPeriod
-	if dp.StakedAt > 0 {
+	// if StakeAt has valid value and lock period is less than MinLockPeriod,
+	// unless the provider is raising its service charge
+	if dp.StakedAt > 0 && !sp.InCommissionNotice(balances.GetBlock().Round) {
 		stakedAt := common.ToTime(dp.StakedAt)
 		minLockPeriod := config.SmartContractConfig.GetDuration("stakepool.min_lock_period")
 		if !stakedAt.Add(minLockPeriod).Before(time.Now()) {
@@ -702,13 +765,30 @@ func StakePoolUnlock(t *transaction.Transaction, input []byte, balances cstate.S
 		}
 	}
 
-	err = sp.Empty(t.ToClientID, t.ClientID, t.ClientID, balances)
+	if dp.VestingPoolID != "" {
+		// staked from a vesting pool, the vesting SC decides where the
+		// tokens go
+		if vestingStaker == nil {
+			return "", common.NewError("stake_pool_unlock_failed",
+				"no vesting SC to unlock the delegate pool")
+		}
+		var receiver string
+		receiver, err = UnstakeVesting(t.ClientID, dp, spr.ProviderType,
+			spr.ProviderID, balances)
+		if err != nil {
+			return "", common.NewErrorf("stake_pool_unlock_failed",
+				"unstaking vesting pool: %v", err)
+		}
+		err = sp.EmptyVestingPool(t.ToClientID, delegateID, receiver, balances)
+	} else {
+		err = sp.Empty(t.ToClientID, t.ClientID, t.ClientID, balances)
+	}
 	if err != nil {
 		return "", common.NewErrorf("stake_pool_unlock_failed",
 			"unlocking tokens: %v", err)
 	}
 
-	output, err := sp.UnlockPool(t.ClientID, spr.ProviderType, spr.ProviderID, balances)
+	output, err := sp.UnlockPool(delegateID, spr.ProviderType, spr.ProviderID, balances)
 	if err != nil {
 		return "", common.NewErrorf("stake_pool_unlock_failed", "%v", err)
 	}
@@ -9,9 +9,9 @@ import (
 // MarshalMsg implements msgp.Marshaler
 func (z *DelegatePool) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 8
 	// string "Balance"
-	o = append(o, 0x86, 0xa7, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65)
+	o = append(o, 0x88, 0xa7, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65)
 	o, err = z.Balance.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "Balance")
@@ -44,6 +44,12 @@ func (z *DelegatePool) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "StakedAt")
 		return
 	}
+	// string "AutoCompound"
+	o = append(o, 0xac, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64)
+	o = msgp.AppendBool(o, z.AutoCompound)
+	// string "VestingPoolID"
+	o = append(o, 0xad, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x44)
+	o = msgp.AppendString(o, z.VestingPoolID)
 	return
 }
 
@@ -101,6 +107,18 @@ func (z *DelegatePool) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "StakedAt")
 				return
 			}
+		case "AutoCompound":
+			z.AutoCompound, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AutoCompound")
+				return
+			}
+		case "VestingPoolID":
+			z.VestingPoolID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "VestingPoolID")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -115,16 +133,16 @@ func (z *DelegatePool) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DelegatePool) Msgsize() (s int) {
-	s = 1 + 8 + z.Balance.Msgsize() + 7 + z.Reward.Msgsize() + 7 + z.Status.Msgsize() + 13 + msgp.Int64Size + 11 + msgp.StringPrefixSize + len(z.DelegateID) + 9 + z.StakedAt.Msgsize()
+	s = 1 + 8 + z.Balance.Msgsize() + 7 + z.Reward.Msgsize() + 7 + z.Status.Msgsize() + 13 + msgp.Int64Size + 11 + msgp.StringPrefixSize + len(z.DelegateID) + 9 + z.StakedAt.Msgsize() + 13 + msgp.BoolSize + 14 + msgp.StringPrefixSize + len(z.VestingPoolID)
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *DelegatePoolStat) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 11
+	// map header, size 12
 	// string "ID"
-	o = append(o, 0x8b, 0xa2, 0x49, 0x44)
+	o = append(o, 0x8c, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "Balance"
 	o = append(o, 0xa7, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65)
@@ -176,6 +194,9 @@ func (z *DelegatePoolStat) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "RoundCreated"
 	o = append(o, 0xac, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64)
 	o = msgp.AppendInt64(o, z.RoundCreated)
+	// string "AutoCompound"
+	o = append(o, 0xac, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64)
+	o = msgp.AppendBool(o, z.AutoCompound)
 	return
 }
 
@@ -263,6 +284,12 @@ func (z *DelegatePoolStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "RoundCreated")
 				return
 			}
+		case "AutoCompound":
+			z.AutoCompound, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "AutoCompound")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -277,7 +304,7 @@ func (z *DelegatePoolStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *DelegatePoolStat) Msgsize() (s int) {
-	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 8 + z.Balance.Msgsize() + 11 + msgp.StringPrefixSize + len(z.DelegateID) + 8 + z.Rewards.Msgsize() + 8 + msgp.BoolSize + 11 + msgp.StringPrefixSize + len(z.ProviderId) + 13 + z.ProviderType.Msgsize() + 12 + z.TotalReward.Msgsize() + 13 + z.TotalPenalty.Msgsize() + 7 + msgp.StringPrefixSize + len(z.Status) + 13 + msgp.Int64Size
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 8 + z.Balance.Msgsize() + 11 + msgp.StringPrefixSize + len(z.DelegateID) + 8 + z.Rewards.Msgsize() + 8 + msgp.BoolSize + 11 + msgp.StringPrefixSize + len(z.ProviderId) + 13 + z.ProviderType.Msgsize() + 12 + z.TotalReward.Msgsize() + 13 + z.TotalPenalty.Msgsize() + 7 + msgp.StringPrefixSize + len(z.Status) + 13 + msgp.Int64Size + 13 + msgp.BoolSize
 	return
 }
 
@@ -380,9 +407,9 @@ func (z *Settings) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *StakePool) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 4
+	// map header, size 5
 	// string "Pools"
-	o = append(o, 0x84, 0xa5, 0x50, 0x6f, 0x6f, 0x6c, 0x73)
+	o = append(o, 0x85, 0xa5, 0x50, 0x6f, 0x6f, 0x6c, 0x73)
 	o = msgp.AppendMapHeader(o, uint32(len(z.Pools)))
 	keys_za0001 := make([]string, 0, len(z.Pools))
 	for k := range z.Pools {
@@ -423,6 +450,17 @@ func (z *StakePool) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Minter")
 		return
 	}
+	// string "PendingCommission"
+	o = append(o, 0xb1, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e)
+	if z.PendingCommission == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.PendingCommission.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "PendingCommission")
+			return
+		}
+	}
 	return
 }
 
@@ -503,6 +541,23 @@ func (z *StakePool) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Minter")
 				return
 			}
+		case "PendingCommission":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.PendingCommission = nil
+			} else {
+				if z.PendingCommission == nil {
+					z.PendingCommission = new(CommissionChange)
+				}
+				bts, err = z.PendingCommission.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "PendingCommission")
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -529,16 +584,21 @@ func (z *StakePool) Msgsize() (s int) {
 			}
 		}
 	}
-	s += 7 + z.Reward.Msgsize() + 9 + z.Settings.Msgsize() + 7 + z.Minter.Msgsize()
+	s += 7 + z.Reward.Msgsize() + 9 + z.Settings.Msgsize() + 7 + z.Minter.Msgsize() + 18
+	if z.PendingCommission == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.PendingCommission.Msgsize()
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *StakePoolRequest) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 4
 	// string "ProviderType"
-	o = append(o, 0x82, 0xac, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65)
+	o = append(o, 0x84, 0xac, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65)
 	o, err = z.ProviderType.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "ProviderType")
@@ -547,6 +607,16 @@ func (z *StakePoolRequest) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "ProviderID"
 	o = append(o, 0xaa, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ProviderID)
+	// string "AutoCompound"
+	o = append(o, 0xac, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x75, 0x6e, 0x64)
+	if z.AutoCompound == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o = msgp.AppendBool(o, *z.AutoCompound)
+	}
+	// string "DelegateID"
+	o = append(o, 0xaa, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x44)
+	o = msgp.AppendString(o, z.DelegateID)
 	return
 }
 
@@ -580,6 +650,29 @@ func (z *StakePoolRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "ProviderID")
 				return
 			}
+		case "AutoCompound":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.AutoCompound = nil
+			} else {
+				if z.AutoCompound == nil {
+					z.AutoCompound = new(bool)
+				}
+				*z.AutoCompound, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AutoCompound")
+					return
+				}
+			}
+		case "DelegateID":
+			z.DelegateID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DelegateID")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -594,16 +687,22 @@ func (z *StakePoolRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *StakePoolRequest) Msgsize() (s int) {
-	s = 1 + 13 + z.ProviderType.Msgsize() + 11 + msgp.StringPrefixSize + len(z.ProviderID)
+	s = 1 + 13 + z.ProviderType.Msgsize() + 11 + msgp.StringPrefixSize + len(z.ProviderID) + 13
+	if z.AutoCompound == nil {
+		s += msgp.NilSize
+	} else {
+		s += msgp.BoolSize
+	}
+	s += 11 + msgp.StringPrefixSize + len(z.DelegateID)
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *StakePoolStat) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 8
+	// map header, size 9
 	// string "ID"
-	o = append(o, 0x88, 0xa2, 0x49, 0x44)
+	o = append(o, 0x89, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "Balance"
 	o = append(o, 0xa7, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65)
@@ -657,6 +756,17 @@ func (z *StakePoolStat) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Settings")
 		return
 	}
+	// string "PendingCommission"
+	o = append(o, 0xb1, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e)
+	if z.PendingCommission == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.PendingCommission.MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "PendingCommission")
+			return
+		}
+	}
 	return
 }
 
@@ -739,6 +849,23 @@ func (z *StakePoolStat) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Settings")
 				return
 			}
+		case "PendingCommission":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.PendingCommission = nil
+			} else {
+				if z.PendingCommission == nil {
+					z.PendingCommission = new(CommissionChange)
+				}
+				bts, err = z.PendingCommission.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "PendingCommission")
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -757,7 +884,12 @@ func (z *StakePoolStat) Msgsize() (s int) {
 	for za0001 := range z.Delegate {
 		s += z.Delegate[za0001].Msgsize()
 	}
-	s += 8 + z.Penalty.Msgsize() + 8 + z.Rewards.Msgsize() + 9 + z.Settings.Msgsize()
+	s += 8 + z.Penalty.Msgsize() + 8 + z.Rewards.Msgsize() + 9 + z.Settings.Msgsize() + 18
+	if z.PendingCommission == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.PendingCommission.Msgsize()
+	}
 	return
 }
 
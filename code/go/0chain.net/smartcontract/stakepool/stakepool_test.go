@@ -9,8 +9,14 @@ import (
 	"0chain.net/chaincore/chain/state"
 	"0chain.net/smartcontract/stakepool/spenum"
 	"github.com/0chain/common/core/currency"
+	"github.com/0chain/common/core/logging"
+	"go.uber.org/zap"
 )
 
+func init() {
+	logging.Logger = zap.NewNop()
+}
+
 func TestStakePool_DistributeRewards(t *testing.T) {
 	providerID := "provider_id"
 	providerType := spenum.Blobber
@@ -182,3 +188,229 @@ func TestStakePool_DistributeRewards(t *testing.T) {
 		})
 	}
 }
+
+func TestStakePool_DistributeRewardsAutoCompound(t *testing.T) {
+	const (
+		providerID   = "provider_id"
+		providerType = spenum.Blobber
+	)
+
+	tests := []struct {
+		name           string
+		value          currency.Coin
+		maxStake       currency.Coin
+		autoCompound   []bool
+		delegateBal    []currency.Coin
+		wantBalance    []currency.Coin
+		wantReward     []currency.Coin
+		wantCompounded map[string]currency.Coin
+	}{
+		{
+			name:           "auto compound disabled",
+			value:          100,
+			maxStake:       1000,
+			autoCompound:   []bool{false, false},
+			delegateBal:    []currency.Coin{50, 50},
+			wantBalance:    []currency.Coin{50, 50},
+			wantReward:     []currency.Coin{50, 50},
+			wantCompounded: map[string]currency.Coin{},
+		},
+		{
+			name:           "rewards credited to stake",
+			value:          100,
+			maxStake:       1000,
+			autoCompound:   []bool{true, false},
+			delegateBal:    []currency.Coin{50, 50},
+			wantBalance:    []currency.Coin{100, 50},
+			wantReward:     []currency.Coin{0, 50},
+			wantCompounded: map[string]currency.Coin{"delegate_0": 50},
+		},
+		{
+			name:           "max stake overflow left claimable",
+			value:          100,
+			maxStake:       80,
+			autoCompound:   []bool{true, true},
+			delegateBal:    []currency.Coin{50, 80},
+			wantBalance:    []currency.Coin{80, 80},
+			wantReward:     []currency.Coin{20, 50},
+			wantCompounded: map[string]currency.Coin{"delegate_0": 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				balances = newTestBalances(t, false)
+				sp       = NewStakePool()
+			)
+			sp.Settings.MaxStake = tt.maxStake
+			for i := range tt.delegateBal {
+				delegateId := "delegate_" + strconv.Itoa(i)
+				sp.Pools[delegateId] = &DelegatePool{
+					DelegateID:   delegateId,
+					Balance:      tt.delegateBal[i],
+					AutoCompound: tt.autoCompound[i],
+				}
+			}
+
+			spUpdate := NewStakePoolReward(providerID, providerType, spenum.BlockRewardBlobber)
+			for id, dp := range sp.Pools {
+				reward := tt.value / currency.Coin(len(sp.Pools))
+				dp.Reward += reward
+				spUpdate.DelegateRewards[id] = reward
+			}
+
+			err := sp.compoundRewards(providerID, providerType, spUpdate, balances)
+			require.NoError(t, err)
+			for i := range tt.delegateBal {
+				dp := sp.Pools["delegate_"+strconv.Itoa(i)]
+				require.EqualValues(t, tt.wantBalance[i], dp.Balance)
+				require.EqualValues(t, tt.wantReward[i], dp.Reward)
+			}
+			require.EqualValues(t, tt.wantCompounded, spUpdate.DelegateCompounded)
+		})
+	}
+}
+
+func TestStakePool_SetAutoCompound(t *testing.T) {
+	balances := newTestBalances(t, false)
+	sp := NewStakePool()
+	sp.Pools["delegate"] = &DelegatePool{
+		DelegateID: "delegate",
+		Status:     spenum.Active,
+	}
+
+	require.NoError(t, sp.SetAutoCompound("delegate", spenum.Miner, "provider_id", true, balances))
+	require.True(t, sp.Pools["delegate"].AutoCompound)
+
+	err := sp.SetAutoCompound("other", spenum.Miner, "provider_id", true, balances)
+	require.EqualError(t, err, "no such delegate pool: other")
+
+	sp.Pools["delegate"].Status = spenum.Deleted
+	err = sp.SetAutoCompound("delegate", spenum.Miner, "provider_id", false, balances)
+	require.Error(t, err)
+	require.True(t, sp.Pools["delegate"].AutoCompound)
+}
+
+func TestStakePool_UpdateServiceCharge(t *testing.T) {
+	conf := CommissionConfig{NoticePeriod: 100, MaxIncrease: 0.1}
+
+	type want struct {
+		serviceCharge float64
+		pending       *CommissionChange
+		errMsg        string
+	}
+
+	tests := []struct {
+		name    string
+		current float64
+		pending *CommissionChange
+		ratio   float64
+		conf    CommissionConfig
+		want    want
+	}{
+		{
+			name:    "increase is scheduled",
+			current: 0.1,
+			ratio:   0.15,
+			conf:    conf,
+			want: want{
+				serviceCharge: 0.1,
+				pending:       &CommissionChange{ServiceChargeRatio: 0.15, ScheduledRound: 10, EffectiveRound: 110},
+			},
+		},
+		{
+			name:    "increase above max is rejected",
+			current: 0.25,
+			ratio:   0.5,
+			conf:    conf,
+			want: want{
+				serviceCharge: 0.25,
+				errMsg:        "service charge increase 0.25 exceeds max increase 0.1",
+			},
+		},
+		{
+			name:    "decrease applies instantly and cancels pending increase",
+			current: 0.1,
+			pending: &CommissionChange{ServiceChargeRatio: 0.15, ScheduledRound: 5, EffectiveRound: 105},
+			ratio:   0.05,
+			conf:    conf,
+			want:    want{serviceCharge: 0.05},
+		},
+		{
+			name:    "same pending ratio keeps the notice period",
+			current: 0.1,
+			pending: &CommissionChange{ServiceChargeRatio: 0.15, ScheduledRound: 5, EffectiveRound: 105},
+			ratio:   0.15,
+			conf:    conf,
+			want: want{
+				serviceCharge: 0.1,
+				pending:       &CommissionChange{ServiceChargeRatio: 0.15, ScheduledRound: 5, EffectiveRound: 105},
+			},
+		},
+		{
+			name:    "due pending change is applied first",
+			current: 0.1,
+			pending: &CommissionChange{ServiceChargeRatio: 0.15, ScheduledRound: 0, EffectiveRound: 10},
+			ratio:   0.2,
+			conf:    conf,
+			want: want{
+				serviceCharge: 0.15,
+				pending:       &CommissionChange{ServiceChargeRatio: 0.2, ScheduledRound: 10, EffectiveRound: 110},
+			},
+		},
+		{
+			name:    "no notice period applies instantly",
+			current: 0.1,
+			ratio:   0.15,
+			want:    want{serviceCharge: 0.15},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balances := newTestBalances(t, false)
+			balances.block.Round = 10
+			sp := NewStakePool()
+			sp.Settings.ServiceChargeRatio = tt.current
+			sp.PendingCommission = tt.pending
+
+			err := sp.UpdateServiceCharge(tt.ratio, "provider_id", spenum.Miner, tt.conf, balances)
+			if tt.want.errMsg != "" {
+				require.EqualError(t, err, tt.want.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.want.serviceCharge, sp.Settings.ServiceChargeRatio)
+			require.Equal(t, tt.want.pending, sp.PendingCommission)
+		})
+	}
+}
+
+func TestStakePool_CommissionNotice(t *testing.T) {
+	balances := newTestBalances(t, false)
+	sp := NewStakePool()
+	sp.Settings.ServiceChargeRatio = 0.1
+	sp.Pools["delegate"] = &DelegatePool{
+		Balance:    10,
+		DelegateID: "delegate",
+		Status:     spenum.Active,
+	}
+
+	require.NoError(t, sp.UpdateServiceCharge(0.2, "provider_id", spenum.Miner,
+		CommissionConfig{NoticePeriod: 100}, balances))
+	require.True(t, sp.InCommissionNotice(0))
+	require.True(t, sp.InCommissionNotice(99))
+	require.False(t, sp.InCommissionNotice(100))
+
+	// rewards are distributed with the old service charge during the notice
+	require.NoError(t, sp.DistributeRewards(100, "provider_id", spenum.Miner, spenum.BlockRewardMiner, balances))
+	require.Equal(t, currency.Coin(10), sp.Reward)
+
+	// and with the new one once the notice period is over
+	balances.block.Round = 100
+	require.NoError(t, sp.DistributeRewards(100, "provider_id", spenum.Miner, spenum.BlockRewardMiner, balances))
+	require.Equal(t, currency.Coin(30), sp.Reward)
+	require.Nil(t, sp.PendingCommission)
+	require.Equal(t, 0.2, sp.Settings.ServiceChargeRatio)
+}
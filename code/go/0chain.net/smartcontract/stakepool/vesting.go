@@ -0,0 +1,186 @@
+package stakepool
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0chain/common/core/currency"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/core/datastore"
+	"0chain.net/smartcontract/dbs/event"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+// VestingStaker is the vesting SC side of delegate pools staked with
+// unvested tokens of vesting pools. The tokens belong to the vesting pool
+// until vested, so unlocking such delegate pool returns them to the pool.
+type VestingStaker interface {
+	// Unstake releases the stake of the delegate from the vesting pool and
+	// returns receiver of the unlocked tokens. The client unlocking the
+	// delegate pool is the delegate or the vesting pool owner.
+	Unstake(clientID, vestingPoolID, delegateID string,
+		providerType spenum.Provider, providerID string, amount currency.Coin,
+		balances cstate.StateContextI) (receiver string, err error)
+}
+
+var vestingStaker VestingStaker
+
+// SetVestingStaker registers the vesting SC unlocking delegate pools
+// staked from vesting pools
+func SetVestingStaker(vs VestingStaker) {
+	vestingStaker = vs
+}
+
+// UnstakeVesting releases the stake of the delegate pool staked from a
+// vesting pool and returns receiver of its tokens given by the vesting SC
+func UnstakeVesting(clientID string, dp *DelegatePool,
+	providerType spenum.Provider, providerID string,
+	balances cstate.StateContextI) (receiver string, err error) {
+
+	if vestingStaker == nil {
+		return "", errors.New("no vesting SC to unlock the delegate pool")
+	}
+	return vestingStaker.Unstake(clientID, dp.VestingPoolID, dp.DelegateID,
+		providerType, providerID, dp.Balance, balances)
+}
+
+// LockVestingPool stakes the value of unvested tokens of the vesting pool
+// for the delegate. The vesting SC of the txn transfers the tokens.
+func (sp *StakePool) LockVestingPool(
+	txn *transaction.Transaction,
+	vestingPoolID, delegateID string,
+	value currency.Coin,
+	providerType spenum.Provider,
+	providerId datastore.Key,
+	balances cstate.StateContextI,
+) (string, error) {
+	dp, ok := sp.Pools[delegateID]
+	if !ok {
+		dp = &DelegatePool{
+			Balance:       value,
+			Status:        spenum.Active,
+			DelegateID:    delegateID,
+			RoundCreated:  balances.GetBlock().Round,
+			StakedAt:      txn.CreationDate,
+			VestingPoolID: vestingPoolID,
+		}
+		sp.Pools[delegateID] = dp
+		dp.emitNew(delegateID, providerId, providerType, balances)
+	} else {
+		if dp.VestingPoolID != vestingPoolID {
+			return "", errors.New("delegate pool is not staked from the vesting pool")
+		}
+		if dp.Status != spenum.Active && dp.Status != spenum.Pending {
+			return "", fmt.Errorf("could not stake pool in %s status", dp.Status)
+		}
+
+		b, err := currency.AddCoin(dp.Balance, value)
+		if err != nil {
+			return "", err
+		}
+
+		dp.Balance = b
+		dp.StakedAt = txn.CreationDate
+
+		update := newDelegatePoolUpdate(delegateID, providerId, providerType)
+		update.Updates["balance"] = dp.Balance
+		update.emitUpdate(balances)
+	}
+
+	i, err := value.Int64()
+	if err != nil {
+		return "", err
+	}
+	lock := event.DelegatePoolLock{
+		Client:       delegateID,
+		ProviderId:   providerId,
+		ProviderType: providerType,
+		Amount:       i,
+		Total:        i,
+	}
+	balances.EmitEvent(event.TypeStats, event.TagLockStakePool, delegateID, lock)
+
+	return toJson(lock), nil
+}
+
+// EmptyVestingPool moves tokens of a delegate pool staked from a vesting
+// pool to the receiver given by the vesting SC
+func (sp *StakePool) EmptyVestingPool(sscID, poolID, receiver string,
+	balances cstate.StateContextI) error {
+
+	var dp, ok = sp.Pools[poolID]
+	if !ok {
+		return fmt.Errorf("no such delegate pool: %q", poolID)
+	}
+
+	if dp.VestingPoolID == "" {
+		return errors.New("delegate pool is not staked from a vesting pool")
+	}
+
+	if dp.Balance > 0 {
+		if err := balances.AddTransfer(
+			state.NewTransfer(sscID, receiver, dp.Balance)); err != nil {
+			return err
+		}
+	}
+
+	dp.Balance = 0
+	dp.Status = spenum.Deleted
+
+	return nil
+}
+
+// VestingStakePoolLock stakes unvested tokens of the vesting pool to the
+// provider for the delegate. The vesting SC calls it within its own txn
+// and transfers the value to the provider SC.
+func VestingStakePoolLock(t *transaction.Transaction,
+	vestingPoolID, delegateID string, value currency.Coin,
+	providerType spenum.Provider, providerID string,
+	balances cstate.StateContextI,
+	get func(providerType spenum.Provider, providerID string, balances cstate.CommonStateContextI) (AbstractStakePool, error),
+) (resp string, err error) {
+
+	var sp AbstractStakePool
+	if sp, err = get(providerType, providerID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_lock_failed",
+			"can't get stake pool: %v", err)
+	}
+
+	if value < sp.GetSettings().MinStake {
+		return "", common.NewError("stake_pool_lock_failed",
+			fmt.Sprintf("too small stake to lock: %v < %v", value, sp.GetSettings().MinStake))
+	}
+	if value > sp.GetSettings().MaxStake {
+		return "", common.NewError("stake_pool_lock_failed",
+			fmt.Sprintf("too large stake to lock: %v > %v", value, sp.GetSettings().MaxStake))
+	}
+
+	if len(sp.GetPools()) >= sp.GetSettings().MaxNumDelegates && !sp.HasStakePool(delegateID) {
+		return "", common.NewErrorf("stake_pool_lock_failed",
+			"max_delegates reached: %v, no more stake pools allowed",
+			sp.GetSettings().MaxNumDelegates)
+	}
+
+	resp, err = sp.LockVestingPool(t, vestingPoolID, delegateID, value,
+		providerType, providerID, balances)
+	if err != nil {
+		return "", common.NewErrorf("stake_pool_lock_failed",
+			"stake pool digging error: %v", err)
+	}
+
+	if err = sp.Save(providerType, providerID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_lock_failed",
+			"saving stake pool: %v", err)
+	}
+
+	if err = sp.EmitStakeEvent(providerType, providerID, balances); err != nil {
+		return "", common.NewErrorf("stake_pool_lock_failed",
+			"stake pool staking error: %v", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,130 @@
+package stakepool
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/require"
+
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+type testVestingStaker struct {
+	clientID, poolID, delegateID string
+	amount                       currency.Coin
+}
+
+func (tvs *testVestingStaker) Unstake(clientID, poolID, delegateID string,
+	_ spenum.Provider, _ string, amount currency.Coin,
+	_ cstate.StateContextI) (string, error) {
+
+	tvs.clientID, tvs.poolID, tvs.delegateID = clientID, poolID, delegateID
+	tvs.amount = amount
+	return "vesting_sc", nil
+}
+
+func newVestingTestStakePool() *StakePool {
+	sp := NewStakePool()
+	sp.Settings.MinStake = 1
+	sp.Settings.MaxStake = 100
+	sp.Settings.MaxNumDelegates = 10
+	return sp
+}
+
+func TestVestingStakePoolLock(t *testing.T) {
+	var (
+		balances = newTestBalances(t, false)
+		sp       = newVestingTestStakePool()
+		txn      = &transaction.Transaction{ClientID: "one", ToClientID: "vesting_sc"}
+		get      = func(spenum.Provider, string, cstate.CommonStateContextI) (AbstractStakePool, error) {
+			return sp, nil
+		}
+	)
+
+	_, err := VestingStakePoolLock(txn, "vp", "one", 200, spenum.Miner, "miner", balances, get)
+	require.EqualError(t, err, "stake_pool_lock_failed: too large stake to lock: 200 > 100")
+
+	_, err = VestingStakePoolLock(txn, "vp", "one", 40, spenum.Miner, "miner", balances, get)
+	require.NoError(t, err)
+	_, err = VestingStakePoolLock(txn, "vp", "one", 10, spenum.Miner, "miner", balances, get)
+	require.NoError(t, err)
+	require.Equal(t, currency.Coin(50), sp.Pools["one"].Balance)
+	require.Equal(t, "vp", sp.Pools["one"].VestingPoolID)
+
+	// the tokens are transferred by the vesting SC
+	require.Empty(t, balances.transfers)
+
+	// another vesting pool of the same delegate
+	_, err = VestingStakePoolLock(txn, "vp2", "one", 10, spenum.Miner, "miner", balances, get)
+	require.EqualError(t, err, "stake_pool_lock_failed: stake pool digging error: "+
+		"delegate pool is not staked from the vesting pool")
+
+	// own tokens of the delegate
+	balances.balances["one"] = 100
+	txn.Value = 10
+	_, err = sp.LockPool(txn, spenum.Miner, "miner", spenum.Active, balances)
+	require.EqualError(t, err, "could not stake to pool staked from vesting pool vp")
+
+	err = sp.SetAutoCompound("one", spenum.Miner, "miner", true, balances)
+	require.EqualError(t, err, "could not auto compound pool staked from a vesting pool")
+}
+
+func TestStakePoolUnlock_vesting(t *testing.T) {
+	var (
+		balances = newTestBalances(t, false)
+		sp       = newVestingTestStakePool()
+		staker   = &testVestingStaker{}
+		txn      = &transaction.Transaction{ClientID: "owner", ToClientID: "miner_sc"}
+		get      = func(spenum.Provider, string, cstate.CommonStateContextI) (AbstractStakePool, error) {
+			return sp, nil
+		}
+		input = (&StakePoolRequest{
+			ProviderType: spenum.Miner,
+			ProviderID:   "miner",
+			DelegateID:   "one",
+		}).Encode()
+	)
+	balances.txn = txn
+	sp.Pools["one"] = &DelegatePool{
+		Balance:       40,
+		Status:        spenum.Active,
+		DelegateID:    "one",
+		VestingPoolID: "vp",
+	}
+	sp.Pools["two"] = &DelegatePool{
+		Balance:    10,
+		Status:     spenum.Active,
+		DelegateID: "two",
+	}
+
+	defer SetVestingStaker(vestingStaker)
+	SetVestingStaker(nil)
+	_, err := StakePoolUnlock(txn, input, balances, get)
+	require.EqualError(t, err, "stake_pool_unlock_failed: "+
+		"no vesting SC to unlock the delegate pool")
+
+	// only the delegate can unlock own delegate pool
+	SetVestingStaker(staker)
+	_, err = StakePoolUnlock(txn, (&StakePoolRequest{
+		ProviderType: spenum.Miner,
+		ProviderID:   "miner",
+		DelegateID:   "two",
+	}).Encode(), balances, get)
+	require.EqualError(t, err, "stake_pool_unlock_failed: "+
+		"only the delegate can unlock the delegate pool")
+
+	// the tokens go where the vesting SC says
+	_, err = StakePoolUnlock(txn, input, balances, get)
+	require.NoError(t, err)
+	require.Equal(t, testVestingStaker{
+		clientID:   "owner",
+		poolID:     "vp",
+		delegateID: "one",
+		amount:     40,
+	}, *staker)
+	require.Equal(t, currency.Coin(40), balances.balances["vesting_sc"])
+	require.Zero(t, balances.balances["one"])
+	require.NotContains(t, sp.Pools, "one")
+}
@@ -57,6 +57,14 @@ func BenchmarkRestTests(
 				FuncName: "storage-config",
 				Endpoint: srh.getConfig,
 			},
+			{
+				FuncName: "simulate-rewards",
+				Params: map[string]string{
+					"blobber_id": getMockBlobberId(0),
+					"rounds":     "1000",
+				},
+				Endpoint: srh.getSimulateRewards,
+			},
 			{
 				FuncName: "get_blocks",
 				Params: map[string]string{
@@ -143,6 +143,13 @@ func (sc *StorageSmartContract) updateBlobber(t *transaction.Transaction,
 			stakedCapacity, blobber.Capacity)
 	}
 
+	if err = sp.UpdateServiceCharge(blobber.StakePoolSettings.ServiceChargeRatio,
+		blobber.ID, spenum.Blobber, conf.StakePool.commission(), balances); err != nil {
+		return fmt.Errorf("updating service charge: %v", err)
+	}
+	// an increase of the service charge waits for its notice period
+	blobber.StakePoolSettings.ServiceChargeRatio = sp.Settings.ServiceChargeRatio
+
 	_, err = balances.InsertTrieNode(blobber.GetKey(sc.ID), blobber)
 	if err != nil {
 		return common.NewError("update_blobber_settings_failed", "saving blobber: "+err.Error())
@@ -150,7 +157,6 @@ func (sc *StorageSmartContract) updateBlobber(t *transaction.Transaction,
 
 	sp.Settings.MinStake = blobber.StakePoolSettings.MinStake
 	sp.Settings.MaxStake = blobber.StakePoolSettings.MaxStake
-	sp.Settings.ServiceChargeRatio = blobber.StakePoolSettings.ServiceChargeRatio
 	sp.Settings.MaxNumDelegates = blobber.StakePoolSettings.MaxNumDelegates
 
 	// Save stake pool
@@ -870,6 +876,7 @@ func (sc *StorageSmartContract) insertBlobber(t *transaction.Transaction,
 	if err != nil {
 		return fmt.Errorf("creating stake pool: %v", err)
 	}
+	blobber.StakePoolSettings.ServiceChargeRatio = sp.Settings.ServiceChargeRatio
 
 	if err = sp.Save(spenum.Blobber, blobber.ID, balances); err != nil {
 		return fmt.Errorf("saving stake pool: %v", err)
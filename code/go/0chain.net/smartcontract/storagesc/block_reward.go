@@ -27,12 +27,6 @@ func (ssc *StorageSmartContract) blobberBlockRewards(
 		zap.Int64("round", balances.GetBlock().Round),
 		zap.String("block_hash", balances.GetBlock().Hash))
 
-	var (
-		totalQStake float64
-		weight      []float64
-		totalWeight float64
-	)
-
 	conf, err := ssc.getConfig(balances, true)
 	if err != nil {
 		return common.NewError("blobber_block_rewards_failed",
@@ -76,6 +70,23 @@ func (ssc *StorageSmartContract) blobberBlockRewards(
 		return nil
 	}
 
+	return payBlobberBlockRewards(conf, bbr, blobberRewards, balances)
+}
+
+// payBlobberBlockRewards distributes the block reward of blobbers among the
+// blobbers passed challenges, weighted by their stakes, terms and challenges
+func payBlobberBlockRewards(
+	conf *Config,
+	bbr currency.Coin,
+	blobberRewards []BlobberRewardNode,
+	balances cstate.StateContextI,
+) error {
+	var (
+		totalQStake float64
+		weight      []float64
+		totalWeight float64
+	)
+
 	type spResp struct {
 		index int
 		sp    *stakePool
@@ -88,7 +99,7 @@ func (ssc *StorageSmartContract) blobberBlockRewards(
 		wg.Add(1)
 		go func(b BlobberRewardNode, i int) {
 			defer wg.Done()
-			if sp, err := ssc.getStakePool(spenum.Blobber, b.ID, balances); err != nil {
+			if sp, err := getStakePool(spenum.Blobber, b.ID, balances); err != nil {
 				errC <- err
 			} else {
 				spC <- spResp{
@@ -219,7 +230,7 @@ func (ssc *StorageSmartContract) blobberBlockRewards(
 	}
 
 	for i, qsp := range stakePools {
-		if err = qsp.Save(spenum.Blobber, qualifyingBlobberIds[i], balances); err != nil {
+		if err := qsp.Save(spenum.Blobber, qualifyingBlobberIds[i], balances); err != nil {
 			return common.NewError("blobber_block_rewards_failed",
 				"saving stake pool: "+err.Error())
 		}
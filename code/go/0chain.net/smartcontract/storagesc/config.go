@@ -33,6 +33,11 @@ type freeAllocationSettings struct {
 type stakePoolConfig struct {
 	MinLock       currency.Coin `json:"min_lock"`
 	MinLockPeriod time.Duration `json:"min_lock_period"`
+	// CommissionNoticePeriod is number of rounds a service charge increase
+	// waits before it becomes effective
+	CommissionNoticePeriod int64 `json:"commission_notice_period"`
+	// MaxCommissionIncrease is the max service charge increase per notice period
+	MaxCommissionIncrease float64 `json:"max_commission_increase"`
 }
 
 type readPoolConfig struct {
@@ -244,6 +249,14 @@ func (conf *Config) validate() (err error) {
 		return fmt.Errorf("invalid stakepool.min_lock: %v <= 1",
 			conf.StakePool.MinLock)
 	}
+	if conf.StakePool.CommissionNoticePeriod < 0 {
+		return fmt.Errorf("negative stakepool.commission_notice_period: %v",
+			conf.StakePool.CommissionNoticePeriod)
+	}
+	if conf.StakePool.MaxCommissionIncrease < 0 {
+		return fmt.Errorf("negative stakepool.max_commission_increase: %v",
+			conf.StakePool.MaxCommissionIncrease)
+	}
 
 	if conf.FreeAllocationSettings.DataShards < 0 {
 		return fmt.Errorf("negative free_allocation_settings.data_shards: %v",
@@ -458,6 +471,8 @@ func getConfiguredConfig() (conf *Config, err error) {
 		return nil, err
 	}
 	conf.StakePool.MinLockPeriod = scc.GetDuration(pfx + "stakepool.min_lock_period")
+	conf.StakePool.CommissionNoticePeriod = scc.GetInt64(pfx + "stakepool.commission_notice_period")
+	conf.StakePool.MaxCommissionIncrease = scc.GetFloat64(pfx + "stakepool.max_commission_increase")
 
 	conf.MaxTotalFreeAllocation, err = currency.MultFloat64(1e10, scc.GetFloat64(pfx+"max_total_free_allocation"))
 	if err != nil {
@@ -75,17 +75,11 @@ func (z *Config) MarshalMsg(b []byte) (o []byte, err error) {
 	if z.StakePool == nil {
 		o = msgp.AppendNil(o)
 	} else {
-		// map header, size 2
-		// string "MinLock"
-		o = append(o, 0x82, 0xa7, 0x4d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b)
-		o, err = z.StakePool.MinLock.MarshalMsg(o)
+		o, err = z.StakePool.MarshalMsg(o)
 		if err != nil {
-			err = msgp.WrapError(err, "StakePool", "MinLock")
+			err = msgp.WrapError(err, "StakePool")
 			return
 		}
-		// string "MinLockPeriod"
-		o = append(o, 0xad, 0x4d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64)
-		o = msgp.AppendDuration(o, z.StakePool.MinLockPeriod)
 	}
 	// string "ValidatorReward"
 	o = append(o, 0xaf, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64)
@@ -366,40 +360,11 @@ func (z *Config) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				if z.StakePool == nil {
 					z.StakePool = new(stakePoolConfig)
 				}
-				var zb0004 uint32
-				zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+				bts, err = z.StakePool.UnmarshalMsg(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "StakePool")
 					return
 				}
-				for zb0004 > 0 {
-					zb0004--
-					field, bts, err = msgp.ReadMapKeyZC(bts)
-					if err != nil {
-						err = msgp.WrapError(err, "StakePool")
-						return
-					}
-					switch msgp.UnsafeString(field) {
-					case "MinLock":
-						bts, err = z.StakePool.MinLock.UnmarshalMsg(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "StakePool", "MinLock")
-							return
-						}
-					case "MinLockPeriod":
-						z.StakePool.MinLockPeriod, bts, err = msgp.ReadDurationBytes(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "StakePool", "MinLockPeriod")
-							return
-						}
-					default:
-						bts, err = msgp.Skip(bts)
-						if err != nil {
-							err = msgp.WrapError(err, "StakePool")
-							return
-						}
-					}
-				}
 			}
 		case "ValidatorReward":
 			z.ValidatorReward, bts, err = msgp.ReadFloat64Bytes(bts)
@@ -545,23 +510,23 @@ func (z *Config) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		case "Cost":
-			var zb0005 uint32
-			zb0005, bts, err = msgp.ReadMapHeaderBytes(bts)
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "Cost")
 				return
 			}
 			if z.Cost == nil {
-				z.Cost = make(map[string]int, zb0005)
+				z.Cost = make(map[string]int, zb0004)
 			} else if len(z.Cost) > 0 {
 				for key := range z.Cost {
 					delete(z.Cost, key)
 				}
 			}
-			for zb0005 > 0 {
+			for zb0004 > 0 {
 				var za0001 string
 				var za0002 int
-				zb0005--
+				zb0004--
 				za0001, bts, err = msgp.ReadStringBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Cost")
@@ -604,7 +569,7 @@ func (z *Config) Msgsize() (s int) {
 	if z.StakePool == nil {
 		s += msgp.NilSize
 	} else {
-		s += 1 + 8 + z.StakePool.MinLock.Msgsize() + 14 + msgp.DurationSize
+		s += z.StakePool.Msgsize()
 	}
 	s += 16 + msgp.Float64Size + 13 + msgp.Float64Size + 25 + msgp.IntSize + 13 + z.MaxReadPrice.Msgsize() + 14 + z.MaxWritePrice.Msgsize() + 14 + z.MinWritePrice.Msgsize() + 19 + msgp.Float64Size + 25 + msgp.IntSize + 32 + msgp.IntSize + 23 + z.MaxTotalFreeAllocation.Msgsize() + 28 + z.MaxIndividualFreeAllocation.Msgsize() + 23 + z.FreeAllocationSettings.Msgsize() + 17 + msgp.BoolSize + 27 + msgp.IntSize + 23 + msgp.IntSize + 24 + msgp.Float64Size + 9 + z.MinStake.Msgsize() + 9 + z.MaxStake.Msgsize() + 13 + msgp.IntSize + 10 + msgp.Float64Size + 12
 	if z.BlockReward == nil {
@@ -1164,9 +1129,9 @@ func (z *readPoolConfig) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *stakePoolConfig) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 2
+	// map header, size 4
 	// string "MinLock"
-	o = append(o, 0x82, 0xa7, 0x4d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b)
+	o = append(o, 0x84, 0xa7, 0x4d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b)
 	o, err = z.MinLock.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "MinLock")
@@ -1175,6 +1140,12 @@ func (z *stakePoolConfig) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "MinLockPeriod"
 	o = append(o, 0xad, 0x4d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64)
 	o = msgp.AppendDuration(o, z.MinLockPeriod)
+	// string "CommissionNoticePeriod"
+	o = append(o, 0xb6, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64)
+	o = msgp.AppendInt64(o, z.CommissionNoticePeriod)
+	// string "MaxCommissionIncrease"
+	o = append(o, 0xb5, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65)
+	o = msgp.AppendFloat64(o, z.MaxCommissionIncrease)
 	return
 }
 
@@ -1208,6 +1179,18 @@ func (z *stakePoolConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "MinLockPeriod")
 				return
 			}
+		case "CommissionNoticePeriod":
+			z.CommissionNoticePeriod, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "CommissionNoticePeriod")
+				return
+			}
+		case "MaxCommissionIncrease":
+			z.MaxCommissionIncrease, bts, err = msgp.ReadFloat64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxCommissionIncrease")
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -1222,7 +1205,7 @@ func (z *stakePoolConfig) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *stakePoolConfig) Msgsize() (s int) {
-	s = 1 + 8 + z.MinLock.Msgsize() + 14 + msgp.DurationSize
+	s = 1 + 8 + z.MinLock.Msgsize() + 14 + msgp.DurationSize + 23 + msgp.Int64Size + 22 + msgp.Float64Size
 	return
 }
 
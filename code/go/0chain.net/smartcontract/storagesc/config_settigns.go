@@ -44,6 +44,8 @@ const (
 
 	StakePoolMinLock
 	StakePoolMinLockPeriod
+	StakePoolCommissionNoticePeriod
+	StakePoolMaxCommissionIncrease
 
 	MaxTotalFreeAllocation
 	MaxIndividualFreeAllocation
@@ -154,6 +156,8 @@ func initSettingName() {
 	SettingName[WritePoolMinLock] = "writepool.min_lock"
 	SettingName[StakePoolMinLock] = "stakepool.min_lock"
 	SettingName[StakePoolMinLockPeriod] = "stakepool.min_lock_period"
+	SettingName[StakePoolCommissionNoticePeriod] = "stakepool.commission_notice_period"
+	SettingName[StakePoolMaxCommissionIncrease] = "stakepool.max_commission_increase"
 	SettingName[MaxTotalFreeAllocation] = "max_total_free_allocation"
 	SettingName[MaxIndividualFreeAllocation] = "max_individual_free_allocation"
 	SettingName[CancellationCharge] = "cancellation_charge"
@@ -241,6 +245,8 @@ func initSettings() {
 		WritePoolMinLock.String():                 {WritePoolMinLock, smartcontract.CurrencyCoin},
 		StakePoolMinLock.String():                 {StakePoolMinLock, smartcontract.CurrencyCoin},
 		StakePoolMinLockPeriod.String():           {StakePoolMinLockPeriod, smartcontract.Duration},
+		StakePoolCommissionNoticePeriod.String():  {StakePoolCommissionNoticePeriod, smartcontract.Int64},
+		StakePoolMaxCommissionIncrease.String():   {StakePoolMaxCommissionIncrease, smartcontract.Float64},
 		MaxTotalFreeAllocation.String():           {MaxTotalFreeAllocation, smartcontract.CurrencyCoin},
 		MaxIndividualFreeAllocation.String():      {MaxIndividualFreeAllocation, smartcontract.CurrencyCoin},
 		CancellationCharge.String():               {CancellationCharge, smartcontract.Float64},
@@ -455,6 +461,11 @@ func (conf *Config) setInt64(key string, change int64) error {
 		conf.MinBlobberCapacity = change
 	case FreeAllocationSize:
 		conf.FreeAllocationSettings.Size = change
+	case StakePoolCommissionNoticePeriod:
+		if conf.StakePool == nil {
+			conf.StakePool = &stakePoolConfig{}
+		}
+		conf.StakePool.CommissionNoticePeriod = change
 	default:
 		return fmt.Errorf("key: %v not implemented as int64", key)
 	}
@@ -472,6 +483,11 @@ func (conf *Config) setFloat64(key string, change float64) error {
 		conf.CancellationCharge = change
 	case BlobberSlash:
 		conf.BlobberSlash = change
+	case StakePoolMaxCommissionIncrease:
+		if conf.StakePool == nil {
+			conf.StakePool = &stakePoolConfig{}
+		}
+		conf.StakePool.MaxCommissionIncrease = change
 	case ChallengeGenerationRate:
 		conf.ChallengeGenerationRate = change
 	case BlockRewardSharderWeight:
@@ -674,6 +690,10 @@ func (conf *Config) get(key Setting) interface{} {
 		return conf.StakePool.MinLock
 	case StakePoolMinLockPeriod:
 		return conf.StakePool.MinLockPeriod
+	case StakePoolCommissionNoticePeriod:
+		return conf.StakePool.CommissionNoticePeriod
+	case StakePoolMaxCommissionIncrease:
+		return conf.StakePool.MaxCommissionIncrease
 	case MaxTotalFreeAllocation:
 		return conf.MaxTotalFreeAllocation
 	case MaxIndividualFreeAllocation:
@@ -777,7 +797,7 @@ func (ssc *StorageSmartContract) updateSettings(
 			"can't get config: "+err.Error())
 	}
 
-	if err := smartcontractinterface.AuthorizeWithOwner("update_settings", func() bool {
+	if err := smartcontractinterface.AuthorizeWithGovernance("update_settings", t.ClientID, func() bool {
 		return conf.OwnerId == t.ClientID
 	}); err != nil {
 		return "", err
@@ -134,6 +134,9 @@ func TestUpdateSettings(t *testing.T) {
 					"writepool.min_lock": "10",
 					"stakepool.min_lock": "10",
 
+					"stakepool.commission_notice_period": "1000",
+					"stakepool.max_commission_increase":  "0.1",
+
 					"max_total_free_allocation":      "10000",
 					"max_individual_free_allocation": "100",
 					"cancellation_charge":            "0.2",
@@ -505,6 +508,10 @@ func getConfField(conf Config, field string) interface{} {
 
 	case StakePoolMinLock:
 		return conf.StakePool.MinLock
+	case StakePoolCommissionNoticePeriod:
+		return conf.StakePool.CommissionNoticePeriod
+	case StakePoolMaxCommissionIncrease:
+		return conf.StakePool.MaxCommissionIncrease
 
 	case MaxTotalFreeAllocation:
 		return conf.MaxTotalFreeAllocation
@@ -15,6 +15,7 @@ import (
 	"github.com/0chain/common/core/currency"
 
 	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/config"
 	"0chain.net/core/maths"
 	"0chain.net/smartcontract/stakepool"
 	"github.com/0chain/common/core/logging"
@@ -81,6 +82,7 @@ func GetEndpoints(rh rest.RestHandlerI) []rest.Endpoint {
 		rest.MakeEndpoint(storage+"/get_blocks", common.UserRateLimit(srh.getBlocks)),
 		rest.MakeEndpoint(storage+"/total-stored-data", common.UserRateLimit(srh.getTotalData)),
 		rest.MakeEndpoint(storage+"/storage-config", common.UserRateLimit(srh.getConfig)),
+		rest.MakeEndpoint(storage+"/simulate-rewards", common.UserRateLimit(srh.getSimulateRewards)),
 		rest.MakeEndpoint(storage+"/getReadPoolStat", common.UserRateLimit(srh.getReadPoolStat)),
 		rest.MakeEndpoint(storage+"/getChallengePoolStat", common.UserRateLimit(srh.getChallengePoolStat)),
 		rest.MakeEndpoint(storage+"/alloc_write_marker_count", common.UserRateLimit(srh.getWriteMarkerCount)),
@@ -672,6 +674,126 @@ func (srh *StorageRestHandler) getConfig(w http.ResponseWriter, r *http.Request)
 	common.Respond(w, r, rtv, nil)
 }
 
+// swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/simulate-rewards simulate-rewards
+// Simulates block rewards of a blobber over the next rounds under the current configurations
+//
+// parameters:
+//
+//	+name: blobber_id
+//	 description: blobber id, a hypothetical blobber if empty
+//	 in: query
+//	 type: string
+//	+name: stake
+//	 description: stake of the hypothetical blobber, in SAS
+//	 in: query
+//	 type: string
+//	+name: service_charge
+//	 description: service charge of the hypothetical blobber
+//	 in: query
+//	 type: string
+//	+name: write_price
+//	 description: write price of the hypothetical blobber, in SAS
+//	 in: query
+//	 type: string
+//	+name: read_price
+//	 description: read price of the hypothetical blobber, in SAS
+//	 in: query
+//	 type: string
+//	+name: total_data
+//	 description: GB stored by the hypothetical blobber
+//	 in: query
+//	 type: string
+//	+name: data_read
+//	 description: GB read from the hypothetical blobber in a period
+//	 in: query
+//	 type: string
+//	+name: success_challenges
+//	 description: challenges the hypothetical blobber passes in a period
+//	 in: query
+//	 type: string
+//	+name: rounds
+//	 description: number of rounds to simulate
+//	 required: true
+//	 in: query
+//	 type: string
+//	+name: seed
+//	 description: seed of the random blobbers rewarded in the simulated periods
+//	 in: query
+//	 type: string
+//
+// responses:
+//
+//	200: RewardsSimulation
+//	400:
+func (srh *StorageRestHandler) getSimulateRewards(w http.ResponseWriter, r *http.Request) {
+	if !config.RewardsSimulation() {
+		common.Respond(w, r, nil, common.NewErrNoResource(
+			"rewards simulation is disabled on the node"))
+		return
+	}
+	var (
+		q   = r.URL.Query()
+		req = &SimulateRewardsRequest{BlobberID: q.Get("blobber_id")}
+		err error
+	)
+	for _, p := range []struct {
+		name  string
+		parse func(s string) error
+	}{
+		{"stake", func(s string) error { return parseCoin(s, &req.Stake) }},
+		{"write_price", func(s string) error { return parseCoin(s, &req.WritePrice) }},
+		{"read_price", func(s string) error { return parseCoin(s, &req.ReadPrice) }},
+		{"service_charge", func(s string) (err error) {
+			req.ServiceCharge, err = strconv.ParseFloat(s, 64)
+			return
+		}},
+		{"total_data", func(s string) (err error) {
+			req.TotalData, err = strconv.ParseFloat(s, 64)
+			return
+		}},
+		{"data_read", func(s string) (err error) {
+			req.DataRead, err = strconv.ParseFloat(s, 64)
+			return
+		}},
+		{"success_challenges", func(s string) (err error) {
+			req.SuccessChallenges, err = strconv.Atoi(s)
+			return
+		}},
+		{"rounds", func(s string) (err error) {
+			req.Rounds, err = strconv.ParseInt(s, 10, 64)
+			return
+		}},
+		{"seed", func(s string) (err error) {
+			req.Seed, err = strconv.ParseInt(s, 10, 64)
+			return
+		}},
+	} {
+		if s := q.Get(p.name); s != "" {
+			if err = p.parse(s); err != nil {
+				common.Respond(w, r, nil, common.NewErrBadRequest(
+					fmt.Sprintf("invalid %s: %v", p.name, err)))
+				return
+			}
+		}
+	}
+
+	rs, err := SimulateRewards(srh.GetQueryStateContext(), req)
+	if err != nil {
+		common.Respond(w, r, nil, common.NewErrBadRequest(err.Error()))
+		return
+	}
+	common.Respond(w, r, rs, nil)
+}
+
+func parseCoin(s string, c *currency.Coin) error {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*c = currency.Coin(v)
+	return nil
+}
+
 // swagger:route GET /v1/screst/6dba10422e368813802877a85039d3985d96760ed844092319743fb3a76712d7/total-stored-data total-stored-data
 // Gets the total data currently storage used across all blobbers.
 //
@@ -0,0 +1,187 @@
+package storagesc
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/0chain/common/core/currency"
+
+	"0chain.net/chaincore/block"
+	cstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+// MaxSimulatedRounds limits the rounds of a rewards simulation
+const MaxSimulatedRounds = 10000
+
+var (
+	// SimulatedBlobberID is the ID of the hypothetical blobber of a simulation
+	SimulatedBlobberID = encryption.Hash("simulated_blobber")
+	// SimulatedDelegateID is the delegate of the hypothetical blobber
+	SimulatedDelegateID = encryption.Hash("simulated_delegate")
+)
+
+// SimulateRewardsRequest is a blobber to simulate block rewards of
+type SimulateRewardsRequest struct {
+	// BlobberID is a registered blobber, or empty for a hypothetical
+	// blobber with the stake and the terms given
+	BlobberID     string        `json:"blobber_id"`
+	Stake         currency.Coin `json:"stake"`
+	ServiceCharge float64       `json:"service_charge"`
+	WritePrice    currency.Coin `json:"write_price"`
+	ReadPrice     currency.Coin `json:"read_price"`
+	// TotalData and DataRead are GB stored and read in a period
+	TotalData float64 `json:"total_data"`
+	DataRead  float64 `json:"data_read"`
+	// SuccessChallenges is the challenges passed in a period
+	SuccessChallenges int   `json:"success_challenges"`
+	Rounds            int64 `json:"rounds"`
+	// Seed of the random blobbers rewarded in the simulated periods
+	Seed int64 `json:"seed"`
+}
+
+func (req *SimulateRewardsRequest) validate(conf *Config) error {
+	if req.Rounds <= 0 || req.Rounds > MaxSimulatedRounds {
+		return fmt.Errorf("rounds should be in [1, %d]", MaxSimulatedRounds)
+	}
+	if req.BlobberID != "" {
+		return nil
+	}
+	if req.Stake == 0 {
+		return errors.New("no stake of the hypothetical blobber")
+	}
+	if req.ServiceCharge < 0 || req.ServiceCharge > conf.MaxCharge {
+		return fmt.Errorf("service charge should be in [0, %v]", conf.MaxCharge)
+	}
+	if req.SuccessChallenges <= 0 {
+		return errors.New("the hypothetical blobber passed no challenges")
+	}
+	return nil
+}
+
+// SimulateRewards simulates the block rewards of a blobber over the rounds
+// following the block of the state context, under the current configurations.
+// The rewards of every period are paid by the function paying them on chain,
+// against a fork of the state. The blobbers passed challenges in the last
+// period are expected to pass them in the simulated periods the same way.
+func SimulateRewards(sctx cstate.CommonStateContextI, req *SimulateRewardsRequest) (
+	*stakepool.RewardsSimulation, error) {
+
+	fork, err := cstate.NewFork(sctx)
+	if err != nil {
+		return nil, err
+	}
+	return simulateRewards(fork.StateContext, sctx.GetBlock().Round, req)
+}
+
+func simulateRewards(
+	newContext func(*block.Block, *transaction.Transaction) cstate.StateContextI,
+	round int64,
+	req *SimulateRewardsRequest,
+) (*stakepool.RewardsSimulation, error) {
+
+	var (
+		rng = rand.New(rand.NewSource(req.Seed))
+		t   = &transaction.Transaction{ToClientID: ADDRESS}
+		b   = &block.Block{}
+	)
+	b.Round = round
+	var balances = newContext(b, t)
+
+	conf, err := getConfig(balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting config: %v", err)
+	}
+	if err = req.validate(conf); err != nil {
+		return nil, err
+	}
+	if conf.BlockReward.TriggerPeriod <= 0 {
+		return nil, errors.New("blobber block rewards are disabled")
+	}
+
+	passed, err := getActivePassedBlobberRewardsPartitions(balances,
+		conf.BlockReward.TriggerPeriod)
+	if err != nil {
+		return nil, fmt.Errorf("getting blobbers passed challenges: %v", err)
+	}
+
+	var blobberID = req.BlobberID
+	if blobberID == "" {
+		blobberID = SimulatedBlobberID
+		var sp = newStakePool()
+		sp.Settings.DelegateWallet = SimulatedDelegateID
+		sp.Settings.ServiceChargeRatio = req.ServiceCharge
+		sp.Settings.MaxNumDelegates = conf.MaxDelegates
+		sp.Minter = cstate.MinterStorage
+		sp.Pools[SimulatedDelegateID] = &stakepool.DelegatePool{
+			Balance:    req.Stake,
+			Status:     spenum.Active,
+			DelegateID: SimulatedDelegateID,
+		}
+		if err = sp.Save(spenum.Blobber, blobberID, balances); err != nil {
+			return nil, fmt.Errorf("saving hypothetical blobber: %v", err)
+		}
+		if err = passed.Add(balances, &BlobberRewardNode{
+			ID:                blobberID,
+			SuccessChallenges: req.SuccessChallenges,
+			WritePrice:        req.WritePrice,
+			ReadPrice:         req.ReadPrice,
+			TotalData:         req.TotalData,
+			DataRead:          req.DataRead,
+		}); err != nil {
+			return nil, fmt.Errorf("adding hypothetical blobber: %v", err)
+		}
+		if err = passed.Save(balances); err != nil {
+			return nil, err
+		}
+	}
+
+	before, err := getStakePool(spenum.Blobber, blobberID, balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting stake pool of %s: %v", blobberID, err)
+	}
+
+	size, err := passed.Size(balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting blobbers passed challenges: %v", err)
+	}
+
+	var period = conf.BlockReward.TriggerPeriod
+	for r := GetCurrentRewardRound(round, period) + period; size > 0 && r <= round+req.Rounds; r += period {
+		b = &block.Block{}
+		b.Round = r
+		balances = newContext(b, t)
+
+		bbr, err := getBlockReward(conf.BlockReward.BlockReward, r,
+			conf.BlockReward.BlockRewardChangePeriod,
+			conf.BlockReward.BlockRewardChangeRatio,
+			conf.BlockReward.BlobberWeight)
+		if err != nil {
+			return nil, fmt.Errorf("getting block reward of round %d: %v", r, err)
+		}
+
+		var rewarded []BlobberRewardNode
+		if err = passed.GetRandomItems(balances, rng, &rewarded); err != nil {
+			return nil, fmt.Errorf("getting rewarded blobbers: %v", err)
+		}
+		if err = payBlobberBlockRewards(conf, bbr, rewarded, balances); err != nil {
+			return nil, fmt.Errorf("paying rewards of round %d: %v", r, err)
+		}
+	}
+
+	after, err := getStakePool(spenum.Blobber, blobberID, balances)
+	if err != nil {
+		return nil, fmt.Errorf("getting stake pool of %s: %v", blobberID, err)
+	}
+	rs, err := stakepool.NewRewardsSimulation(blobberID,
+		before.StakePool, after.StakePool)
+	if err != nil {
+		return nil, err
+	}
+	rs.FromRound, rs.ToRound = round+1, round+req.Rounds
+	return rs, nil
+}
@@ -0,0 +1,96 @@
+package storagesc
+
+import (
+	"testing"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"0chain.net/chaincore/block"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+func TestSimulateRewards(t *testing.T) {
+	var (
+		balances = newTestBalances(t, true)
+		conf     = setConfig(t, balances)
+	)
+	balances.setBlock(t, &block.Block{})
+	balances.block.Round = 65
+
+	passed, err := getActivePassedBlobberRewardsPartitions(balances,
+		conf.BlockReward.TriggerPeriod)
+	require.NoError(t, err)
+	require.NoError(t, passed.Add(balances, &BlobberRewardNode{
+		ID:                "blobber0",
+		SuccessChallenges: 10,
+		WritePrice:        2,
+		ReadPrice:         1,
+		TotalData:         10,
+		DataRead:          2,
+	}))
+	require.NoError(t, passed.Save(balances))
+
+	var sp = newStakePool()
+	sp.Settings.DelegateWallet = "blobber0"
+	sp.Settings.ServiceChargeRatio = 0.1
+	for id, bal := range map[string]currency.Coin{"d0": 1, "d1": 3} {
+		sp.Pools[id] = &stakepool.DelegatePool{Balance: bal, DelegateID: id}
+	}
+	require.NoError(t, sp.Save(spenum.Blobber, "blobber0", balances))
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := SimulateRewards(balances, &SimulateRewardsRequest{BlobberID: "blobber0"})
+		require.EqualError(t, err, "rounds should be in [1, 100000]")
+		_, err = SimulateRewards(balances, &SimulateRewardsRequest{Rounds: 10})
+		require.EqualError(t, err, "no stake of the hypothetical blobber")
+		_, err = SimulateRewards(balances, &SimulateRewardsRequest{
+			Rounds: 10, Stake: 10, ServiceCharge: 0.6,
+		})
+		require.EqualError(t, err, "service charge should be in [0, 0.5]")
+	})
+
+	t.Run("registered blobber", func(t *testing.T) {
+		// three periods of 1000*0.5 block reward, the only blobber gets it all
+		rs, err := SimulateRewards(balances, &SimulateRewardsRequest{
+			BlobberID: "blobber0",
+			Rounds:    100,
+		})
+		require.NoError(t, err)
+		assert.EqualValues(t, 66, rs.FromRound)
+		assert.EqualValues(t, 165, rs.ToRound)
+		assert.Equal(t, currency.Coin(4), rs.Stake)
+		assert.Equal(t, currency.Coin(150), rs.ProviderReward)
+		assert.Equal(t, currency.Coin(1500), rs.TotalReward)
+		assert.Len(t, rs.DelegateRewards, 2)
+
+		// the state isn't changed
+		got, err := getStakePool(spenum.Blobber, "blobber0", balances)
+		require.NoError(t, err)
+		assert.Zero(t, got.Reward)
+	})
+
+	t.Run("hypothetical blobber", func(t *testing.T) {
+		rs, err := SimulateRewards(balances, &SimulateRewardsRequest{
+			Stake:             100,
+			ServiceCharge:     0.2,
+			WritePrice:        2,
+			ReadPrice:         1,
+			TotalData:         10,
+			DataRead:          2,
+			SuccessChallenges: 10,
+			Rounds:            100,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, SimulatedBlobberID, rs.ProviderID)
+		assert.Equal(t, currency.Coin(100), rs.Stake)
+		assert.NotZero(t, rs.DelegateRewards[SimulatedDelegateID])
+		assert.Less(t, rs.TotalReward, currency.Coin(1500))
+
+		size, err := passed.Size(balances)
+		require.NoError(t, err)
+		assert.Equal(t, 1, size)
+	})
+}
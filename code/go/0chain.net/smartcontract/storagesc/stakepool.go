@@ -42,6 +42,17 @@ func validateStakePoolSettings(
 	return nil
 }
 
+// commission limits of the blobbers and validators stake pools
+func (spc *stakePoolConfig) commission() stakepool.CommissionConfig {
+	if spc == nil {
+		return stakepool.CommissionConfig{}
+	}
+	return stakepool.CommissionConfig{
+		NoticePeriod: spc.CommissionNoticePeriod,
+		MaxIncrease:  spc.MaxCommissionIncrease,
+	}
+}
+
 // stake pool of a blobber
 
 type stakePool struct {
@@ -308,6 +319,12 @@ func (ssc *StorageSmartContract) getStakePool(providerType spenum.Provider, prov
 
 // getStakePool of given blobber
 func (ssc *StorageSmartContract) getStakePoolAdapter(providerType spenum.Provider, providerID string,
+	balances chainstate.CommonStateContextI) (sp stakepool.AbstractStakePool, err error) {
+	return GetStakePoolAdapter(providerType, providerID, balances)
+}
+
+// GetStakePoolAdapter returns stake pool of given blobber or validator
+func GetStakePoolAdapter(providerType spenum.Provider, providerID string,
 	balances chainstate.CommonStateContextI) (sp stakepool.AbstractStakePool, err error) {
 	pool, err := getStakePool(providerType, providerID, balances)
 	if err != nil {
@@ -351,12 +368,15 @@ func (ssc *StorageSmartContract) getOrCreateStakePool(
 		}
 		sp = newStakePool()
 		sp.Settings.DelegateWallet = settings.DelegateWallet
+		sp.Settings.ServiceChargeRatio = settings.ServiceChargeRatio
 		sp.Minter = chainstate.MinterStorage
+	} else if err := sp.UpdateServiceCharge(settings.ServiceChargeRatio,
+		providerId, providerType, conf.StakePool.commission(), balances); err != nil {
+		return nil, fmt.Errorf("updating service charge: %v", err)
 	}
 
 	sp.Settings.MinStake = settings.MinStake
 	sp.Settings.MaxStake = settings.MaxStake
-	sp.Settings.ServiceChargeRatio = settings.ServiceChargeRatio
 	sp.Settings.MaxNumDelegates = settings.MaxNumDelegates
 	return sp, nil
 }
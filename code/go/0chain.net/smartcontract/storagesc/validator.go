@@ -227,9 +227,16 @@ func (sc *StorageSmartContract) updateValidator(t *transaction.Transaction,
 		return fmt.Errorf("invalid new stake pool settings:  %v", err)
 	}
 
+	if err = sp.UpdateServiceCharge(inputValidator.StakePoolSettings.ServiceChargeRatio,
+		inputValidator.ID, spenum.Validator, conf.StakePool.commission(), balances); err != nil {
+		return fmt.Errorf("updating service charge: %v", err)
+	}
+	// an increase of the service charge waits for its notice period
+	inputValidator.StakePoolSettings.ServiceChargeRatio = sp.Settings.ServiceChargeRatio
+	savedValidator.StakePoolSettings.ServiceChargeRatio = sp.Settings.ServiceChargeRatio
+
 	sp.Settings.MinStake = inputValidator.StakePoolSettings.MinStake
 	sp.Settings.MaxStake = inputValidator.StakePoolSettings.MaxStake
-	sp.Settings.ServiceChargeRatio = inputValidator.StakePoolSettings.ServiceChargeRatio
 	sp.Settings.MaxNumDelegates = inputValidator.StakePoolSettings.MaxNumDelegates
 
 	// Save stake pool
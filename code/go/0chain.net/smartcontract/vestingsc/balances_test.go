@@ -36,9 +36,9 @@ func (tb *testBalances) setBalance(key datastore.Key, b currency.Coin) { //nolin
 }
 
 // stubs
-func (tb *testBalances) GetBlock() *block.Block                       { return nil }
+func (tb *testBalances) GetBlock() *block.Block                       { return &block.Block{} }
 func (tb *testBalances) GetState() util.MerklePatriciaTrieI           { return nil }
-func (tb *testBalances) GetTransaction() *transaction.Transaction     { return nil }
+func (tb *testBalances) GetTransaction() *transaction.Transaction     { return tb.txn }
 func (tb *testBalances) Validate() error                              { return nil }
 func (tb *testBalances) GetMints() []*state.Mint                      { return nil }
 func (tb *testBalances) SetStateContext(*state.State) error           { return nil }
@@ -44,6 +44,7 @@ func AddMockConfig(balances cstate.StateContextI) {
 	conf.MaxDuration = viper.GetDuration(benchmark.VestingMaxDuration)
 	conf.MaxDestinations = viper.GetInt(benchmark.VestingMaxDestinations)
 	conf.MaxDescriptionLength = viper.GetInt(benchmark.VestingMaxDescriptionLength)
+	conf.MaxTranches = viper.GetInt(benchmark.VestingMaxTranches)
 
 	_, err = balances.InsertTrieNode(scConfigKey(ADDRESS), &conf)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	"0chain.net/core/common"
 	sc "0chain.net/smartcontract"
 	bk "0chain.net/smartcontract/benchmark"
+	"0chain.net/smartcontract/stakepool/spenum"
 )
 
 type BenchTest struct {
@@ -93,6 +94,7 @@ func BenchmarkTests(
 					Settings[MaxDuration]:          "3m",
 					Settings[MaxDestinations]:      "5",
 					Settings[MaxDescriptionLength]: "7",
+					Settings[MaxTranches]:          "12",
 				},
 			}).Encode(),
 		},
@@ -147,6 +149,53 @@ func BenchmarkTests(
 				return bytes
 			}(),
 		},
+		{
+			name:     "vesting.clawback",
+			endpoint: vsc.clawback,
+			txn: &transaction.Transaction{
+				ClientID:     data.Clients[0],
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&stopRequest{
+					PoolID:      geMockVestingPoolId(0),
+					Destination: getMockDestinationId(0, 0),
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "vesting.change_destination",
+			endpoint: vsc.changeDestination,
+			txn: &transaction.Transaction{
+				ClientID:     getMockDestinationId(0, 0),
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&changeDestinationRequest{
+					PoolID:      geMockVestingPoolId(0),
+					Destination: data.Clients[1],
+				})
+				return bytes
+			}(),
+		},
+		{
+			name:     "vesting.stake",
+			endpoint: vsc.stake,
+			txn: &transaction.Transaction{
+				ClientID:     getMockDestinationId(0, 0),
+				CreationDate: creationTime,
+			},
+			input: func() []byte {
+				bytes, _ := json.Marshal(&stakeRequest{
+					PoolID:       geMockVestingPoolId(0),
+					ProviderType: spenum.Miner,
+					ProviderID:   data.Miners[0],
+					Amount:       mockDestinationBalance / 10,
+				})
+				return bytes
+			}(),
+		},
 		{
 			name:     "vesting.delete",
 			endpoint: vsc.delete,
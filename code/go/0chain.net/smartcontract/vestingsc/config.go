@@ -36,6 +36,7 @@ const (
 	MaxDuration
 	MaxDestinations
 	MaxDescriptionLength
+	MaxTranches
 	OwnerId
 	Cost
 )
@@ -47,13 +48,17 @@ var (
 		"max_duration",
 		"max_destinations",
 		"max_description_length",
+		"max_tranches",
 		"owner_id",
 		"cost",
 	}
 
 	costFunctions = []string{
 		"add",
+		"change_destination",
+		"clawback",
 		"delete",
+		"stake",
 		"stop",
 		"trigger",
 		"unlock",
@@ -72,6 +77,7 @@ type config struct {
 	MaxDuration          time.Duration  `json:"max_duration"`
 	MaxDestinations      int            `json:"max_destinations"`
 	MaxDescriptionLength int            `json:"max_description_length"`
+	MaxTranches          int            `json:"max_tranches"`
 	OwnerId              string         `json:"owner_id"`
 	Cost                 map[string]int `json:"cost"`
 }
@@ -86,6 +92,8 @@ func (c *config) validate() (err error) {
 		return errors.New("invalid max_destinations (< 1)")
 	case c.MaxDescriptionLength < 1:
 		return errors.New("invalid max_description_length (< 1)")
+	case c.MaxTranches < 1:
+		return errors.New("invalid max_tranches (< 1)")
 	case c.OwnerId == "":
 		return errors.New("owner_id is not set or empty")
 	}
@@ -146,6 +154,13 @@ func (c *config) update(changes *smartcontract.StringMap) error {
 			} else {
 				c.MaxDescriptionLength = iValue
 			}
+		case Settings[MaxTranches]:
+			if iValue, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("value %v cannot be converted to int, "+
+					"failing to set config key %s", value, key)
+			} else {
+				c.MaxTranches = iValue
+			}
 		case Settings[OwnerId]:
 			if _, err := hex.DecodeString(value); err != nil {
 				return fmt.Errorf("value %v cannot be converted to int with 16 base, "+
@@ -195,6 +210,7 @@ func (c *config) getConfigMap() smartcontract.StringMap {
 		Settings[MaxDuration]:          fmt.Sprintf("%v", c.MaxDuration),
 		Settings[MaxDestinations]:      fmt.Sprintf("%v", c.MaxDestinations),
 		Settings[MaxDescriptionLength]: fmt.Sprintf("%v", c.MaxDescriptionLength),
+		Settings[MaxTranches]:          fmt.Sprintf("%v", c.MaxTranches),
 		Settings[OwnerId]:              fmt.Sprintf("%v", c.OwnerId),
 	}
 
@@ -218,7 +234,7 @@ func (vsc *VestingSmartContract) updateConfig(
 			"can't get config: "+err.Error())
 	}
 
-	if err := smartcontractinterface.AuthorizeWithOwner("update_config", func() bool {
+	if err := smartcontractinterface.AuthorizeWithGovernance("update_config", txn.ClientID, func() bool {
 		return conf.OwnerId == txn.ClientID
 	}); err != nil {
 		return "", err
@@ -261,6 +277,7 @@ func getConfiguredConfig() (conf *config, err error) {
 	conf.MaxDuration = scconf.GetDuration(prefix + "max_duration")
 	conf.MaxDestinations = scconf.GetInt(prefix + "max_destinations")
 	conf.MaxDescriptionLength = scconf.GetInt(prefix + "max_description_length")
+	conf.MaxTranches = scconf.GetInt(prefix + "max_tranches")
 	conf.OwnerId = scconf.GetString(prefix + "owner_id")
 	conf.Cost = scconf.GetStringMapInt(prefix + "cost")
 
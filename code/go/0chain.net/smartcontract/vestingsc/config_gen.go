@@ -37,9 +37,9 @@ func (z Setting) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *config) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 7
+	// map header, size 8
 	// string "MinLock"
-	o = append(o, 0x87, 0xa7, 0x4d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b)
+	o = append(o, 0x88, 0xa7, 0x4d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b)
 	o, err = z.MinLock.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "MinLock")
@@ -57,6 +57,9 @@ func (z *config) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "MaxDescriptionLength"
 	o = append(o, 0xb4, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68)
 	o = msgp.AppendInt(o, z.MaxDescriptionLength)
+	// string "MaxTranches"
+	o = append(o, 0xab, 0x4d, 0x61, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73)
+	o = msgp.AppendInt(o, z.MaxTranches)
 	// string "OwnerId"
 	o = append(o, 0xa7, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64)
 	o = msgp.AppendString(o, z.OwnerId)
@@ -124,6 +127,12 @@ func (z *config) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "MaxDescriptionLength")
 				return
 			}
+		case "MaxTranches":
+			z.MaxTranches, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "MaxTranches")
+				return
+			}
 		case "OwnerId":
 			z.OwnerId, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
@@ -174,7 +183,7 @@ func (z *config) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *config) Msgsize() (s int) {
-	s = 1 + 8 + z.MinLock.Msgsize() + 12 + msgp.DurationSize + 12 + msgp.DurationSize + 16 + msgp.IntSize + 21 + msgp.IntSize + 8 + msgp.StringPrefixSize + len(z.OwnerId) + 5 + msgp.MapHeaderSize
+	s = 1 + 8 + z.MinLock.Msgsize() + 12 + msgp.DurationSize + 12 + msgp.DurationSize + 16 + msgp.IntSize + 21 + msgp.IntSize + 12 + msgp.IntSize + 8 + msgp.StringPrefixSize + len(z.OwnerId) + 5 + msgp.MapHeaderSize
 	if z.Cost != nil {
 		for za0001, za0002 := range z.Cost {
 			_ = za0002
@@ -80,6 +80,18 @@ func (vsc *VestingSmartContract) setSC(sc *smartcontractinterface.SmartContract,
 	vsc.SmartContractExecutionStats["stop"] = metrics.GetOrRegisterTimer(
 		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "stop"), nil)
 
+	// claw back unvested tokens of a destination to the pool treasury
+	vsc.SmartContractExecutionStats["clawback"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "clawback"), nil)
+
+	// redirect vesting of a destination to new client ID by the destination
+	vsc.SmartContractExecutionStats["change_destination"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "change_destination"), nil)
+
+	// stake unvested tokens of a destination to a provider
+	vsc.SmartContractExecutionStats["stake"] = metrics.GetOrRegisterTimer(
+		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "stake"), nil)
+
 	// tokens unlock for an existing pool (as owner, as a destination)
 	vsc.SmartContractExecutionStats["unlock"] = metrics.GetOrRegisterTimer(
 		fmt.Sprintf("sc:%v:func:%v", vsc.ID, "unlock"), nil)
@@ -109,6 +121,12 @@ func (vsc *VestingSmartContract) Execute(t *transaction.Transaction,
 		resp, err = vsc.stop(t, input, balances)
 	case "delete":
 		resp, err = vsc.delete(t, input, balances)
+	case "clawback":
+		resp, err = vsc.clawback(t, input, balances)
+	case "change_destination":
+		resp, err = vsc.changeDestination(t, input, balances)
+	case "stake":
+		resp, err = vsc.stake(t, input, balances)
 	case "vestingsc-update-settings":
 		resp, err = vsc.updateConfig(t, input, balances)
 	default:
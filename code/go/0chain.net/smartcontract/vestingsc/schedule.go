@@ -0,0 +1,158 @@
+package vestingsc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+
+	"0chain.net/core/common"
+)
+
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+// vesting schedule types
+const (
+	scheduleLinear      = "linear"       // linear from start to expiration
+	scheduleCliffLinear = "cliff_linear" // linear, but nothing before the cliff
+	schedulePeriodic    = "periodic"     // equal tranches every period
+	scheduleTable       = "table"        // explicit tranches of destinations
+)
+
+// schedule of a vesting pool. The zero value is the linear vesting.
+type schedule struct {
+	Type string `json:"type,omitempty"`
+	// Cliff is time from start of the pool nothing vested before, the
+	// cliff_linear schedule releases all tokens accrued by the cliff at once.
+	Cliff time.Duration `json:"cliff,omitempty"`
+	// Period between tranches of the periodic schedule.
+	Period time.Duration `json:"period,omitempty"`
+}
+
+// tranche is (timestamp, amount) entry of the table schedule
+type tranche struct {
+	Time   common.Timestamp `json:"time"`
+	Amount currency.Coin    `json:"amount"`
+}
+
+func (s *schedule) isLinear() bool {
+	return s.Type == "" || s.Type == scheduleLinear
+}
+
+// tranches returns number of tranches of the periodic schedule
+// for given vesting duration, the last one can be shorter
+func (s *schedule) tranches(dur common.Timestamp) int64 {
+	var period = toSeconds(s.Period)
+	return int64((dur + period - 1) / period)
+}
+
+// validate the schedule of a vesting pool starting at the start and lasting
+// the dur, the destinations must be validated before
+func (s *schedule) validate(start common.Timestamp, dur time.Duration,
+	ds destinations, conf *config) (err error) {
+
+	switch s.Type {
+	case "", scheduleLinear:
+		if s.Cliff != 0 || s.Period != 0 {
+			return errors.New("linear schedule takes no cliff or period")
+		}
+	case scheduleCliffLinear:
+		if toSeconds(s.Cliff) < 1 || toSeconds(s.Cliff) >= toSeconds(dur) {
+			return errors.New("cliff is out of the vesting duration")
+		}
+	case schedulePeriodic:
+		if toSeconds(s.Period) < 1 || toSeconds(s.Period) > toSeconds(dur) {
+			return errors.New("tranche period is out of the vesting duration")
+		}
+		if s.tranches(toSeconds(dur)) > int64(conf.MaxTranches) {
+			return errors.New("too many tranches")
+		}
+	case scheduleTable:
+		var end = start + toSeconds(dur)
+		for _, d := range ds {
+			if err = d.validateTranches(start, end, conf); err != nil {
+				return fmt.Errorf("destination %s: %v", d.ID, err)
+			}
+		}
+		return
+	default:
+		return fmt.Errorf("unknown vesting schedule %q", s.Type)
+	}
+
+	for _, d := range ds {
+		if len(d.Tranches) > 0 {
+			return fmt.Errorf("destination %s: tranches require %s schedule",
+				d.ID, scheduleTable)
+		}
+	}
+	return
+}
+
+// validateTranches of the table schedule, the tranches must be in time
+// order within the [start, end] and must sum to the destination amount
+func (d *destination) validateTranches(start, end common.Timestamp,
+	conf *config) (err error) {
+
+	switch {
+	case len(d.Tranches) == 0:
+		return errors.New("no tranches")
+	case len(d.Tranches) > conf.MaxTranches:
+		return errors.New("too many tranches")
+	}
+
+	var (
+		last  = start
+		total currency.Coin
+	)
+	for _, tr := range d.Tranches {
+		if tr.Time < last || tr.Time > end {
+			return errors.New("tranches out of order or out of the vesting duration")
+		}
+		if tr.Amount == 0 {
+			return errors.New("zero tranche")
+		}
+		if total, err = currency.AddCoin(total, tr.Amount); err != nil {
+			return
+		}
+		last = tr.Time
+	}
+	if total != d.Amount {
+		return errors.New("tranches don't sum to the destination amount")
+	}
+	return
+}
+
+// vested returns total amount of tokens of the destination vested by the
+// now under non-linear schedule. The now must be within [start, end].
+func (s *schedule) vested(d *destination, now, start, end common.Timestamp) (
+	vested currency.Coin, err error) {
+
+	if now >= end {
+		return d.Amount, nil // pool ending, should drain all
+	}
+
+	switch s.Type {
+	case scheduleCliffLinear:
+		if now < start+toSeconds(s.Cliff) {
+			return 0, nil
+		}
+		return currency.MultFloat64(d.Amount,
+			float64(now-start)/float64(end-start))
+	case schedulePeriodic:
+		var passed = int64((now - start) / toSeconds(s.Period))
+		return currency.MultFloat64(d.Amount,
+			float64(passed)/float64(s.tranches(end-start)))
+	case scheduleTable:
+		for _, tr := range d.Tranches {
+			if tr.Time > now {
+				break
+			}
+			if vested, err = currency.AddCoin(vested, tr.Amount); err != nil {
+				return 0, err
+			}
+		}
+		return
+	}
+	return 0, fmt.Errorf("unknown vesting schedule %q", s.Type)
+}
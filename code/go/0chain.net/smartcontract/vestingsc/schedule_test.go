@@ -0,0 +1,150 @@
+package vestingsc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+
+	"0chain.net/core/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_schedule_validate(t *testing.T) {
+	var conf = configureConfig() // max tranches is 4
+
+	one := func(tranches ...tranche) destinations {
+		var d = &destination{ID: "one", Tranches: tranches}
+		for _, tr := range tranches {
+			d.Amount += tr.Amount
+		}
+		return destinations{d}
+	}
+
+	for _, tt := range []struct {
+		name string
+		s    schedule
+		ds   destinations
+		err  string
+	}{
+		{"linear", schedule{}, one(), ""},
+		{"linear_cliff", schedule{Type: scheduleLinear, Cliff: s(10)}, one(),
+			"linear schedule takes no cliff or period"},
+		{"cliff", schedule{Type: scheduleCliffLinear, Cliff: s(10)}, one(), ""},
+		{"no_cliff", schedule{Type: scheduleCliffLinear}, one(),
+			"cliff is out of the vesting duration"},
+		{"long_cliff", schedule{Type: scheduleCliffLinear, Cliff: s(100)}, one(),
+			"cliff is out of the vesting duration"},
+		{"periodic", schedule{Type: schedulePeriodic, Period: s(25)}, one(), ""},
+		{"long_period", schedule{Type: schedulePeriodic, Period: s(101)}, one(),
+			"tranche period is out of the vesting duration"},
+		{"many_periods", schedule{Type: schedulePeriodic, Period: s(24)}, one(),
+			"too many tranches"},
+		{"tranches_not_table", schedule{Type: schedulePeriodic, Period: s(50)},
+			one(tranche{Time: 10, Amount: 10}),
+			"destination one: tranches require table schedule"},
+		{"table", schedule{Type: scheduleTable},
+			one(tranche{Time: 10, Amount: 10}, tranche{Time: 110, Amount: 5}), ""},
+		{"table_empty", schedule{Type: scheduleTable}, one(),
+			"destination one: no tranches"},
+		{"table_order", schedule{Type: scheduleTable},
+			one(tranche{Time: 50, Amount: 10}, tranche{Time: 20, Amount: 5}),
+			"destination one: tranches out of order or out of the vesting duration"},
+		{"table_after_end", schedule{Type: scheduleTable},
+			one(tranche{Time: 111, Amount: 10}),
+			"destination one: tranches out of order or out of the vesting duration"},
+		{"table_zero", schedule{Type: scheduleTable},
+			one(tranche{Time: 20, Amount: 0}),
+			"destination one: zero tranche"},
+		{"table_many", schedule{Type: scheduleTable},
+			one(tranche{Time: 20, Amount: 1}, tranche{Time: 30, Amount: 1},
+				tranche{Time: 40, Amount: 1}, tranche{Time: 50, Amount: 1},
+				tranche{Time: 60, Amount: 1}),
+			"destination one: too many tranches"},
+		{"unknown", schedule{Type: "exponential"}, one(),
+			`unknown vesting schedule "exponential"`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			requireErrMsg(t, tt.s.validate(10, s(100), tt.ds, conf), tt.err)
+		})
+	}
+
+	var ds = one(tranche{Time: 10, Amount: 10})
+	ds[0].Amount = 20
+	requireErrMsg(t, (&schedule{Type: scheduleTable}).validate(10, s(100), ds, conf),
+		"destination one: tranches don't sum to the destination amount")
+}
+
+func Test_vestingPool_schedules(t *testing.T) {
+	newPool := func(sc schedule, tranches ...tranche) *vestingPool {
+		return newVestingPoolFromReqeust("client_hex", &addRequest{
+			StartTime: 100,
+			Duration:  100 * time.Second,
+			Destinations: destinations{
+				&destination{ID: "one", Amount: 100, Tranches: tranches},
+			},
+			Schedule: sc,
+		})
+	}
+
+	for _, tt := range []struct {
+		name string
+		vp   *vestingPool
+		// vested total by the time
+		want map[common.Timestamp]currency.Coin
+	}{
+		{
+			name: "cliff_linear",
+			vp:   newPool(schedule{Type: scheduleCliffLinear, Cliff: s(25)}),
+			want: map[common.Timestamp]currency.Coin{
+				100: 0, 124: 0, 125: 25, 150: 50, 200: 100,
+			},
+		},
+		{
+			name: "periodic",
+			vp:   newPool(schedule{Type: schedulePeriodic, Period: s(30)}),
+			want: map[common.Timestamp]currency.Coin{
+				100: 0, 129: 0, 130: 25, 150: 25, 160: 50, 190: 75, 199: 75, 200: 100,
+			},
+		},
+		{
+			name: "table",
+			vp: newPool(schedule{Type: scheduleTable},
+				tranche{Time: 100, Amount: 10},
+				tranche{Time: 150, Amount: 60},
+				tranche{Time: 180, Amount: 30}),
+			want: map[common.Timestamp]currency.Coin{
+				100: 10, 149: 10, 150: 70, 180: 100, 200: 100,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			for now, want := range tt.want {
+				inf, err := tt.vp.info(now)
+				require.NoError(t, err)
+				require.Len(t, inf.Destinations, 1)
+				assert.Equal(t, tt.vp.Schedule, inf.Schedule)
+				assert.Equal(t, want, inf.Destinations[0].Earned, "at %d", now)
+				assert.Equal(t, 100-want, inf.Destinations[0].Unvested, "at %d", now)
+			}
+
+			// vesting moves only the difference with already vested
+			var d = tt.vp.Destinations[0]
+			value, err := tt.vp.unlock(d, 150, false)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want[150], value)
+			assert.Equal(t, tt.want[150], d.Vested)
+
+			value, err = tt.vp.unlock(d, 150, false)
+			require.NoError(t, err)
+			assert.Zero(t, value)
+
+			value, err = tt.vp.unlock(d, 200, false)
+			require.NoError(t, err)
+			assert.Equal(t, 100-tt.want[150], value)
+			assert.Equal(t, currency.Coin(100), d.Vested)
+		})
+	}
+}
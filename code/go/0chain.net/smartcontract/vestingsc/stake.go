@@ -0,0 +1,268 @@
+package vestingsc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/0chain/common/core/currency"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/chaincore/state"
+	"0chain.net/chaincore/transaction"
+	"0chain.net/core/common"
+	"0chain.net/smartcontract/minersc"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+	"0chain.net/smartcontract/storagesc"
+)
+
+//msgp:ignore stakeRequest stakingSC
+//go:generate msgp -io=false -tests=false -unexported=true -v
+
+func init() {
+	stakepool.SetVestingStaker(vestingStaker{})
+}
+
+// stakingSC is a SC of providers unvested tokens can be staked to
+type stakingSC struct {
+	address string
+	get     func(providerType spenum.Provider, providerID string,
+		balances chainstate.CommonStateContextI) (stakepool.AbstractStakePool, error)
+}
+
+var stakingSCs = map[spenum.Provider]stakingSC{
+	spenum.Blobber:   {storagesc.ADDRESS, storagesc.GetStakePoolAdapter},
+	spenum.Validator: {storagesc.ADDRESS, storagesc.GetStakePoolAdapter},
+	spenum.Miner:     {minersc.ADDRESS, minersc.GetStakePoolAdapter},
+	spenum.Sharder:   {minersc.ADDRESS, minersc.GetStakePoolAdapter},
+}
+
+//
+// stake unvested tokens of a destination
+//
+
+type stakeRequest struct {
+	PoolID       string          `json:"pool_id"`
+	ProviderType spenum.Provider `json:"provider_type"`
+	ProviderID   string          `json:"provider_id"`
+	Amount       currency.Coin   `json:"amount"`
+}
+
+func (sr *stakeRequest) decode(b []byte) error {
+	return json.Unmarshal(b, sr)
+}
+
+// vestingStake is unvested tokens of a destination staked to a provider,
+// the provider delegate pool is the one of the destination
+type vestingStake struct {
+	DestinationID string          `json:"destination_id"`
+	ProviderType  spenum.Provider `json:"provider_type"`
+	ProviderID    string          `json:"provider_id"`
+	Amount        currency.Coin   `json:"amount"`
+	// Receiver of the tokens on unstake if the destination has stopped or
+	// clawed back, empty while the tokens return to the pool.
+	Receiver string `json:"receiver,omitempty"`
+}
+
+// staked returns tokens of the destination staked to providers
+func (vp *vestingPool) staked(destID string) (staked currency.Coin, err error) {
+	for _, st := range vp.Stakes {
+		if st.DestinationID != destID || st.Receiver != "" {
+			continue
+		}
+		if staked, err = currency.AddCoin(staked, st.Amount); err != nil {
+			return 0, err
+		}
+	}
+	return
+}
+
+// liquid returns tokens of the destination the pool holds
+func (vp *vestingPool) liquid(d *destination) (currency.Coin, error) {
+	left, err := d.left()
+	if err != nil {
+		return 0, err
+	}
+	staked, err := vp.staked(d.ID)
+	if err != nil {
+		return 0, err
+	}
+	return currency.MinusCoin(left, staked)
+}
+
+func (vp *vestingPool) findStake(destID string, providerType spenum.Provider,
+	providerID string) (i int, st *vestingStake) {
+
+	for i, st = range vp.Stakes {
+		if st.DestinationID == destID && st.ProviderType == providerType &&
+			st.ProviderID == providerID {
+			return
+		}
+	}
+	return -1, nil
+}
+
+// revokeStakes of the destination, the tokens go to the receiver on unstake
+func (vp *vestingPool) revokeStakes(destID, receiver string) {
+	for _, st := range vp.Stakes {
+		if st.DestinationID == destID && st.Receiver == "" {
+			st.Receiver = receiver
+		}
+	}
+}
+
+// stake moves unvested tokens of the destination to stake pool of the
+// provider, the vesting continues and the rewards go to the destination
+func (vsc *VestingSmartContract) stake(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var sr stakeRequest
+	if err = sr.decode(input); err != nil {
+		return "", common.NewError("stake_vesting_failed",
+			"malformed request: "+err.Error())
+	}
+
+	if sr.Amount == 0 {
+		return "", common.NewError("stake_vesting_failed", "zero stake")
+	}
+
+	ssc, ok := stakingSCs[sr.ProviderType]
+	if !ok {
+		return "", common.NewError("stake_vesting_failed",
+			"can't stake to "+sr.ProviderType.String())
+	}
+
+	var vp *vestingPool
+	if vp, err = vsc.getPool(sr.PoolID, balances); err != nil {
+		return "", common.NewError("stake_vesting_failed",
+			"can't get vesting pool: "+err.Error())
+	}
+
+	if t.CreationDate >= vp.ExpireAt {
+		return "", common.NewError("stake_vesting_failed", "expired pool")
+	}
+
+	var d *destination
+	if d, err = vp.find(t.ClientID); err != nil {
+		return "", common.NewError("stake_vesting_failed",
+			"only a destination can stake its tokens: "+err.Error())
+	}
+
+	var now = t.CreationDate
+	if now < vp.StartTime {
+		now = vp.StartTime
+	}
+
+	var liquid, earned currency.Coin
+	if liquid, err = vp.liquid(d); err != nil {
+		return "", common.NewError("stake_vesting_failed", err.Error())
+	}
+	if earned, err = vp.unlock(d, now, true); err != nil {
+		return "", common.NewError("stake_vesting_failed", err.Error())
+	}
+	if sr.Amount > liquid-earned {
+		return "", common.NewError("stake_vesting_failed",
+			"not enough unvested tokens to stake")
+	}
+
+	resp, err = stakepool.VestingStakePoolLock(t, vp.ID, d.ID, sr.Amount,
+		sr.ProviderType, sr.ProviderID, balances, ssc.get)
+	if err != nil {
+		return "", err
+	}
+
+	var transfer *state.Transfer
+	transfer, _, err = vp.DrainPool(t.ToClientID, ssc.address, sr.Amount, nil)
+	if err != nil {
+		return "", common.NewError("stake_vesting_failed",
+			"draining vesting pool: "+err.Error())
+	}
+	if err = balances.AddTransfer(transfer); err != nil {
+		return "", common.NewError("stake_vesting_failed",
+			"adding transfer vesting_pool->stake_pool: "+err.Error())
+	}
+
+	if _, st := vp.findStake(d.ID, sr.ProviderType, sr.ProviderID); st != nil {
+		if st.Amount, err = currency.AddCoin(st.Amount, sr.Amount); err != nil {
+			return "", common.NewError("stake_vesting_failed", err.Error())
+		}
+	} else {
+		vp.Stakes = append(vp.Stakes, &vestingStake{
+			DestinationID: d.ID,
+			ProviderType:  sr.ProviderType,
+			ProviderID:    sr.ProviderID,
+			Amount:        sr.Amount,
+		})
+	}
+
+	if err = vp.save(balances); err != nil {
+		return "", common.NewError("stake_vesting_failed",
+			"saving pool: "+err.Error())
+	}
+
+	return
+}
+
+// vestingStaker unstakes delegate pools staked from vesting pools
+type vestingStaker struct{}
+
+// Unstake implements stakepool.VestingStaker. The tokens return to the
+// vesting pool, or go to the receiver of a stopped destination. A slashed
+// stake reduces amount of the destination.
+func (vestingStaker) Unstake(clientID, poolID, delegateID string,
+	providerType spenum.Provider, providerID string, amount currency.Coin,
+	balances chainstate.StateContextI) (receiver string, err error) {
+
+	var vp *vestingPool
+	if vp, err = getPool(poolID, balances); err != nil {
+		return "", fmt.Errorf("can't get vesting pool: %v", err)
+	}
+
+	var i, st = vp.findStake(delegateID, providerType, providerID)
+	if st == nil {
+		return "", errors.New("no such stake in the vesting pool")
+	}
+
+	switch clientID {
+	case delegateID:
+	case vp.ClientID:
+		if st.Receiver == "" {
+			if err = vp.revocable(balances.GetTransaction().CreationDate); err != nil {
+				return "", err
+			}
+		}
+	default:
+		return "", errors.New("only the destination or the pool owner can unstake")
+	}
+
+	vp.Stakes = append(vp.Stakes[:i], vp.Stakes[i+1:]...)
+
+	if st.Receiver != "" {
+		receiver = st.Receiver
+	} else {
+		receiver = ADDRESS
+		if vp.Balance, err = currency.AddCoin(vp.Balance, amount); err != nil {
+			return "", err
+		}
+		if amount < st.Amount {
+			var d *destination
+			if d, err = vp.find(delegateID); err != nil {
+				return "", err
+			}
+			var slashed currency.Coin
+			if slashed, err = currency.MinusCoin(st.Amount, amount); err != nil {
+				return "", err
+			}
+			if d.Amount, err = currency.MinusCoin(d.Amount, slashed); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if err = vp.save(balances); err != nil {
+		return "", fmt.Errorf("saving vesting pool: %v", err)
+	}
+
+	return
+}
@@ -0,0 +1,198 @@
+package vestingsc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/0chain/common/core/currency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	chainstate "0chain.net/chaincore/chain/state"
+	"0chain.net/core/common"
+	"0chain.net/core/encryption"
+	"0chain.net/smartcontract/stakepool"
+	"0chain.net/smartcontract/stakepool/spenum"
+)
+
+// withTestStakingSC replaces blobbers SC by single stake pool in memory
+func withTestStakingSC(t *testing.T) (sp *stakepool.StakePool) {
+	sp = stakepool.NewStakePool()
+	sp.Settings.MaxStake = 1000e10
+	sp.Settings.MaxNumDelegates = 10
+
+	var orig = stakingSCs[spenum.Blobber]
+	stakingSCs[spenum.Blobber] = stakingSC{
+		address: "storage_sc",
+		get: func(spenum.Provider, string, chainstate.CommonStateContextI) (
+			stakepool.AbstractStakePool, error) {
+			return sp, nil
+		},
+	}
+	t.Cleanup(func() { stakingSCs[spenum.Blobber] = orig })
+	return
+}
+
+func TestVestingSmartContract_stake(t *testing.T) {
+	var (
+		vsc      = newTestVestingSC()
+		balances = newTestBalances()
+		client   = newClient(1200e10, balances)
+		sp       = withTestStakingSC(t)
+		err      = InitConfig(balances)
+	)
+	require.NoError(t, err)
+	configureConfig()
+
+	resp, err := client.add(t, vsc, &addRequest{
+		StartTime: 10,
+		Duration:  10 * time.Second,
+		Destinations: destinations{
+			&destination{ID: "one", Amount: 100e10},
+			&destination{ID: "two", Amount: 200e10},
+		},
+	}, 300e10, 0, balances)
+	require.NoError(t, err)
+	var vp vestingPool
+	require.NoError(t, vp.Decode([]byte(resp)))
+
+	var (
+		tx = newTransaction("one", vsc.ID, 0, 15)
+		sr = stakeRequest{
+			PoolID:       vp.ID,
+			ProviderType: spenum.Blobber,
+			ProviderID:   "blobber",
+			Amount:       60e10,
+		}
+	)
+	balances.txn = tx
+
+	// 1. not a destination
+	tx.ClientID = client.id
+	_, err = vsc.stake(tx, mustEncode(t, &sr), balances)
+	requireErrMsg(t, err, "stake_vesting_failed: "+
+		"only a destination can stake its tokens: "+
+		"destination "+client.id+" not found in the pool")
+	tx.ClientID = "one"
+
+	// 2. half of tokens already vested
+	_, err = vsc.stake(tx, mustEncode(t, &sr), balances)
+	requireErrMsg(t, err, "stake_vesting_failed: "+
+		"not enough unvested tokens to stake")
+
+	// 3. stake
+	sr.Amount = 40e10
+	_, err = vsc.stake(tx, mustEncode(t, &sr), balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(40e10), balances.balances["storage_sc"])
+	require.Contains(t, sp.Pools, "one")
+	assert.Equal(t, currency.Coin(40e10), sp.Pools["one"].Balance)
+	assert.Equal(t, vp.ID, sp.Pools["one"].VestingPoolID)
+
+	got, err := vsc.getPool(vp.ID, balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(260e10), got.Balance)
+	inf, err := got.info(15)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(40e10), inf.Destinations[0].Staked)
+	assert.Equal(t, currency.Coin(50e10), inf.Destinations[0].Unvested)
+	assert.Zero(t, inf.Left)
+
+	// 4. vesting is limited by tokens left in the pool
+	tx.CreationDate = 18
+	_, err = vsc.unlock(tx, mustEncode(t, &poolRequest{PoolID: vp.ID}),
+		balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(60e10), balances.balances["one"])
+
+	// 5. the destination can't change while staking
+	_, err = vsc.changeDestination(tx, mustEncode(t, &changeDestinationRequest{
+		PoolID:      vp.ID,
+		Destination: encryption.Hash("one_new"),
+	}), balances)
+	requireErrMsg(t, err, "change_destination_failed: "+
+		"tokens of the destination are staked, unstake them first")
+
+	// 6. the pool can't be deleted while staking
+	tx.ClientID = client.id
+	_, err = vsc.delete(tx, mustEncode(t, &poolRequest{PoolID: vp.ID}),
+		balances)
+	requireErrMsg(t, err, "delete_vesting_pool_failed: "+
+		"tokens of the pool are staked, unstake them first")
+
+	// 7. unstake returns the tokens to the pool, vesting continues
+	receiver, err := vestingStaker{}.Unstake("one", vp.ID, "one",
+		spenum.Blobber, "blobber", 40e10, balances)
+	require.NoError(t, err)
+	assert.Equal(t, ADDRESS, receiver)
+
+	tx.ClientID = "one"
+	tx.CreationDate = 20
+	_, err = vsc.unlock(tx, mustEncode(t, &poolRequest{PoolID: vp.ID}),
+		balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(60e10+40e10), balances.balances["one"])
+}
+
+func TestVestingSmartContract_stake_revoked(t *testing.T) {
+	var (
+		vsc      = newTestVestingSC()
+		balances = newTestBalances()
+		client   = newClient(1200e10, balances)
+		err      = InitConfig(balances)
+	)
+	withTestStakingSC(t)
+	require.NoError(t, err)
+	configureConfig()
+
+	resp, err := client.add(t, vsc, &addRequest{
+		StartTime: 10,
+		Duration:  10 * time.Second,
+		Destinations: destinations{
+			&destination{ID: "one", Amount: 100e10},
+		},
+		Treasury: treasuryID,
+	}, 100e10, 0, balances)
+	require.NoError(t, err)
+	var vp vestingPool
+	require.NoError(t, vp.Decode([]byte(resp)))
+
+	var tx = newTransaction("one", vsc.ID, 0, 12)
+	balances.txn = tx
+	_, err = vsc.stake(tx, mustEncode(t, &stakeRequest{
+		PoolID:       vp.ID,
+		ProviderType: spenum.Blobber,
+		ProviderID:   "blobber",
+		Amount:       50e10,
+	}), balances)
+	require.NoError(t, err)
+
+	// other clients can't unstake
+	_, err = vestingStaker{}.Unstake("another_one", vp.ID, "one",
+		spenum.Blobber, "blobber", 50e10, balances)
+	requireErrMsg(t, err, "only the destination or the pool owner can unstake")
+
+	// clawback moves the tokens in the pool, the staked ones go to the
+	// treasury on unstake
+	tx.ClientID = client.id
+	tx.CreationDate = 15
+	_, err = vsc.clawback(tx, mustEncode(t, &stopRequest{
+		PoolID:      vp.ID,
+		Destination: "one",
+	}), balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(50e10), balances.balances["one"])
+	assert.Zero(t, balances.balances[treasuryID])
+
+	balances.txn = newTransaction(client.id, "storage_sc", 0,
+		common.Timestamp(16))
+	receiver, err := vestingStaker{}.Unstake(client.id, vp.ID, "one",
+		spenum.Blobber, "blobber", 50e10, balances)
+	require.NoError(t, err)
+	assert.Equal(t, treasuryID, receiver)
+
+	got, err := vsc.getPool(vp.ID, balances)
+	require.NoError(t, err)
+	assert.Empty(t, got.Stakes)
+	assert.Zero(t, got.Balance)
+}
@@ -21,10 +21,11 @@ import (
 	"0chain.net/chaincore/transaction"
 	"0chain.net/core/common"
 	"0chain.net/core/datastore"
+	"0chain.net/core/encryption"
 	"github.com/0chain/common/core/util"
 )
 
-//msgp:ignore info destInfo addRequest
+//msgp:ignore info destInfo addRequest changeDestinationRequest
 //go:generate msgp -io=false -tests=false -unexported=true -v
 
 // internal errors
@@ -56,6 +57,19 @@ func (sr *stopRequest) decode(b []byte) error {
 	return json.Unmarshal(b, sr)
 }
 
+//
+// redirect vesting of a destination to new client ID
+//
+
+type changeDestinationRequest struct {
+	PoolID      string `json:"pool_id"`
+	Destination string `json:"destination"` // new destination ID
+}
+
+func (cr *changeDestinationRequest) decode(b []byte) error {
+	return json.Unmarshal(b, cr)
+}
+
 //
 // a destination
 //
@@ -73,6 +87,8 @@ type destination struct {
 	// can produce zero tokens transfer (resolution is a second). The move
 	// will be updated only if a triggering really moves tokens (non zero).
 	Move common.Timestamp `json:"move"`
+	// Tranches of the destination for the table schedule.
+	Tranches []tranche `json:"tranches,omitempty"`
 }
 
 // tokens left for this destination
@@ -166,6 +182,12 @@ type addRequest struct {
 	StartTime    common.Timestamp `json:"start_time"`            //
 	Duration     time.Duration    `json:"duration"`              //
 	Destinations destinations     `json:"destinations"`          //
+	Schedule     schedule         `json:"schedule"`              // linear by default
+	// Irrevocable pool can't be stopped, clawed back or deleted before
+	// its expiration.
+	Irrevocable bool `json:"irrevocable,omitempty"`
+	// Treasury receives unvested tokens clawed back, the owner by default.
+	Treasury string `json:"treasury,omitempty"`
 }
 
 func (ar *addRequest) decode(b []byte) error {
@@ -194,8 +216,11 @@ func (ar *addRequest) validate(now common.Timestamp, conf *config) (err error) {
 		return errors.New("no destinations")
 	case len(ar.Destinations) > conf.MaxDestinations:
 		return errors.New("too many destinations")
+	case ar.Treasury != "" && !encryption.IsHash(ar.Treasury):
+		return errors.New("invalid treasury")
 	}
-	return
+	return ar.Schedule.validate(ar.StartTime, ar.Duration, ar.Destinations,
+		conf)
 }
 
 //
@@ -214,6 +239,11 @@ type vestingPool struct {
 	ExpireAt     common.Timestamp `json:"expire_at"`    //
 	Destinations destinations     `json:"destinations"` //
 	ClientID     string           `json:"client_id"`    // the pool owner
+	Schedule     schedule         `json:"schedule"`     // vesting schedule
+	Irrevocable  bool             `json:"irrevocable"`  // can't be revoked
+	Treasury     string           `json:"treasury"`     // clawback receiver
+	// Stakes of unvested tokens of destinations.
+	Stakes []*vestingStake `json:"stakes,omitempty"`
 }
 
 // newVestingPool returns new empty uninitialized vesting pool.
@@ -235,9 +265,28 @@ func newVestingPoolFromReqeust(clientID datastore.Key, ar *addRequest) (
 	vp.ExpireAt = ar.StartTime + toSeconds(ar.Duration)
 	vp.Destinations = ar.Destinations
 	vp.Destinations.start(vp.StartTime)
+	vp.Schedule = ar.Schedule
+	vp.Irrevocable = ar.Irrevocable
+	vp.Treasury = ar.Treasury
 	return
 }
 
+// treasury returns receiver of tokens clawed back
+func (vp *vestingPool) treasury() datastore.Key {
+	if vp.Treasury == "" {
+		return vp.ClientID
+	}
+	return vp.Treasury
+}
+
+// revocable returns error if the pool can't be revoked at the now
+func (vp *vestingPool) revocable(now common.Timestamp) error {
+	if vp.Irrevocable && now < vp.ExpireAt {
+		return errors.New("irrevocable pool")
+	}
+	return nil
+}
+
 // Encode the vesting pool from JSON value. Implements
 // required util.Serializale interface.
 func (vp *vestingPool) Encode() (b []byte) {
@@ -287,6 +336,42 @@ func (vp *vestingPool) want() (want currency.Coin, err error) {
 	return
 }
 
+// unlock returns amount of tokens to vest for the destination by the now
+// under the pool schedule. The now must be within the pool time range,
+// see the destination.unlock for the dry argument. Staked tokens vest
+// only after they are unstaked back to the pool.
+func (vp *vestingPool) unlock(d *destination, now common.Timestamp,
+	dry bool) (amount currency.Coin, err error) {
+
+	if vp.Schedule.isLinear() {
+		if amount, err = d.unlock(now, vp.ExpireAt, true); err != nil {
+			return 0, err
+		}
+	} else {
+		var vested currency.Coin
+		if vested, err = vp.Schedule.vested(d, now, vp.StartTime, vp.ExpireAt); err != nil {
+			return 0, err
+		}
+		if vested > d.Vested {
+			amount = vested - d.Vested
+		}
+	}
+
+	var liquid currency.Coin
+	if liquid, err = vp.liquid(d); err != nil {
+		return 0, err
+	}
+	if amount > liquid {
+		amount = liquid
+	}
+
+	if !dry {
+		err = d.move(now, amount)
+	}
+
+	return
+}
+
 // fill the pool by client
 func (vp *vestingPool) fill(t *transaction.Transaction,
 	balances chainstate.StateContextI) (resp string, err error) {
@@ -348,7 +433,7 @@ func (vp *vestingPool) trigger(t *transaction.Transaction,
 	)
 	sb.WriteByte('[')
 	for _, d := range vp.Destinations {
-		value, err := d.unlock(now, end, false)
+		value, err := vp.unlock(d, now, false)
 		if err != nil {
 			return "", err
 		}
@@ -375,7 +460,7 @@ func (vp *vestingPool) trigger(t *transaction.Transaction,
 func (vp *vestingPool) excess() (amount currency.Coin, err error) {
 	var need currency.Coin
 	for _, d := range vp.Destinations {
-		destLeft, err := d.left()
+		destLeft, err := vp.liquid(d)
 		if err != nil {
 			return 0, err
 		}
@@ -438,7 +523,7 @@ func (vp *vestingPool) vest(vscID, destID datastore.Key, now common.Timestamp,
 		return
 	}
 
-	value, err := d.unlock(now, end, false)
+	value, err := vp.unlock(d, now, false)
 	if err != nil {
 		return "", err
 	}
@@ -453,6 +538,32 @@ func (vp *vestingPool) vest(vscID, destID datastore.Key, now common.Timestamp,
 	return
 }
 
+// clawback moves unvested tokens of the destination to the treasury,
+// the destination must be vested by now before. The staked tokens go
+// to the treasury on unstake.
+func (vp *vestingPool) clawback(vscKey datastore.Key, d *destination,
+	balances chainstate.StateContextI) (unvested currency.Coin, err error) {
+
+	if unvested, err = vp.liquid(d); err != nil {
+		return
+	}
+	vp.revokeStakes(d.ID, vp.treasury())
+	if unvested == 0 {
+		return
+	}
+
+	var transfer *state.Transfer
+	transfer, _, err = vp.DrainPool(vscKey, vp.treasury(), unvested, nil)
+	if err != nil {
+		return 0, fmt.Errorf("clawing back %s: %v", d.ID, err)
+	}
+	if err = balances.AddTransfer(transfer); err != nil {
+		return 0, fmt.Errorf("adding transfer vesting_pool->treasury: %v", err)
+	}
+
+	return
+}
+
 func (vp *vestingPool) drain(t *transaction.Transaction,
 	balances chainstate.StateContextI) (resp string, err error) {
 
@@ -515,30 +626,50 @@ func (vp *vestingPool) info(now common.Timestamp) (i *info, err error) {
 
 	var dinfos = make([]*destInfo, 0, len(vp.Destinations))
 	for _, d := range vp.Destinations {
-		value, err := d.unlock(now, end, true)
+		value, err := vp.unlock(d, now, true)
+		if err != nil {
+			return nil, err
+		}
+		left, err := d.left()
+		if err != nil {
+			return nil, err
+		}
+		unvested, err := currency.MinusCoin(left, value)
+		if err != nil {
+			return nil, err
+		}
+		staked, err := vp.staked(d.ID)
 		if err != nil {
 			return nil, err
 		}
 		dinfos = append(dinfos, &destInfo{
-			ID:     d.ID,
-			Wanted: d.Amount,
-			Earned: value,
-			Vested: d.Vested,
-			Last:   d.Last,
+			ID:       d.ID,
+			Wanted:   d.Amount,
+			Earned:   value,
+			Vested:   d.Vested,
+			Unvested: unvested,
+			Staked:   staked,
+			Last:     d.Last,
 		})
 	}
 
 	i.Destinations = dinfos
 	i.ClientID = vp.ClientID
+	i.Schedule = vp.Schedule
+	i.Irrevocable = vp.Irrevocable
+	i.Treasury = vp.treasury()
+	i.Stakes = vp.Stakes
 	return
 }
 
 type destInfo struct {
-	ID     datastore.Key    `json:"id"`     // identifier
-	Wanted currency.Coin    `json:"wanted"` // wanted amount for entire period
-	Earned currency.Coin    `json:"earned"` // can unlock
-	Vested currency.Coin    `json:"vested"` // tokens already vested
-	Last   common.Timestamp `json:"last"`   // last time unlocked
+	ID       datastore.Key    `json:"id"`       // identifier
+	Wanted   currency.Coin    `json:"wanted"`   // wanted amount for entire period
+	Earned   currency.Coin    `json:"earned"`   // can unlock
+	Vested   currency.Coin    `json:"vested"`   // tokens already vested
+	Unvested currency.Coin    `json:"unvested"` // not vested yet by the schedule
+	Staked   currency.Coin    `json:"staked"`   // unvested tokens staked
+	Last     common.Timestamp `json:"last"`     // last time unlocked
 }
 
 // swagger:model vestingInfo
@@ -551,6 +682,10 @@ type info struct {
 	ExpireAt     common.Timestamp `json:"expire_at"`    // until
 	Destinations []*destInfo      `json:"destinations"` // receivers
 	ClientID     datastore.Key    `json:"client_id"`    // owner
+	Schedule     schedule         `json:"schedule"`     // vesting schedule
+	Irrevocable  bool             `json:"irrevocable"`  // can't be revoked
+	Treasury     datastore.Key    `json:"treasury"`     // clawback receiver
+	Stakes       []*vestingStake  `json:"stakes"`       // unvested tokens staked
 }
 
 //
@@ -677,6 +812,10 @@ func (vsc *VestingSmartContract) stop(t *transaction.Transaction,
 			"only owner can stop a vesting")
 	}
 
+	if err = vp.revocable(t.CreationDate); err != nil {
+		return "", common.NewError("stop_vesting_failed", err.Error())
+	}
+
 	if t.CreationDate > vp.ExpireAt {
 		return "", common.NewError("stop_vesting_failed", "expired pool")
 	}
@@ -686,6 +825,9 @@ func (vsc *VestingSmartContract) stop(t *transaction.Transaction,
 		return "", common.NewError("stop_vesting_failed", err.Error())
 	}
 
+	// staked tokens go to the owner on unstake
+	vp.revokeStakes(sr.Destination, vp.ClientID)
+
 	if err = vp.delete(sr.Destination); err != nil {
 		return "", common.NewError("stop_vesting_failed",
 			"deleting destination: "+err.Error())
@@ -699,6 +841,142 @@ func (vsc *VestingSmartContract) stop(t *transaction.Transaction,
 	return sr.Destination + " has deleted from the vesting pool", nil
 }
 
+// clawback vests tokens of a destination released by now and moves the
+// unvested ones to the treasury of the pool
+func (vsc *VestingSmartContract) clawback(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var cr stopRequest
+	if err = cr.decode(input); err != nil {
+		return "", common.NewError("clawback_vesting_failed",
+			"malformed request: "+err.Error())
+	}
+
+	if cr.Destination == "" {
+		return "", common.NewError("clawback_vesting_failed",
+			"missing destination to claw back")
+	}
+
+	var vp *vestingPool
+	if vp, err = vsc.getPool(cr.PoolID, balances); err != nil {
+		return "", common.NewError("clawback_vesting_failed",
+			"can't get vesting pool: "+err.Error())
+	}
+
+	if vp.ClientID != t.ClientID {
+		return "", common.NewError("clawback_vesting_failed",
+			"only owner can claw back a vesting")
+	}
+
+	if t.CreationDate > vp.ExpireAt {
+		return "", common.NewError("clawback_vesting_failed", "expired pool")
+	}
+
+	if err = vp.revocable(t.CreationDate); err != nil {
+		return "", common.NewError("clawback_vesting_failed", err.Error())
+	}
+
+	_, err = vp.vest(t.ToClientID, cr.Destination, t.CreationDate, balances)
+	if err != nil && err != errZeroVesting {
+		return "", common.NewError("clawback_vesting_failed", err.Error())
+	}
+
+	var d *destination
+	if d, err = vp.find(cr.Destination); err != nil {
+		return "", common.NewError("clawback_vesting_failed", err.Error())
+	}
+
+	var unvested currency.Coin
+	if unvested, err = vp.clawback(t.ToClientID, d, balances); err != nil {
+		return "", common.NewError("clawback_vesting_failed", err.Error())
+	}
+
+	if err = vp.delete(cr.Destination); err != nil {
+		return "", common.NewError("clawback_vesting_failed",
+			"deleting destination: "+err.Error())
+	}
+
+	if err = vp.save(balances); err != nil {
+		return "", common.NewError("clawback_vesting_failed",
+			"saving pool: "+err.Error())
+	}
+
+	return fmt.Sprintf(`{"pool_id":"%s","destination":"%s","clawback":%d,"treasury":"%s"}`,
+		vp.ID, cr.Destination, unvested, vp.treasury()), nil
+}
+
+// changeDestination redirects future vesting of the destination signed
+// the transaction to new client ID, tokens released by now are vested to
+// the current destination
+func (vsc *VestingSmartContract) changeDestination(t *transaction.Transaction,
+	input []byte, balances chainstate.StateContextI) (resp string, err error) {
+
+	var cr changeDestinationRequest
+	if err = cr.decode(input); err != nil {
+		return "", common.NewError("change_destination_failed",
+			"malformed request: "+err.Error())
+	}
+
+	if cr.Destination == "" {
+		return "", common.NewError("change_destination_failed",
+			"missing new destination")
+	}
+
+	if !encryption.IsHash(cr.Destination) {
+		return "", common.NewError("change_destination_failed",
+			"invalid new destination")
+	}
+
+	if cr.Destination == t.ClientID {
+		return "", common.NewError("change_destination_failed",
+			"the same destination")
+	}
+
+	var vp *vestingPool
+	if vp, err = vsc.getPool(cr.PoolID, balances); err != nil {
+		return "", common.NewError("change_destination_failed",
+			"can't get vesting pool: "+err.Error())
+	}
+
+	if t.CreationDate > vp.ExpireAt {
+		return "", common.NewError("change_destination_failed", "expired pool")
+	}
+
+	var d *destination
+	if d, err = vp.find(t.ClientID); err != nil {
+		return "", common.NewError("change_destination_failed",
+			"only a destination can change itself: "+err.Error())
+	}
+
+	if _, err = vp.find(cr.Destination); err == nil {
+		return "", common.NewError("change_destination_failed",
+			"new destination is already in the pool")
+	}
+
+	var staked currency.Coin
+	if staked, err = vp.staked(d.ID); err != nil {
+		return "", common.NewError("change_destination_failed", err.Error())
+	}
+	if staked > 0 {
+		return "", common.NewError("change_destination_failed",
+			"tokens of the destination are staked, unstake them first")
+	}
+
+	_, err = vp.vest(t.ToClientID, t.ClientID, t.CreationDate, balances)
+	if err != nil && err != errZeroVesting {
+		return "", common.NewError("change_destination_failed", err.Error())
+	}
+
+	d.ID = cr.Destination
+
+	if err = vp.save(balances); err != nil {
+		return "", common.NewError("change_destination_failed",
+			"saving pool: "+err.Error())
+	}
+
+	return t.ClientID + " vesting has moved to " + cr.Destination, nil
+}
+
 func (vsc *VestingSmartContract) delete(t *transaction.Transaction,
 	input []byte, balances chainstate.StateContextI) (resp string, err error) {
 
@@ -729,6 +1007,15 @@ func (vsc *VestingSmartContract) delete(t *transaction.Transaction,
 			"only pool owner can delete the pool")
 	}
 
+	if err = vp.revocable(t.CreationDate); err != nil {
+		return "", common.NewError("delete_vesting_pool_failed", err.Error())
+	}
+
+	if len(vp.Stakes) > 0 {
+		return "", common.NewError("delete_vesting_pool_failed",
+			"tokens of the pool are staked, unstake them first")
+	}
+
 	// move tokens to destinations
 	if vp.Balance > 0 {
 		if _, err = vp.trigger(t, balances); err != nil {
@@ -9,9 +9,9 @@ import (
 // MarshalMsg implements msgp.Marshaler
 func (z *destination) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 5
+	// map header, size 6
 	// string "ID"
-	o = append(o, 0x85, 0xa2, 0x49, 0x44)
+	o = append(o, 0x86, 0xa2, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ID)
 	// string "Amount"
 	o = append(o, 0xa6, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74)
@@ -41,6 +41,16 @@ func (z *destination) MarshalMsg(b []byte) (o []byte, err error) {
 		err = msgp.WrapError(err, "Move")
 		return
 	}
+	// string "Tranches"
+	o = append(o, 0xa8, 0x54, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Tranches)))
+	for za0001 := range z.Tranches {
+		o, err = z.Tranches[za0001].MarshalMsg(o)
+		if err != nil {
+			err = msgp.WrapError(err, "Tranches", za0001)
+			return
+		}
+	}
 	return
 }
 
@@ -92,6 +102,25 @@ func (z *destination) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Move")
 				return
 			}
+		case "Tranches":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Tranches")
+				return
+			}
+			if cap(z.Tranches) >= int(zb0002) {
+				z.Tranches = (z.Tranches)[:zb0002]
+			} else {
+				z.Tranches = make([]tranche, zb0002)
+			}
+			for za0001 := range z.Tranches {
+				bts, err = z.Tranches[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Tranches", za0001)
+					return
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -106,7 +135,10 @@ func (z *destination) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *destination) Msgsize() (s int) {
-	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 7 + z.Amount.Msgsize() + 7 + z.Vested.Msgsize() + 5 + z.Last.Msgsize() + 5 + z.Move.Msgsize()
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 7 + z.Amount.Msgsize() + 7 + z.Vested.Msgsize() + 5 + z.Last.Msgsize() + 5 + z.Move.Msgsize() + 9 + msgp.ArrayHeaderSize
+	for za0001 := range z.Tranches {
+		s += z.Tranches[za0001].Msgsize()
+	}
 	return
 }
 
@@ -292,9 +324,9 @@ func (z stopRequest) Msgsize() (s int) {
 // MarshalMsg implements msgp.Marshaler
 func (z *vestingPool) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 6
+	// map header, size 10
 	// string "ZcnPool"
-	o = append(o, 0x86, 0xa7, 0x5a, 0x63, 0x6e, 0x50, 0x6f, 0x6f, 0x6c)
+	o = append(o, 0x8a, 0xa7, 0x5a, 0x63, 0x6e, 0x50, 0x6f, 0x6f, 0x6c)
 	o, err = z.ZcnPool.MarshalMsg(o)
 	if err != nil {
 		err = msgp.WrapError(err, "ZcnPool")
@@ -334,6 +366,33 @@ func (z *vestingPool) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "ClientID"
 	o = append(o, 0xa8, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44)
 	o = msgp.AppendString(o, z.ClientID)
+	// string "Schedule"
+	o = append(o, 0xa8, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65)
+	o, err = z.Schedule.MarshalMsg(o)
+	if err != nil {
+		err = msgp.WrapError(err, "Schedule")
+		return
+	}
+	// string "Irrevocable"
+	o = append(o, 0xab, 0x49, 0x72, 0x72, 0x65, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x6c, 0x65)
+	o = msgp.AppendBool(o, z.Irrevocable)
+	// string "Treasury"
+	o = append(o, 0xa8, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79)
+	o = msgp.AppendString(o, z.Treasury)
+	// string "Stakes"
+	o = append(o, 0xa6, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Stakes)))
+	for za0002 := range z.Stakes {
+		if z.Stakes[za0002] == nil {
+			o = msgp.AppendNil(o)
+		} else {
+			o, err = z.Stakes[za0002].MarshalMsg(o)
+			if err != nil {
+				err = msgp.WrapError(err, "Stakes", za0002)
+				return
+			}
+		}
+	}
 	return
 }
 
@@ -415,6 +474,54 @@ func (z *vestingPool) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "ClientID")
 				return
 			}
+		case "Schedule":
+			bts, err = z.Schedule.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Schedule")
+				return
+			}
+		case "Irrevocable":
+			z.Irrevocable, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Irrevocable")
+				return
+			}
+		case "Treasury":
+			z.Treasury, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Treasury")
+				return
+			}
+		case "Stakes":
+			var zb0003 uint32
+			zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Stakes")
+				return
+			}
+			if cap(z.Stakes) >= int(zb0003) {
+				z.Stakes = (z.Stakes)[:zb0003]
+			} else {
+				z.Stakes = make([]*vestingStake, zb0003)
+			}
+			for za0002 := range z.Stakes {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.Stakes[za0002] = nil
+				} else {
+					if z.Stakes[za0002] == nil {
+						z.Stakes[za0002] = new(vestingStake)
+					}
+					bts, err = z.Stakes[za0002].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Stakes", za0002)
+						return
+					}
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -437,6 +544,13 @@ func (z *vestingPool) Msgsize() (s int) {
 			s += z.Destinations[za0001].Msgsize()
 		}
 	}
-	s += 9 + msgp.StringPrefixSize + len(z.ClientID)
+	s += 9 + msgp.StringPrefixSize + len(z.ClientID) + 9 + z.Schedule.Msgsize() + 12 + msgp.BoolSize + 9 + msgp.StringPrefixSize + len(z.Treasury) + 7 + msgp.ArrayHeaderSize
+	for za0002 := range z.Stakes {
+		if z.Stakes[za0002] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.Stakes[za0002].Msgsize()
+		}
+	}
 	return
 }
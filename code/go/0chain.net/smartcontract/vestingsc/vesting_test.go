@@ -11,6 +11,7 @@ import (
 	"github.com/0chain/common/core/currency"
 
 	"0chain.net/core/common"
+	"0chain.net/core/encryption"
 	"github.com/0chain/common/core/util"
 	"github.com/stretchr/testify/mock"
 
@@ -18,6 +19,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// treasuryID receives tokens clawed back in tests
+var treasuryID = encryption.Hash("treasury")
+
 func mockSetValue(v interface{}) interface{} {
 	return mock.MatchedBy(func(c interface{}) bool {
 		cv := reflect.ValueOf(c)
@@ -96,6 +100,10 @@ func Test_addRequest_validate(t *testing.T) {
 		&destination{ID: "two", Amount: 20},
 	}
 
+	ar.Treasury = "treasury"
+	requireErrMsg(t, ar.validate(10, conf), "invalid treasury")
+	ar.Treasury = ""
+
 	assert.NoError(t, ar.validate(10, conf))
 	ar.StartTime = 0
 	assert.NoError(t, ar.validate(10, conf))
@@ -134,8 +142,8 @@ func Test_vestingPool(t *testing.T) {
 	assert.Equal(t, vp.StartTime, inf.StartTime)
 	assert.Equal(t, vp.ExpireAt, inf.ExpireAt)
 	assert.EqualValues(t, []*destInfo{
-		{ID: "one", Wanted: 10, Earned: 5, Vested: 0, Last: 10},
-		{ID: "two", Wanted: 20, Earned: 10, Vested: 0, Last: 10},
+		{ID: "one", Wanted: 10, Earned: 5, Vested: 0, Unvested: 5, Last: 10},
+		{ID: "two", Wanted: 20, Earned: 10, Vested: 0, Unvested: 10, Last: 10},
 	}, inf.Destinations) // TODO
 	assert.Equal(t, currency.Coin(40), inf.Balance)
 	assert.Equal(t, currency.Coin(10), inf.Left)
@@ -384,6 +392,159 @@ func TestVestingSmartContract_stop(t *testing.T) {
 
 }
 
+func TestVestingSmartContract_clawback(t *testing.T) {
+	var (
+		vsc      = newTestVestingSC()
+		balances = newTestBalances()
+		client   = newClient(1200e10, balances)
+		tp       = common.Timestamp(0)
+		cr       stopRequest
+		err      = InitConfig(balances)
+	)
+	require.NoError(t, err)
+	configureConfig()
+
+	newPool := func(irrevocable bool) string {
+		resp, err := client.add(t, vsc, &addRequest{
+			StartTime: 10,
+			Duration:  10 * time.Second,
+			Destinations: destinations{
+				&destination{ID: "one", Amount: 100e10},
+				&destination{ID: "two", Amount: 200e10},
+			},
+			Irrevocable: irrevocable,
+			Treasury:    treasuryID,
+		}, 300e10, tp, balances)
+		require.NoError(t, err)
+		var vp vestingPool
+		require.NoError(t, vp.Decode([]byte(resp)))
+		return vp.ID
+	}
+
+	var tx = newTransaction(client.id, vsc.ID, 0, 15)
+	balances.txn = tx
+
+	// 1. missing destination
+	cr.PoolID = newPool(false)
+	_, err = vsc.clawback(tx, mustEncode(t, &cr), balances)
+	requireErrMsg(t, err, "clawback_vesting_failed: "+
+		"missing destination to claw back")
+
+	// 2. not owner
+	cr.Destination = "one"
+	tx.ClientID = "another_one"
+	_, err = vsc.clawback(tx, mustEncode(t, &cr), balances)
+	requireErrMsg(t, err, "clawback_vesting_failed: "+
+		"only owner can claw back a vesting")
+	tx.ClientID = client.id
+
+	// 3. vested half goes to the destination, unvested one to the treasury
+	_, err = vsc.clawback(tx, mustEncode(t, &cr), balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(50e10), balances.balances["one"])
+	assert.Equal(t, currency.Coin(50e10), balances.balances[treasuryID])
+
+	got, err := vsc.getPool(cr.PoolID, balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(200e10), got.Balance)
+	require.Len(t, got.Destinations, 1)
+	assert.Equal(t, "two", got.Destinations[0].ID)
+
+	// 4. irrevocable pool
+	var sr = stopRequest{PoolID: newPool(true), Destination: "one"}
+	tx.CreationDate = 15
+	_, err = vsc.clawback(tx, mustEncode(t, &sr), balances)
+	requireErrMsg(t, err, "clawback_vesting_failed: irrevocable pool")
+	_, err = vsc.stop(tx, mustEncode(t, &sr), balances)
+	requireErrMsg(t, err, "stop_vesting_failed: irrevocable pool")
+	var dr = poolRequest{PoolID: sr.PoolID}
+	_, err = vsc.delete(tx, mustEncode(t, &dr), balances)
+	requireErrMsg(t, err, "delete_vesting_pool_failed: irrevocable pool")
+
+	// 5. irrevocable pool can be deleted after expiration
+	tx.CreationDate = 20
+	_, err = vsc.delete(tx, mustEncode(t, &dr), balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(150e10), balances.balances["one"])
+}
+
+func TestVestingSmartContract_changeDestination(t *testing.T) {
+	var (
+		vsc      = newTestVestingSC()
+		balances = newTestBalances()
+		client   = newClient(1200e10, balances)
+		tp       = common.Timestamp(0)
+		two      = encryption.Hash("two")
+		err      = InitConfig(balances)
+	)
+	require.NoError(t, err)
+	configureConfig()
+
+	resp, err := client.add(t, vsc, &addRequest{
+		StartTime: 10,
+		Duration:  10 * time.Second,
+		Destinations: destinations{
+			&destination{ID: "one", Amount: 100e10},
+			&destination{ID: two, Amount: 200e10},
+		},
+	}, 300e10, tp, balances)
+	require.NoError(t, err)
+	var vp vestingPool
+	require.NoError(t, vp.Decode([]byte(resp)))
+
+	var (
+		cr      = changeDestinationRequest{PoolID: vp.ID}
+		tx      = newTransaction("one", vsc.ID, 0, 15)
+		newDest = encryption.Hash("one_new")
+	)
+	balances.txn = tx
+
+	// 1. missing destination
+	_, err = vsc.changeDestination(tx, mustEncode(t, &cr), balances)
+	requireErrMsg(t, err, "change_destination_failed: missing new destination")
+
+	// 2. not a client ID
+	cr.Destination = "one_new"
+	_, err = vsc.changeDestination(tx, mustEncode(t, &cr), balances)
+	requireErrMsg(t, err, "change_destination_failed: invalid new destination")
+
+	// 3. the same
+	tx.ClientID = newDest
+	cr.Destination = newDest
+	_, err = vsc.changeDestination(tx, mustEncode(t, &cr), balances)
+	requireErrMsg(t, err, "change_destination_failed: the same destination")
+
+	// 4. already in the pool
+	tx.ClientID = "one"
+	cr.Destination = two
+	_, err = vsc.changeDestination(tx, mustEncode(t, &cr), balances)
+	requireErrMsg(t, err, "change_destination_failed: "+
+		"new destination is already in the pool")
+
+	// 5. not a destination
+	cr.Destination = newDest
+	tx.ClientID = client.id
+	_, err = vsc.changeDestination(tx, mustEncode(t, &cr), balances)
+	requireErrMsg(t, err, "change_destination_failed: "+
+		"only a destination can change itself: "+
+		"destination "+client.id+" not found in the pool")
+
+	// 6. change, vested tokens go to the old destination
+	tx.ClientID = "one"
+	_, err = vsc.changeDestination(tx, mustEncode(t, &cr), balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(50e10), balances.balances["one"])
+
+	// 7. future vesting goes to the new one
+	tx = newTransaction(client.id, vsc.ID, 0, 20)
+	balances.txn = tx
+	_, err = vsc.trigger(tx, mustEncode(t, &poolRequest{PoolID: vp.ID}),
+		balances)
+	require.NoError(t, err)
+	assert.Equal(t, currency.Coin(50e10), balances.balances["one"])
+	assert.Equal(t, currency.Coin(50e10), balances.balances[newDest])
+}
+
 func TestVestingSmartContract_unlock(t *testing.T) {
 	var (
 		vsc      = newTestVestingSC()
@@ -33,6 +33,9 @@ const (
 	OwnerID            = "owner_id"
 	Cost               = "cost"
 	MaxDelegates       = "max_delegates"
+
+	CommissionNoticePeriod = "commission_notice_period"
+	MaxCommissionIncrease  = "max_commission_increase"
 )
 
 var CostFunctions = []string{
@@ -69,7 +72,7 @@ func (zcn *ZCNSmartContract) UpdateGlobalConfig(t *transaction.Transaction, inpu
 		return "", errors.Wrap(err, Code)
 	}
 
-	if err := smartcontractinterface.AuthorizeWithOwner(FuncName, func() bool {
+	if err := smartcontractinterface.AuthorizeWithGovernance(FuncName, t.ClientID, func() bool {
 		return gn.OwnerId == t.ClientID
 	}); err != nil {
 		return "", errors.Wrap(err, Code)
@@ -109,6 +112,9 @@ func (gn *GlobalNode) ToStringMap() smartcontract.StringMap {
 		BurnAddress:        fmt.Sprintf("%v", gn.BurnAddress),
 		OwnerID:            fmt.Sprintf("%v", gn.OwnerId),
 		MaxDelegates:       fmt.Sprintf("%v", gn.MaxDelegates),
+
+		CommissionNoticePeriod: fmt.Sprintf("%v", gn.CommissionNoticePeriod),
+		MaxCommissionIncrease:  fmt.Sprintf("%v", gn.MaxCommissionIncrease),
 	}
 
 	for _, key := range CostFunctions {
@@ -137,6 +143,8 @@ func getConfig() (conf *ZCNSConfig) {
 	conf.OwnerId = cfg.GetString(postfix(OwnerID))
 	conf.Cost = cfg.GetStringMapInt(postfix(Cost))
 	conf.MaxDelegates = cfg.GetInt(postfix(MaxDelegates))
+	conf.CommissionNoticePeriod = cfg.GetInt64(postfix(CommissionNoticePeriod))
+	conf.MaxCommissionIncrease = cfg.GetFloat64(postfix(MaxCommissionIncrease))
 
 	return conf
 }
@@ -33,7 +33,7 @@ func TestConfigMap_Get(t *testing.T) {
 
 	stringMap := cfg.ToStringMap()
 
-	require.Equal(t, 14, len(stringMap.Fields))
+	require.Equal(t, 16, len(stringMap.Fields))
 	require.Contains(t, stringMap.Fields, OwnerID)
 	require.Contains(t, stringMap.Fields, MinBurnAmount)
 	require.Contains(t, stringMap.Fields, MinMintAmount)
@@ -44,6 +44,8 @@ func TestConfigMap_Get(t *testing.T) {
 	require.Contains(t, stringMap.Fields, BurnAddress)
 	require.Contains(t, stringMap.Fields, PercentAuthorizers)
 	require.Contains(t, stringMap.Fields, MaxDelegates)
+	require.Contains(t, stringMap.Fields, CommissionNoticePeriod)
+	require.Contains(t, stringMap.Fields, MaxCommissionIncrease)
 
 	for _, costFunction := range CostFunctions {
 		require.Contains(t, stringMap.Fields, fmt.Sprintf("%s.%s", Cost, costFunction))
@@ -59,6 +61,8 @@ func TestConfigMap_Get(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%v", cfg.BurnAddress), stringMap.Fields[BurnAddress])
 	require.Equal(t, fmt.Sprintf("%v", cfg.PercentAuthorizers), stringMap.Fields[PercentAuthorizers])
 	require.Equal(t, fmt.Sprintf("%v", cfg.MaxDelegates), stringMap.Fields[MaxDelegates])
+	require.Equal(t, fmt.Sprintf("%v", cfg.CommissionNoticePeriod), stringMap.Fields[CommissionNoticePeriod])
+	require.Equal(t, fmt.Sprintf("%v", cfg.MaxCommissionIncrease), stringMap.Fields[MaxCommissionIncrease])
 
 	for _, costFunction := range CostFunctions {
 		t.Log("expected key,  value:", costFunction, fmt.Sprintf("%d", cfg.Cost[strings.ToLower(costFunction)]))
@@ -70,6 +70,8 @@ func MakeMockStateContextWithoutAutorizers() *mockStateContext {
 		},
 	)
 
+	ctx.On("GetBlock").Return(&block.Block{})
+
 	// Global Node
 
 	ctx.globalNode = &GlobalNode{
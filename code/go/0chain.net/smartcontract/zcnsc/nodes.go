@@ -34,6 +34,10 @@ type ZCNSConfig struct {
 	OwnerId            string         `json:"owner_id"`
 	Cost               map[string]int `json:"cost"`
 	MaxDelegates       int            `json:"max_delegates"` // MaxDelegates per stake pool
+	// CommissionNoticePeriod is number of rounds a service charge increase waits
+	CommissionNoticePeriod int64 `json:"commission_notice_period"`
+	// MaxCommissionIncrease is the max service charge increase per notice period
+	MaxCommissionIncrease float64 `json:"max_commission_increase"`
 }
 
 type GlobalNode struct {
@@ -114,6 +118,16 @@ func (gn *GlobalNode) UpdateConfig(cfg *smartcontract.StringMap) (err error) {
 			if err != nil {
 				return fmt.Errorf("key %s, unable to convert %v to int64", key, value)
 			}
+		case CommissionNoticePeriod:
+			gn.CommissionNoticePeriod, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("key %s, unable to convert %v to int64", key, value)
+			}
+		case MaxCommissionIncrease:
+			gn.MaxCommissionIncrease, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("key %s, unable to convert %v to float64", key, value)
+			}
 		default:
 			return fmt.Errorf("key %s, unable to convert %v to currency.Coin", key, value)
 		}
@@ -175,6 +189,10 @@ func (gn *GlobalNode) Validate() error {
 		return common.NewError(Code, fmt.Sprintf("max delegate count (%v) is less than 0", gn.MaxDelegates))
 	case gn.MinLockAmount == 0:
 		return common.NewError(Code, fmt.Sprintf("min lock amount (%v) is equal to 0", gn.MinLockAmount))
+	case gn.CommissionNoticePeriod < 0:
+		return common.NewError(Code, fmt.Sprintf("commission notice period (%v) is less than 0", gn.CommissionNoticePeriod))
+	case gn.MaxCommissionIncrease < 0:
+		return common.NewError(Code, fmt.Sprintf("max commission increase (%v) is less than 0", gn.MaxCommissionIncrease))
 	}
 	return nil
 }
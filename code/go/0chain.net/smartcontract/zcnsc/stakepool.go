@@ -142,6 +142,7 @@ func (zcn *ZCNSmartContract) getOrUpdateStakePool(
 		sp = NewStakePool()
 		sp.Minter = cstate.MinterStorage
 		sp.Settings.DelegateWallet = settings.DelegateWallet
+		sp.Settings.ServiceChargeRatio = settings.ServiceChargeRatio
 		changed = true
 	}
 
@@ -155,8 +156,17 @@ func (zcn *ZCNSmartContract) getOrUpdateStakePool(
 		changed = true
 	}
 
-	if sp.Settings.ServiceChargeRatio != settings.ServiceChargeRatio {
-		sp.Settings.ServiceChargeRatio = settings.ServiceChargeRatio
+	if sp.Settings.ServiceChargeRatio != settings.ServiceChargeRatio ||
+		sp.PendingCommission != nil && sp.PendingCommission.ServiceChargeRatio != settings.ServiceChargeRatio {
+		// an increase waits for its notice period
+		err := sp.UpdateServiceCharge(settings.ServiceChargeRatio, authorizerID, spenum.Authorizer,
+			stakepool.CommissionConfig{
+				NoticePeriod: gn.CommissionNoticePeriod,
+				MaxIncrease:  gn.MaxCommissionIncrease,
+			}, ctx)
+		if err != nil {
+			return nil, err
+		}
 		changed = true
 	}
 